@@ -0,0 +1,240 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultBatchWindow is how long record changes for a zone are collected before they are written together
+const DefaultBatchWindow = 250 * time.Millisecond
+
+// recordChange is a single record mutation waiting to be written as part of a zone batch
+type recordChange struct {
+	// apply modifies the record set in place or appends to it. It returns nil records
+	// if the change turned out to be a no-op.
+	apply func(records []*DNSRecordInfo) ([]*DNSRecordInfo, error)
+	// created is set for creations, whose record ID is looked up after the batch was written
+	created *DNSRecordInfo
+
+	ctx  context.Context
+	err  error
+	done chan recordChangeResult
+}
+
+// recordChangeResult is handed back to the caller that submitted a change
+type recordChangeResult struct {
+	recordID string
+	err      error
+}
+
+// zoneBatch collects the pending changes of one zone
+type zoneBatch struct {
+	mu      sync.Mutex
+	pending []*recordChange
+}
+
+// zoneBatches holds the batch of every zone that has seen changes in the provider process
+var zoneBatches = struct {
+	mu      sync.Mutex
+	batches map[string]*zoneBatch
+}{
+	batches: make(map[string]*zoneBatch),
+}
+
+func zoneBatchFor(key string) *zoneBatch {
+	zoneBatches.mu.Lock()
+	defer zoneBatches.mu.Unlock()
+
+	batch, ok := zoneBatches.batches[key]
+	if !ok {
+		batch = &zoneBatch{}
+		zoneBatches.batches[key] = batch
+	}
+	return batch
+}
+
+// withdraw removes a change that has not been picked up for writing yet
+func (b *zoneBatch) withdraw(change *recordChange) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	i := slices.Index(b.pending, change)
+	if i < 0 {
+		return false
+	}
+	b.pending = slices.Delete(b.pending, i, i+1)
+	return true
+}
+
+// submitRecordChange queues a change for the domain and waits until the batch it ended up in
+// has been written. The first change of a batch starts the collection window. If the context
+// is done before the batch is written, the change is withdrawn.
+func (c *NetcupClient) submitRecordChange(ctx context.Context, domain string, change *recordChange) (string, error) {
+	change.ctx = ctx
+	change.done = make(chan recordChangeResult, 1)
+	batch := zoneBatchFor(zoneKey(c, domain))
+
+	batch.mu.Lock()
+	batch.pending = append(batch.pending, change)
+	if len(batch.pending) == 1 {
+		time.AfterFunc(c.batchWindow, func() {
+			c.flushRecordChanges(domain, batch)
+		})
+	}
+	batch.mu.Unlock()
+
+	select {
+	case result := <-change.done:
+		return result.recordID, result.err
+	case <-ctx.Done():
+		if batch.withdraw(change) {
+			return "", ctx.Err()
+		}
+		// The batch is already being written; its context is cancelled once every caller gave up
+		result := <-change.done
+		return result.recordID, result.err
+	}
+}
+
+// batchContext returns a context for writing a batch that is cancelled only once the contexts
+// of all callers waiting for the batch are done.
+func batchContext(changes []*recordChange) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.WithoutCancel(changes[0].ctx))
+
+	var waiting atomic.Int32
+	waiting.Store(int32(len(changes)))
+
+	stops := make([]func() bool, 0, len(changes))
+	for _, change := range changes {
+		stops = append(stops, context.AfterFunc(change.ctx, func() {
+			if waiting.Add(-1) == 0 {
+				cancel()
+			}
+		}))
+	}
+
+	return ctx, func() {
+		for _, stop := range stops {
+			stop()
+		}
+		cancel()
+	}
+}
+
+// flushRecordChanges writes all pending changes of a zone in a single updateDnsRecords call
+// and reports the outcome, including the IDs of created records, to every waiting caller.
+func (c *NetcupClient) flushRecordChanges(domain string, batch *zoneBatch) {
+	batch.mu.Lock()
+	changes := batch.pending
+	batch.pending = nil
+	batch.mu.Unlock()
+
+	// Drop changes whose callers gave up while the batch was being collected
+	changes = slices.DeleteFunc(changes, func(change *recordChange) bool {
+		if err := change.ctx.Err(); err != nil {
+			change.done <- recordChangeResult{err: err}
+			return true
+		}
+		return false
+	})
+	if len(changes) == 0 {
+		return
+	}
+
+	ctx, cancel := batchContext(changes)
+	defer cancel()
+
+	existingRecords, err := c.modifyDNSRecords(ctx, domain, func(records []*DNSRecordInfo) ([]*DNSRecordInfo, error) {
+		modified := false
+		for _, change := range changes {
+			updated, err := change.apply(records)
+			change.err = err
+			if err != nil || updated == nil {
+				continue
+			}
+			records = updated
+			modified = true
+		}
+
+		if !modified {
+			return nil, nil
+		}
+		return records, nil
+	})
+	if err != nil {
+		for _, change := range changes {
+			change.done <- recordChangeResult{err: err}
+		}
+		return
+	}
+
+	var updatedRecords []*DNSRecordInfo
+	for _, change := range changes {
+		if change.created != nil && change.err == nil {
+			updatedRecords, err = c.getAllDNSRecords(ctx, domain)
+			break
+		}
+	}
+
+	// Hand out each new record ID only once, so identical creations in one batch get distinct IDs
+	claimedIDs := make(map[string]bool, len(existingRecords))
+	for _, record := range existingRecords {
+		claimedIDs[record.ID] = true
+	}
+
+	for _, change := range changes {
+		result := recordChangeResult{err: change.err}
+		if change.created != nil && result.err == nil {
+			result.recordID, result.err = resolveCreatedRecordID(change.created, updatedRecords, claimedIDs, err)
+		}
+		change.done <- result
+	}
+}
+
+// resolveCreatedRecordID finds the ID Netcup assigned to a newly created record
+func resolveCreatedRecordID(
+	created *DNSRecordInfo,
+	updatedRecords []*DNSRecordInfo,
+	claimedIDs map[string]bool,
+	fetchErr error,
+) (string, error) {
+	if fetchErr != nil {
+		return "", fmt.Errorf("failed to get updated DNS records to find new record ID: %w", fetchErr)
+	}
+
+	for _, record := range updatedRecords {
+		match := record.Hostname == created.Hostname &&
+			record.Type == created.Type &&
+			!valueChanged(record.Destination, created.Destination, created.Type)
+		if requiresPriority(created.Type) && created.Priority != "" {
+			match = match && record.Priority == created.Priority
+		}
+
+		if match && record.ID != "" && !claimedIDs[record.ID] {
+			claimedIDs[record.ID] = true
+			return record.ID, nil
+		}
+	}
+
+	return "", errors.New("DNS record was created successfully but no record ID was found. " +
+		"This may indicate an API issue")
+}
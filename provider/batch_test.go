@@ -0,0 +1,138 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetcupClient_BatchesZoneChanges(t *testing.T) {
+	t.Parallel()
+	zone := &zoneServer{
+		nextID: 10,
+		records: []DNSRecordInfo{
+			{ID: "1", Hostname: "www", Type: "A", Destination: "1.2.3.4"},
+			{ID: "2", Hostname: "old", Type: "A", Destination: "1.2.3.4"},
+		},
+	}
+	server := httptest.NewServer(zone)
+	defer server.Close()
+
+	client := NewNetcupClient("test-key", "test-password", "test-customer",
+		WithEndpoint(server.URL), WithBatchWindow(100*time.Millisecond))
+	client.limiter = newRateLimiter(60000)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	createdIDs := make(map[string]bool)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Identical records must still be matched to distinct IDs
+			id, err := client.CreateDNSRecord(t.Context(), "example.com", "rr", "A", "5.6.7.8", "")
+			assert.NoError(t, err)
+
+			mu.Lock()
+			defer mu.Unlock()
+			assert.False(t, createdIDs[id], "record ID %s handed out twice", id)
+			createdIDs[id] = true
+		}()
+	}
+
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, client.UpdateDNSRecord(t.Context(), "1", "example.com", "www", "A", "9.9.9.9", ""))
+	}()
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, client.DeleteDNSRecord(t.Context(), "2", "example.com"))
+	}()
+	go func() {
+		defer wg.Done()
+		// A failing change only fails its own caller
+		err := client.UpdateDNSRecord(t.Context(), "404", "example.com", "missing", "A", "9.9.9.9", "")
+		assert.ErrorContains(t, err, "DNS record not found: 404")
+	}()
+	wg.Wait()
+
+	zone.mu.Lock()
+	defer zone.mu.Unlock()
+	assert.Equal(t, 1, zone.updates, "all changes should be written in one updateDnsRecords call")
+	require.Len(t, zone.records, 6)
+	assert.Equal(t, "9.9.9.9", zone.records[0].Destination)
+	for _, record := range zone.records[1:] {
+		assert.True(t, createdIDs[record.ID])
+	}
+}
+
+func TestNetcupClient_CancelledChangeIsWithdrawn(t *testing.T) {
+	t.Parallel()
+	zone := &zoneServer{
+		nextID:  1,
+		records: []DNSRecordInfo{{ID: "1", Hostname: "www", Type: "A", Destination: "1.2.3.4"}},
+	}
+	server := httptest.NewServer(zone)
+	defer server.Close()
+
+	client := NewNetcupClient("test-key", "test-password", "test-customer",
+		WithEndpoint(server.URL), WithBatchWindow(200*time.Millisecond))
+	client.limiter = newRateLimiter(60000)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := client.CreateDNSRecord(ctx, "example.com", "gone", "A", "5.6.7.8", "")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// Let the batch window elapse; the withdrawn change must never be written
+	time.Sleep(300 * time.Millisecond)
+
+	zone.mu.Lock()
+	defer zone.mu.Unlock()
+	assert.Zero(t, zone.updates)
+	assert.Len(t, zone.records, 1)
+}
+
+func TestResolveCreatedRecordID_MatchesPriority(t *testing.T) {
+	t.Parallel()
+	updated := []*DNSRecordInfo{
+		{ID: "1", Hostname: "_sip._tcp", Type: "SRV", Destination: "5 5060 sip.example.com", Priority: "10"},
+		{ID: "2", Hostname: "_sip._tcp", Type: "SRV", Destination: "5 5060 sip.example.com", Priority: "20"},
+	}
+	created := &DNSRecordInfo{Hostname: "_sip._tcp", Type: "SRV", Destination: "5 5060 sip.example.com", Priority: "20"}
+
+	id, err := resolveCreatedRecordID(created, updated, map[string]bool{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "2", id)
+}
+
+func TestResolveCreatedRecordID_MatchesRequotedCAA(t *testing.T) {
+	t.Parallel()
+	updated := []*DNSRecordInfo{{ID: "1", Hostname: "@", Type: "CAA", Destination: "0 issue letsencrypt.org"}}
+	created := &DNSRecordInfo{Hostname: "@", Type: "CAA", Destination: `0 issue "letsencrypt.org"`}
+
+	id, err := resolveCreatedRecordID(created, updated, map[string]bool{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "1", id)
+}
@@ -0,0 +1,243 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// CAA property tags as defined in RFC 8659 and the CA/Browser Forum baseline requirements
+const (
+	CAATagIssue        = "issue"
+	CAATagIssueWild    = "issuewild"
+	CAATagIODEF        = "iodef"
+	CAATagContactEmail = "contactemail"
+	CAATagContactPhone = "contactphone"
+)
+
+// caaFlagIssuerCritical is the only flag defined for CAA records
+const caaFlagIssuerCritical = 128
+
+var (
+	caaValuePattern     = regexp.MustCompile(`^\s*([0-9]+)\s+(\S+)(?:\s+(.*?))?\s*$`)
+	caaTagPattern       = regexp.MustCompile(`^[a-z0-9]+$`)
+	caaParameterPattern = regexp.MustCompile(`^[a-zA-Z0-9]+=[\x21-\x3a\x3c-\x7e]*$`)
+	phoneNumberPattern  = regexp.MustCompile(`^\+?[0-9][0-9 ().-]*$`)
+)
+
+// CAARecord contains the structured inputs of a CAA record as defined in RFC 8659
+type CAARecord struct {
+	Flags *int   `pulumi:"flags,optional"`
+	Tag   string `pulumi:"tag"`
+	Value string `pulumi:"value"`
+}
+
+// Annotate provides metadata about the CAARecord.
+func (caa *CAARecord) Annotate(a infer.Annotator) {
+	a.Describe(&caa.Flags, "The flags of the record: 0, or 128 to mark the property as critical for issuers")
+	a.SetDefault(&caa.Flags, 0)
+	a.Describe(&caa.Tag, "The property tag: issue, issuewild, iodef, contactemail or contactphone")
+	a.Describe(
+		&caa.Value,
+		"The unquoted property value, e.g. 'letsencrypt.org' for issue or 'mailto:security@example.com' for iodef",
+	)
+}
+
+// value returns the destination of the record in canonical presentation format
+func (caa CAARecord) value() string {
+	flags := 0
+	if caa.Flags != nil {
+		flags = *caa.Flags
+	}
+	return formatCAAValue(flags, caa.Tag, caa.Value)
+}
+
+// formatCAAValue renders a CAA record as 'flags tag "value"'
+func formatCAAValue(flags int, tag, value string) string {
+	escape := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	return fmt.Sprintf(`%d %s "%s"`, flags, tag, escape.Replace(value))
+}
+
+// parseCAAValue splits a CAA destination into flags, tag and unquoted value. The value may be
+// quoted or not and the fields may be separated by any whitespace, as Netcup does not
+// preserve the formatting.
+func parseCAAValue(destination string) (*CAARecord, bool) {
+	match := caaValuePattern.FindStringSubmatch(destination)
+	if match == nil {
+		return nil, false
+	}
+	flags, err := strconv.Atoi(match[1])
+	if err != nil {
+		return nil, false
+	}
+
+	value := match[3]
+	if len(value) >= 2 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+		value = strings.NewReplacer(`\\`, `\`, `\"`, `"`).Replace(value[1 : len(value)-1])
+	}
+
+	return &CAARecord{Flags: &flags, Tag: strings.ToLower(match[2]), Value: value}, true
+}
+
+// canonicalCAAValue renders a CAA destination in canonical format. Values that cannot be
+// parsed are returned unchanged for validation to report them.
+func canonicalCAAValue(destination string) string {
+	caa, ok := parseCAAValue(destination)
+	if !ok {
+		return destination
+	}
+	return caa.value()
+}
+
+// normalizeCAARecord normalizes the structured CAA inputs
+func normalizeCAARecord(caa *CAARecord) *CAARecord {
+	if caa == nil {
+		return nil
+	}
+	normalized := *caa
+	normalized.Tag = strings.ToLower(strings.TrimSpace(caa.Tag))
+	normalized.Value = strings.TrimSpace(caa.Value)
+	return &normalized
+}
+
+// composeCAARecord derives the value of a record with structured CAA inputs
+func composeCAARecord(args DNSRecordArgs) DNSRecordArgs {
+	if args.CAA != nil && args.Value == "" {
+		args.Value = args.CAA.value()
+	}
+	return args
+}
+
+// validateCAARecord validates the structured CAA inputs, or the value of a CAA record declared
+// without them. Values may use tags beyond the ones the structured inputs support.
+func validateCAARecord(args DNSRecordArgs) []p.CheckFailure {
+	isCAA := strings.EqualFold(args.Type, "CAA")
+	if args.CAA != nil && !isCAA {
+		return []p.CheckFailure{{
+			Property: "caa",
+			Reason:   fmt.Sprintf("caa can only be set for CAA records, not %s", args.Type),
+		}}
+	}
+	if !isCAA || args.Value == "" {
+		return nil
+	}
+
+	if args.CAA == nil {
+		caa, ok := parseCAAValue(args.Value)
+		if !ok {
+			return []p.CheckFailure{{
+				Property: "value",
+				Reason:   `CAA value must have the format 'flags tag "value"'`,
+			}}
+		}
+		return caaFailures(*caa, false, func(string) string { return "value" })
+	}
+
+	var failures []p.CheckFailure
+	if args.Value != args.CAA.value() {
+		failures = append(failures, p.CheckFailure{
+			Property: "value",
+			Reason:   "Only one of value and caa can be set",
+		})
+	}
+	return append(failures, caaFailures(*args.CAA, true, func(field string) string { return "caa." + field })...)
+}
+
+// caaFailures validates the flags, tag and value of a CAA record as defined in RFC 8659
+func caaFailures(caa CAARecord, knownTagsOnly bool, property func(field string) string) []p.CheckFailure {
+	var failures []p.CheckFailure
+	fail := func(field, reason string) {
+		failures = append(failures, p.CheckFailure{Property: property(field), Reason: reason})
+	}
+
+	if caa.Flags != nil && *caa.Flags != 0 && *caa.Flags != caaFlagIssuerCritical {
+		fail("flags", fmt.Sprintf("Flags must be 0 or %d, got %d", caaFlagIssuerCritical, *caa.Flags))
+	}
+
+	switch caa.Tag {
+	case CAATagIssue, CAATagIssueWild:
+		if reason := validateCAAIssuer(caa.Value); reason != "" {
+			fail("value", reason)
+		}
+	case CAATagIODEF:
+		if reason := validateCAAIODEF(caa.Value); reason != "" {
+			fail("value", reason)
+		}
+	case CAATagContactEmail:
+		if address, err := mail.ParseAddress(caa.Value); err != nil || address.Address != caa.Value {
+			fail("value", fmt.Sprintf("contactemail value %q must be an email address", caa.Value))
+		}
+	case CAATagContactPhone:
+		if !phoneNumberPattern.MatchString(caa.Value) {
+			fail("value", fmt.Sprintf("contactphone value %q must be a phone number", caa.Value))
+		}
+	default:
+		if knownTagsOnly || !caaTagPattern.MatchString(caa.Tag) {
+			fail("tag", fmt.Sprintf("Unsupported CAA tag %q. Valid tags are: %s, %s, %s, %s, %s", caa.Tag,
+				CAATagIssue, CAATagIssueWild, CAATagIODEF, CAATagContactEmail, CAATagContactPhone))
+		}
+	}
+
+	return failures
+}
+
+// validateCAAIssuer validates an issue or issuewild value: an optional issuer domain name
+// followed by semicolon separated "key=value" parameters
+func validateCAAIssuer(value string) string {
+	parts := strings.Split(value, ";")
+	if issuer := strings.TrimSpace(parts[0]); issuer != "" && !isValidHostname(issuer) {
+		return fmt.Sprintf("issuer %q must be a domain name", issuer)
+	}
+	for _, parameter := range parts[1:] {
+		parameter = strings.TrimSpace(parameter)
+		if parameter == "" && len(parts) == 2 {
+			// A single semicolon forbids issuance
+			continue
+		}
+		if !caaParameterPattern.MatchString(parameter) {
+			return fmt.Sprintf("parameter %q must have the format key=value", parameter)
+		}
+	}
+	return ""
+}
+
+// validateCAAIODEF validates an iodef value, which must be a mailto, http or https URL
+func validateCAAIODEF(value string) string {
+	u, err := url.Parse(value)
+	if err != nil {
+		return fmt.Sprintf("iodef value %q must be a URL: %v", value, err)
+	}
+	switch u.Scheme {
+	case "mailto":
+		if _, err := mail.ParseAddress(u.Opaque); err != nil {
+			return fmt.Sprintf("iodef value %q must contain an email address", value)
+		}
+	case "http", "https":
+		if u.Host == "" {
+			return fmt.Sprintf("iodef value %q must contain a host", value)
+		}
+	default:
+		return fmt.Sprintf("iodef value %q must be a mailto, http or https URL", value)
+	}
+	return ""
+}
@@ -0,0 +1,141 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestParseCAAValue(t *testing.T) {
+	t.Parallel()
+	for _, destination := range []string{
+		`0 issue "letsencrypt.org"`,
+		`0 issue letsencrypt.org`,
+		"  0\tISSUE   \"letsencrypt.org\" ",
+	} {
+		assert.Equal(t, `0 issue "letsencrypt.org"`, canonicalCAAValue(destination), destination)
+	}
+
+	caa, ok := parseCAAValue(`128 iodef "mailto:security@example.com"`)
+	require.True(t, ok)
+	assert.Equal(t, 128, *caa.Flags)
+	assert.Equal(t, "iodef", caa.Tag)
+	assert.Equal(t, "mailto:security@example.com", caa.Value)
+
+	caa, ok = parseCAAValue(`0 issue "a \"quoted\" \\ value"`)
+	require.True(t, ok)
+	assert.Equal(t, `a "quoted" \ value`, caa.Value)
+	assert.Equal(t, `0 issue "a \"quoted\" \\ value"`, caa.value())
+
+	_, ok = parseCAAValue("issue letsencrypt.org")
+	assert.False(t, ok)
+}
+
+func TestDnsRecordCheckCAA(t *testing.T) {
+	t.Parallel()
+	check := func(inputs map[string]property.Value) infer.CheckResponse[DNSRecordArgs] {
+		inputs["domain"] = property.New("example.com")
+		inputs["name"] = property.New("@")
+		inputs["type"] = property.New("CAA")
+		response, err := (&DNSRecord{}).Check(t.Context(), infer.CheckRequest{NewInputs: property.NewMap(inputs)})
+		require.NoError(t, err)
+		return response
+	}
+	caa := func(tag, value string) property.Value {
+		return property.New(map[string]property.Value{"tag": property.New(tag), "value": property.New(value)})
+	}
+	reasons := func(response infer.CheckResponse[DNSRecordArgs]) map[string]string {
+		byProperty := make(map[string]string)
+		for _, failure := range response.Failures {
+			byProperty[failure.Property] = failure.Reason
+		}
+		return byProperty
+	}
+
+	response := check(map[string]property.Value{"caa": caa("Issue", "letsencrypt.org; validationmethods=dns-01")})
+	assert.Empty(t, response.Failures)
+	assert.Equal(t, `0 issue "letsencrypt.org; validationmethods=dns-01"`, response.Inputs.Value)
+
+	for _, valid := range [][2]string{
+		{"issuewild", ";"},
+		{"iodef", "mailto:security@example.com"},
+		{"iodef", "https://example.com/caa"},
+		{"contactemail", "security@example.com"},
+		{"contactphone", "+49 (0)30 1234-567"},
+	} {
+		response = check(map[string]property.Value{"caa": caa(valid[0], valid[1])})
+		assert.Empty(t, response.Failures, valid)
+	}
+
+	response = check(map[string]property.Value{"caa": caa("iodef", "ftp://example.com")})
+	assert.Equal(t, map[string]string{
+		"caa.value": `iodef value "ftp://example.com" must be a mailto, http or https URL`,
+	}, reasons(response))
+
+	response = check(map[string]property.Value{"caa": caa("issue", "letsencrypt.org; bad parameter")})
+	assert.Contains(t, reasons(response), "caa.value")
+
+	response = check(map[string]property.Value{"caa": caa("issuemail", "example.net")})
+	assert.Contains(t, reasons(response)["caa.tag"], `Unsupported CAA tag "issuemail"`)
+
+	response = check(map[string]property.Value{"caa": property.New(map[string]property.Value{
+		"flags": property.New(1.0),
+		"tag":   property.New("issue"),
+		"value": property.New("letsencrypt.org"),
+	})})
+	assert.Equal(t, map[string]string{"caa.flags": "Flags must be 0 or 128, got 1"}, reasons(response))
+
+	// Plain values are rendered canonically and validated, but may use other tags
+	response = check(map[string]property.Value{"value": property.New("0   issue letsencrypt.org")})
+	assert.Empty(t, response.Failures)
+	assert.Equal(t, `0 issue "letsencrypt.org"`, response.Inputs.Value)
+
+	response = check(map[string]property.Value{"value": property.New(`0 issuemail "example.net"`)})
+	assert.Empty(t, response.Failures)
+
+	response = check(map[string]property.Value{"value": property.New(`0 iodef "example.com"`)})
+	assert.Contains(t, reasons(response), "value")
+
+	response = check(map[string]property.Value{
+		"value": property.New(`0 issue "other.org"`),
+		"caa":   caa("issue", "letsencrypt.org"),
+	})
+	assert.Equal(t, map[string]string{"value": "Only one of value and caa can be set"}, reasons(response))
+}
+
+func TestDnsRecordDiffCAA(t *testing.T) {
+	t.Parallel()
+	diff := func(inputValue, stateValue string) bool {
+		args := DNSRecordArgs{Domain: "example.com", Name: "@", Type: "CAA", Value: inputValue}
+		state := DNSRecordState{DNSRecordArgs: args, FQDN: "example.com"}
+		state.Value = stateValue
+		response, err := (&DNSRecord{}).Diff(t.Context(), infer.DiffRequest[DNSRecordArgs, DNSRecordState]{
+			Inputs: args,
+			State:  state,
+		})
+		require.NoError(t, err)
+		return response.HasChanges
+	}
+
+	assert.False(t, diff(`0 issue "letsencrypt.org"`, "0 issue letsencrypt.org"))
+	assert.False(t, diff(`0 issue "letsencrypt.org"`, `0  issue   "letsencrypt.org"`))
+	assert.True(t, diff(`0 issue "letsencrypt.org"`, `0 issue "sectigo.com"`))
+}
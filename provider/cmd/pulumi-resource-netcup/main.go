@@ -19,13 +19,29 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 
 	netcup "github.com/blackdark/pulumi-netcup/provider"
 )
 
-// Serve the provider against Pulumi's Provider protocol.
+// Serve the provider against Pulumi's Provider protocol, or run the generate command that
+// writes a Pulumi program for the live records of existing domains.
 func main() {
-	err := netcup.Provider().Run(context.Background(), netcup.Name, netcup.Version)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	var err error
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		err = netcup.RunGenerate(ctx, os.Args[2:], os.Stdout)
+	} else {
+		err = netcup.Provider().Run(ctx, netcup.Name, netcup.Version)
+	}
+	stop()
+
+	// Log out of any API sessions that were kept open across operations
+	closeCtx, cancel := context.WithTimeout(context.Background(), netcup.APITimeout)
+	_ = netcup.CloseSessions(closeCtx)
+	cancel()
+
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %s", err.Error())
 		os.Exit(1)
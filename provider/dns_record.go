@@ -16,11 +16,13 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
 	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
 )
 
 // DNSRecord represents a DNS record managed by Netcup DNS service.
@@ -28,16 +30,23 @@ type DNSRecord struct{}
 
 // Annotate provides metadata about the DNSRecord resource.
 func (r *DNSRecord) Annotate(a infer.Annotator) {
-	a.Describe(&r, "A DNS record managed by Netcup DNS service")
+	a.Describe(&r, "A DNS record managed by Netcup DNS service. Existing records are imported with the ID "+
+		"'domain:recordID' or by content with 'domain/name/type' or 'domain/name/type/value'")
 }
 
 // DNSRecordArgs contains the input arguments for a DNS record resource.
 type DNSRecordArgs struct {
-	Domain   string  `pulumi:"domain"`
-	Name     string  `pulumi:"name"`
-	Type     string  `pulumi:"type"`
-	Value    string  `pulumi:"value"`
-	Priority *string `pulumi:"priority,optional"`
+	Domain   string      `pulumi:"domain"`
+	Name     string      `pulumi:"name,optional"`
+	Type     string      `pulumi:"type"`
+	Value    string      `pulumi:"value,optional"`
+	Priority *string     `pulumi:"priority,optional"`
+	SRV      *SRVRecord  `pulumi:"srv,optional"`
+	CAA      *CAARecord  `pulumi:"caa,optional"`
+	TLSA     *TLSARecord `pulumi:"tlsa,optional"`
+
+	OPENPGPKEY *OPENPGPKEYRecord `pulumi:"openpgpkey,optional"`
+	SMIMEA     *SMIMEARecord     `pulumi:"smimea,optional"`
 }
 
 // Annotate provides metadata about the DNSRecordArgs.
@@ -45,7 +54,8 @@ func (args *DNSRecordArgs) Annotate(a infer.Annotator) {
 	a.Describe(&args.Domain, "The domain name for the DNS record (e.g., 'example.com')")
 	a.Describe(
 		&args.Name,
-		"The hostname for the DNS record. Use '@' for root domain, or specify subdomain (e.g., 'www', 'mail')",
+		"The hostname for the DNS record. Use '@' for root domain, or specify subdomain (e.g., 'www', 'mail'). "+
+			"Defaults to '@', for openpgpkey and smimea records to the name derived from the email address",
 	)
 	a.Describe(
 		&args.Type,
@@ -53,9 +63,31 @@ func (args *DNSRecordArgs) Annotate(a infer.Annotator) {
 	)
 	a.Describe(
 		&args.Value,
-		"The value/destination for the DNS record (e.g., IP address for A records, hostname for CNAME)",
+		"The value/destination for the DNS record (e.g., IP address for A records, hostname for CNAME). "+
+			"Required unless the value is composed from structured inputs such as srv",
 	)
 	a.Describe(&args.Priority, "The priority for MX and SRV records (required for these types, ignored for others)")
+	a.Describe(
+		&args.SRV,
+		"Structured inputs of an SRV record. The value is composed as 'weight port target' and the name is "+
+			"placed below '_service._proto', using '@' for the service of the domain itself",
+	)
+	a.Describe(&args.CAA, "Structured inputs of a CAA record. The value is composed as 'flags tag \"value\"'")
+	a.Describe(
+		&args.TLSA,
+		"Inputs of a TLSA record derived from a certificate or public key. The association data is computed "+
+			"from the certificate and the name is placed below '_port._proto'",
+	)
+	a.Describe(
+		&args.OPENPGPKEY,
+		"Inputs of an OPENPGPKEY record publishing the key of an email address. The name is derived from "+
+			"the hashed local part below '_openpgpkey' and the value is the base64 encoded key",
+	)
+	a.Describe(
+		&args.SMIMEA,
+		"Inputs of an SMIMEA record publishing the S/MIME certificate of an email address. The name is "+
+			"derived from the hashed local part below '_smimecert'",
+	)
 }
 
 // DNSRecordState contains the state of a DNS record resource.
@@ -72,6 +104,11 @@ func (state *DNSRecordState) Annotate(a infer.Annotator) {
 	a.Describe(&state.Type, "The DNS record type")
 	a.Describe(&state.Value, "The value/destination for the DNS record")
 	a.Describe(&state.Priority, "The priority for the DNS record")
+	a.Describe(&state.SRV, "The structured inputs of an SRV record")
+	a.Describe(&state.CAA, "The structured inputs of a CAA record")
+	a.Describe(&state.TLSA, "The inputs the TLSA record is derived from")
+	a.Describe(&state.OPENPGPKEY, "The inputs the OPENPGPKEY record is derived from")
+	a.Describe(&state.SMIMEA, "The inputs the SMIMEA record is derived from")
 	a.Describe(&state.RecordID, "The unique identifier for the DNS record")
 	a.Describe(&state.FQDN, "The fully qualified domain name")
 }
@@ -101,14 +138,17 @@ func (r *DNSRecord) Create(
 	}
 
 	config := infer.GetConfig[Config](ctx)
-	client := NewNetcupClient(config.APIKey, config.APIPassword, config.CustomerID)
+	client, err := config.netcupClient()
+	if err != nil {
+		return infer.CreateResponse[DNSRecordState]{}, err
+	}
 
 	var priority string
 	if input.Priority != nil {
 		priority = *input.Priority
 	}
 
-	recordID, err := client.CreateDNSRecord(input.Domain, input.Name, input.Type, input.Value, priority)
+	recordID, err := client.CreateDNSRecord(ctx, input.Domain, input.Name, input.Type, input.Value, priority)
 	if err != nil {
 		return infer.CreateResponse[DNSRecordState]{}, fmt.Errorf("failed to create DNS record: %w", err)
 	}
@@ -130,20 +170,32 @@ func (r *DNSRecord) Read(
 	ctx context.Context,
 	req infer.ReadRequest[DNSRecordArgs, DNSRecordState],
 ) (infer.ReadResponse[DNSRecordArgs, DNSRecordState], error) {
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.netcupClient()
+	if err != nil {
+		return infer.ReadResponse[DNSRecordArgs, DNSRecordState]{}, err
+	}
+
+	// Imports may identify the record by its content instead of the composite ID
+	id := req.ID
+	if isNaturalKeyID(id) {
+		id, err = resolveNaturalKeyID(ctx, client, id)
+		if err != nil {
+			return infer.ReadResponse[DNSRecordArgs, DNSRecordState]{}, err
+		}
+	}
+
 	// Parse the composite ID to get domain and record ID
-	domain, recordID, err := parseCompositeID(req.ID)
+	domain, recordID, err := parseCompositeID(id)
 	if err != nil {
 		return infer.ReadResponse[DNSRecordArgs, DNSRecordState]{},
 			fmt.Errorf("invalid resource ID format: %w", err)
 	}
 
-	config := infer.GetConfig[Config](ctx)
-	client := NewNetcupClient(config.APIKey, config.APIPassword, config.CustomerID)
-
-	currentRecord, err := client.GetDNSRecordByID(recordID, domain)
+	currentRecord, err := client.GetDNSRecordByID(ctx, recordID, domain)
 	if err != nil {
-		// Check if this is a "not found" error specifically
-		if isNotFoundError(err) {
+		// The record or its whole domain is gone from the account
+		if errors.Is(err, ErrNotFound) || errors.Is(err, ErrDomainInaccessible) {
 			// Return empty response to indicate resource should be recreated
 			return infer.ReadResponse[DNSRecordArgs, DNSRecordState]{}, nil
 		}
@@ -152,13 +204,8 @@ func (r *DNSRecord) Read(
 			fmt.Errorf("failed to read DNS record %s: %w", recordID, err)
 	}
 
-	// Convert priority to pointer (handling empty/zero values)
-	var priority *string
-	if currentRecord.Priority != "" && currentRecord.Priority != "0" {
-		priority = &currentRecord.Priority
-	}
-
 	// Create inputs and state from current record data
+	priority := recordPriority(currentRecord)
 	inputs := DNSRecordArgs{
 		Domain:   domain,
 		Name:     currentRecord.Hostname,
@@ -167,6 +214,18 @@ func (r *DNSRecord) Read(
 		Priority: priority,
 	}
 
+	// Keep structured inputs in sync with the live record when the record was declared with them
+	if req.Inputs.SRV != nil && strings.EqualFold(currentRecord.Type, "SRV") {
+		inputs.SRV, _ = parseSRVRecord(currentRecord.Hostname, currentRecord.Destination)
+	}
+	if req.Inputs.CAA != nil && strings.EqualFold(currentRecord.Type, "CAA") {
+		inputs.CAA, _ = parseCAAValue(currentRecord.Destination)
+	}
+	// Keys and certificates cannot be derived from the record, drift shows as a changed value
+	inputs.TLSA = req.Inputs.TLSA
+	inputs.OPENPGPKEY = req.Inputs.OPENPGPKEY
+	inputs.SMIMEA = req.Inputs.SMIMEA
+
 	state := DNSRecordState{
 		DNSRecordArgs: inputs,
 		RecordID:      recordID,
@@ -174,7 +233,7 @@ func (r *DNSRecord) Read(
 	}
 
 	return infer.ReadResponse[DNSRecordArgs, DNSRecordState]{
-		ID:     req.ID, // Return the same composite ID
+		ID:     id, // Return the composite ID, also when imported by natural key
 		Inputs: inputs,
 		State:  state,
 	}, nil
@@ -202,10 +261,13 @@ func (r *DNSRecord) Update(
 		return infer.UpdateResponse[DNSRecordState]{}, fmt.Errorf("invalid resource ID: %w", err)
 	}
 	config := infer.GetConfig[Config](ctx)
-	client := NewNetcupClient(config.APIKey, config.APIPassword, config.CustomerID)
+	client, err := config.netcupClient()
+	if err != nil {
+		return infer.UpdateResponse[DNSRecordState]{}, err
+	}
 
 	// Verify the record exists before updating
-	_, err = client.GetDNSRecordByID(recordID, domain)
+	_, err = client.GetDNSRecordByID(ctx, recordID, domain)
 	if err != nil {
 		return infer.UpdateResponse[DNSRecordState]{},
 			fmt.Errorf("failed to find existing DNS record for update: %w", err)
@@ -216,7 +278,7 @@ func (r *DNSRecord) Update(
 		priority = *inputs.Priority
 	}
 
-	err = client.UpdateDNSRecord(recordID, domain, inputs.Name, inputs.Type, inputs.Value, priority)
+	err = client.UpdateDNSRecord(ctx, recordID, domain, inputs.Name, inputs.Type, inputs.Value, priority)
 	if err != nil {
 		return infer.UpdateResponse[DNSRecordState]{}, fmt.Errorf("failed to update DNS record: %w", err)
 	}
@@ -270,7 +332,7 @@ func (r *DNSRecord) Diff(
 	}
 
 	// Check for changes that can be updated in place
-	if req.Inputs.Value != req.State.Value {
+	if valueChanged(req.Inputs.Value, req.State.Value, req.Inputs.Type) {
 		hasChanges = true
 		detailedDiff["value"] = p.PropertyDiff{
 			Kind:      p.Update,
@@ -312,9 +374,12 @@ func (r *DNSRecord) Delete(ctx context.Context, req infer.DeleteRequest[DNSRecor
 	}
 
 	config := infer.GetConfig[Config](ctx)
-	client := NewNetcupClient(config.APIKey, config.APIPassword, config.CustomerID)
+	client, err := config.netcupClient()
+	if err != nil {
+		return infer.DeleteResponse{}, err
+	}
 
-	err = client.DeleteDNSRecord(recordID, domain)
+	err = client.DeleteDNSRecord(ctx, recordID, domain)
 	if err != nil {
 		return infer.DeleteResponse{}, fmt.Errorf("failed to delete DNS record %s: %w", recordID, err)
 	}
@@ -333,8 +398,18 @@ func (r *DNSRecord) Check(ctx context.Context, req infer.CheckRequest) (infer.Ch
 		}, err
 	}
 
-	// Normalize inputs
+	// Normalize inputs and compose the name and value of structured inputs
 	args = normalizeInputs(args)
+	args = composeStructuredInputs(args)
+
+	// Structured inputs may depend on outputs of other resources, such as a renewed certificate,
+	// that are unknown during previews. They are validated once they are known.
+	if hasComputedStructuredInputs(req.NewInputs) {
+		return infer.CheckResponse[DNSRecordArgs]{
+			Inputs:   args,
+			Failures: failures,
+		}, nil
+	}
 
 	// Add custom validation failures
 	additionalFailures := validateDNSRecordWithFailures(args)
@@ -349,11 +424,25 @@ func (r *DNSRecord) Check(ctx context.Context, req infer.CheckRequest) (infer.Ch
 // WireDependencies defines the dependency relationships between inputs and outputs.
 func (r *DNSRecord) WireDependencies(f infer.FieldSelector, args *DNSRecordArgs, state *DNSRecordState) {
 	f.OutputField(&state.Domain).DependsOn(f.InputField(&args.Domain))
-	f.OutputField(&state.Name).DependsOn(f.InputField(&args.Name))
+	f.OutputField(&state.Name).DependsOn(
+		f.InputField(&args.Name), f.InputField(&args.SRV), f.InputField(&args.TLSA),
+		f.InputField(&args.OPENPGPKEY), f.InputField(&args.SMIMEA),
+	)
 	f.OutputField(&state.Type).DependsOn(f.InputField(&args.Type))
-	f.OutputField(&state.Value).DependsOn(f.InputField(&args.Value))
+	f.OutputField(&state.Value).DependsOn(
+		f.InputField(&args.Value), f.InputField(&args.SRV), f.InputField(&args.CAA), f.InputField(&args.TLSA),
+		f.InputField(&args.OPENPGPKEY), f.InputField(&args.SMIMEA),
+	)
 	f.OutputField(&state.Priority).DependsOn(f.InputField(&args.Priority))
-	f.OutputField(&state.FQDN).DependsOn(f.InputField(&args.Name), f.InputField(&args.Domain))
+	f.OutputField(&state.SRV).DependsOn(f.InputField(&args.SRV))
+	f.OutputField(&state.CAA).DependsOn(f.InputField(&args.CAA))
+	f.OutputField(&state.TLSA).DependsOn(f.InputField(&args.TLSA))
+	f.OutputField(&state.OPENPGPKEY).DependsOn(f.InputField(&args.OPENPGPKEY))
+	f.OutputField(&state.SMIMEA).DependsOn(f.InputField(&args.SMIMEA))
+	f.OutputField(&state.FQDN).DependsOn(
+		f.InputField(&args.Name), f.InputField(&args.Domain), f.InputField(&args.SRV), f.InputField(&args.TLSA),
+		f.InputField(&args.OPENPGPKEY), f.InputField(&args.SMIMEA),
+	)
 }
 
 // normalizeInputs normalizes and cleans up input values
@@ -376,9 +465,54 @@ func normalizeInputs(args DNSRecordArgs) DNSRecordArgs {
 		args.Priority = &priority
 	}
 
+	// Render CAA values with canonical quoting
+	if args.Type == "CAA" {
+		args.Value = canonicalCAAValue(args.Value)
+	}
+
+	args.SRV = normalizeSRVRecord(args.SRV)
+	args.CAA = normalizeCAARecord(args.CAA)
+	args.TLSA = normalizeTLSARecord(args.TLSA)
+	if args.OPENPGPKEY != nil {
+		openPGPKey := *args.OPENPGPKEY
+		openPGPKey.Email = strings.TrimSpace(openPGPKey.Email)
+		args.OPENPGPKEY = &openPGPKey
+	}
+	if args.SMIMEA != nil {
+		smimea := *args.SMIMEA
+		smimea.Email = strings.TrimSpace(smimea.Email)
+		args.SMIMEA = &smimea
+	}
+
 	return args
 }
 
+// composeStructuredInputs derives the name and value of a record declared with structured inputs
+func composeStructuredInputs(args DNSRecordArgs) DNSRecordArgs {
+	args = composeSRVRecord(args)
+	args = composeCAARecord(args)
+	args = composeTLSARecord(args)
+	args = composeOPENPGPKEYRecord(args)
+	args = composeSMIMEARecord(args)
+	return args
+}
+
+// hasStructuredInputs reports whether the value of the record is composed from structured inputs
+func (args DNSRecordArgs) hasStructuredInputs() bool {
+	return args.SRV != nil || args.CAA != nil || args.TLSA != nil || args.OPENPGPKEY != nil || args.SMIMEA != nil
+}
+
+// hasComputedStructuredInputs reports whether structured inputs contain values that are not
+// known yet
+func hasComputedStructuredInputs(inputs property.Map) bool {
+	for _, key := range []string{"srv", "caa", "tlsa", "openpgpkey", "smimea"} {
+		if inputs.Get(key).HasComputed() {
+			return true
+		}
+	}
+	return false
+}
+
 // validateDnsRecordWithFailures performs validation and returns field-specific failures
 func validateDNSRecordWithFailures(args DNSRecordArgs) []p.CheckFailure {
 	var failures []p.CheckFailure
@@ -415,7 +549,8 @@ func validateDNSRecordWithFailures(args DNSRecordArgs) []p.CheckFailure {
 		})
 	}
 
-	if args.Value == "" {
+	// Values composed from structured inputs are only missing if those are invalid
+	if args.Value == "" && !args.hasStructuredInputs() {
 		failures = append(failures, p.CheckFailure{
 			Property: "value",
 			Reason:   "Value is required",
@@ -441,6 +576,12 @@ func validateDNSRecordWithFailures(args DNSRecordArgs) []p.CheckFailure {
 		}
 	}
 
+	failures = append(failures, validateSRVRecord(args)...)
+	failures = append(failures, validateCAARecord(args)...)
+	failures = append(failures, validateTLSARecord(args)...)
+	failures = append(failures, validateOPENPGPKEYRecord(args)...)
+	failures = append(failures, validateSMIMEARecord(args)...)
+
 	return failures
 }
 
@@ -504,6 +645,23 @@ func priorityChanged(inputPriority, statePriority *string, recordType string) bo
 	return inputVal != stateVal
 }
 
+// valueChanged compares record values, ignoring the formatting Netcup does not preserve
+func valueChanged(inputValue, stateValue, recordType string) bool {
+	if strings.EqualFold(recordType, "CAA") {
+		return canonicalCAAValue(inputValue) != canonicalCAAValue(stateValue)
+	}
+	return inputValue != stateValue
+}
+
+// recordPriority returns the priority of a record as input. Netcup reports a priority of 0 for
+// all records, which is only kept for the record types that use a priority.
+func recordPriority(record *DNSRecordInfo) *string {
+	if record.Priority == "" || (record.Priority == "0" && !requiresPriority(record.Type)) {
+		return nil
+	}
+	return &record.Priority
+}
+
 func requiresPriority(recordType string) bool {
 	switch strings.ToUpper(recordType) {
 	case "MX", "SRV":
@@ -529,20 +687,18 @@ func sanitizeRecordName(name string) string {
 	return name
 }
 
-func isNotFoundError(err error) bool {
-	if err == nil {
-		return false
+// prefixRecordName places a record name below the given labels, using the labels alone for the
+// root domain. Names that already start with the labels are kept, which keeps the composition
+// of structured inputs idempotent.
+func prefixRecordName(name, prefix string) string {
+	switch {
+	case name == "@":
+		return prefix
+	case name == prefix || strings.HasPrefix(name, prefix+"."):
+		return name
+	default:
+		return prefix + "." + name
 	}
-
-	errMsg := strings.ToLower(err.Error())
-
-	// Check for various "not found" indicators from the Netcup API
-	return strings.Contains(errMsg, "dns record not found") ||
-		strings.Contains(errMsg, "not found") ||
-		strings.Contains(errMsg, "record not found") ||
-		strings.Contains(errMsg, "domain not found") ||
-		// Check for specific Netcup status codes that indicate "not found"
-		strings.Contains(errMsg, "status code: 2016") // Domain not found
 }
 
 // createCompositeID creates a composite ID in the format "domain:recordID"
@@ -564,6 +720,80 @@ func parseCompositeID(compositeID string) (domain, recordID string, err error) {
 	return parts[0], parts[1], nil
 }
 
+// isNaturalKeyID reports whether an ID is a natural key in the format
+// "domain/name/type[/value]" rather than a composite ID
+func isNaturalKeyID(id string) bool {
+	return strings.Contains(id, "/")
+}
+
+// parseNaturalKeyID parses a natural key in the format "domain/name/type[/value]". The value
+// may itself contain slashes.
+func parseNaturalKeyID(id string) (args DNSRecordArgs, hasValue bool, err error) {
+	parts := strings.SplitN(id, "/", 4)
+	if len(parts) < 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return DNSRecordArgs{}, false, fmt.Errorf(
+			"import ID must be in format 'domain:recordID' or 'domain/name/type[/value]', got: %s", id)
+	}
+
+	args = DNSRecordArgs{Domain: parts[0], Name: parts[1], Type: parts[2]}
+	if len(parts) == 4 {
+		args.Value = parts[3]
+		hasValue = true
+	}
+	return normalizeInputs(args), hasValue, nil
+}
+
+// resolveNaturalKeyID looks up the record identified by a natural key in the live zone and
+// returns its composite ID. The key has to match exactly one record.
+func resolveNaturalKeyID(ctx context.Context, client *NetcupClient, id string) (string, error) {
+	key, hasValue, err := parseNaturalKeyID(id)
+	if err != nil {
+		return "", err
+	}
+
+	records, err := client.getAllDNSRecords(ctx, key.Domain)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve import ID %s: %w", id, err)
+	}
+
+	var matches, candidates []*DNSRecordInfo
+	for _, record := range records {
+		if !strings.EqualFold(record.Hostname, key.Name) {
+			continue
+		}
+		candidates = append(candidates, record)
+		if !strings.EqualFold(record.Type, key.Type) {
+			continue
+		}
+		if hasValue && valueChanged(strings.TrimSuffix(key.Value, "."), strings.TrimSuffix(record.Destination, "."),
+			record.Type) {
+			continue
+		}
+		matches = append(matches, record)
+	}
+
+	describe := func(records []*DNSRecordInfo) string {
+		lines := make([]string, 0, len(records))
+		for _, record := range records {
+			lines = append(lines, fmt.Sprintf("\n  %s (%s %s %s)", createCompositeID(key.Domain, record.ID),
+				record.Hostname, record.Type, record.Destination))
+		}
+		return strings.Join(lines, "")
+	}
+
+	switch {
+	case len(matches) == 1:
+		return createCompositeID(key.Domain, matches[0].ID), nil
+	case len(matches) > 1:
+		return "", fmt.Errorf("import ID %s matches %d DNS records, add the value or use one of the IDs:%s",
+			id, len(matches), describe(matches))
+	case len(candidates) > 0:
+		return "", fmt.Errorf("DNS record %w: %s. Records of the same hostname:%s", ErrNotFound, id, describe(candidates))
+	default:
+		return "", fmt.Errorf("DNS record %w: %s", ErrNotFound, id)
+	}
+}
+
 func buildFQDN(name, domain string) string {
 	if name == "@" || name == "" {
 		return domain
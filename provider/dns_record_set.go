@@ -0,0 +1,372 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// DNSRecordSet represents all records of one hostname and type managed by Netcup DNS service.
+type DNSRecordSet struct{}
+
+// Annotate provides metadata about the DNSRecordSet resource.
+func (r *DNSRecordSet) Annotate(a infer.Annotator) {
+	a.Describe(&r, "All DNS records of one hostname and type, such as round-robin A records, "+
+		"several MX hosts or multiple TXT values. Records of the hostname and type that are not listed are deleted")
+}
+
+// DNSRecordSetArgs contains the input arguments for a DNS record set resource.
+type DNSRecordSetArgs struct {
+	Domain string              `pulumi:"domain"`
+	Name   string              `pulumi:"name"`
+	Type   string              `pulumi:"type"`
+	Values []DNSRecordSetValue `pulumi:"values,optional"`
+	SSHFP  *SSHFPRecordSet     `pulumi:"sshfp,optional"`
+}
+
+// Annotate provides metadata about the DNSRecordSetArgs.
+func (args *DNSRecordSetArgs) Annotate(a infer.Annotator) {
+	a.Describe(&args.Domain, "The domain name for the DNS records (e.g., 'example.com')")
+	a.Describe(&args.Name, "The hostname of the DNS records. Use '@' for root domain")
+	a.Describe(
+		&args.Type,
+		"The DNS record type. Supported types: A, AAAA, CNAME, MX, TXT, SRV, CAA, TLSA, NS, DS, OPENPGPKEY, SMIMEA, SSHFP",
+	)
+	a.Describe(&args.Values, "The values of the records, one record per value. Required unless sshfp is set")
+	a.Describe(
+		&args.SSHFP,
+		"Host keys the values of an SSHFP record set are derived from, a SHA-1 and a SHA-256 record per key",
+	)
+}
+
+// DNSRecordSetValue is the value of a single record of a record set.
+type DNSRecordSetValue struct {
+	Value    string  `pulumi:"value"`
+	Priority *string `pulumi:"priority,optional"`
+}
+
+// Annotate provides metadata about the DNSRecordSetValue.
+func (value *DNSRecordSetValue) Annotate(a infer.Annotator) {
+	a.Describe(&value.Value, "The value/destination of the record")
+	a.Describe(&value.Priority, "The priority for MX and SRV records (required for these types, ignored for others)")
+}
+
+// DNSRecordSetState contains the state of a DNS record set resource.
+type DNSRecordSetState struct {
+	DNSRecordSetArgs
+	RecordIDs []string `pulumi:"recordIds"`
+	FQDN      string   `pulumi:"fqdn"`
+}
+
+// Annotate provides metadata about the DNSRecordSetState.
+func (state *DNSRecordSetState) Annotate(a infer.Annotator) {
+	a.Describe(&state.Domain, "The domain name for the DNS records")
+	a.Describe(&state.Name, "The hostname of the DNS records")
+	a.Describe(&state.Type, "The DNS record type")
+	a.Describe(&state.Values, "The values of the records")
+	a.Describe(&state.SSHFP, "The host keys the values of an SSHFP record set are derived from")
+	a.Describe(&state.RecordIDs, "The Netcup IDs of the records, in the order of values")
+	a.Describe(&state.FQDN, "The fully qualified domain name")
+}
+
+// Create writes the records of the set in a single update of the zone.
+func (r *DNSRecordSet) Create(
+	ctx context.Context,
+	req infer.CreateRequest[DNSRecordSetArgs],
+) (infer.CreateResponse[DNSRecordSetState], error) {
+	input := req.Inputs
+	id := createRecordSetID(input.Domain, input.Name, input.Type)
+
+	if req.DryRun {
+		state := DNSRecordSetState{
+			DNSRecordSetArgs: input,
+			FQDN:             buildFQDN(input.Name, input.Domain),
+		}
+		return infer.CreateResponse[DNSRecordSetState]{ID: id, Output: state}, nil
+	}
+
+	state, err := applyDNSRecordSet(ctx, input)
+	if err != nil {
+		return infer.CreateResponse[DNSRecordSetState]{}, fmt.Errorf("failed to create DNS record set: %w", err)
+	}
+
+	return infer.CreateResponse[DNSRecordSetState]{ID: id, Output: state}, nil
+}
+
+// Read reads the current records of the hostname and type.
+func (r *DNSRecordSet) Read(
+	ctx context.Context,
+	req infer.ReadRequest[DNSRecordSetArgs, DNSRecordSetState],
+) (infer.ReadResponse[DNSRecordSetArgs, DNSRecordSetState], error) {
+	domain, name, recordType, err := parseRecordSetID(req.ID)
+	if err != nil {
+		return infer.ReadResponse[DNSRecordSetArgs, DNSRecordSetState]{},
+			fmt.Errorf("invalid resource ID format: %w", err)
+	}
+
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.netcupClient()
+	if err != nil {
+		return infer.ReadResponse[DNSRecordSetArgs, DNSRecordSetState]{}, err
+	}
+
+	records, err := client.getAllDNSRecords(ctx, domain)
+	if err != nil {
+		if errors.Is(err, ErrDomainInaccessible) {
+			return infer.ReadResponse[DNSRecordSetArgs, DNSRecordSetState]{}, nil
+		}
+		return infer.ReadResponse[DNSRecordSetArgs, DNSRecordSetState]{},
+			fmt.Errorf("failed to read DNS record set %s: %w", req.ID, err)
+	}
+
+	records = slices.DeleteFunc(records, func(record *DNSRecordInfo) bool {
+		return !inRecordSet(name, recordType)(record)
+	})
+	if len(records) == 0 {
+		// Every record of the set is gone, so the set has to be recreated
+		return infer.ReadResponse[DNSRecordSetArgs, DNSRecordSetState]{}, nil
+	}
+
+	// Keep the order of the known records and append the ones added elsewhere
+	slices.SortStableFunc(records, func(a, b *DNSRecordInfo) int {
+		return recordIndex(req.State.RecordIDs, a.ID) - recordIndex(req.State.RecordIDs, b.ID)
+	})
+
+	state := DNSRecordSetState{
+		DNSRecordSetArgs: DNSRecordSetArgs{
+			Domain: domain,
+			Name:   name,
+			Type:   recordType,
+			Values: make([]DNSRecordSetValue, 0, len(records)),
+			// The keys cannot be derived from the records, drift shows as changed values
+			SSHFP: req.Inputs.SSHFP,
+		},
+		RecordIDs: make([]string, 0, len(records)),
+		FQDN:      buildFQDN(name, domain),
+	}
+	for _, record := range records {
+		zoneRecord := dnsZoneRecordFromInfo(record)
+		state.Values = append(state.Values, DNSRecordSetValue{Value: zoneRecord.Value, Priority: zoneRecord.Priority})
+		state.RecordIDs = append(state.RecordIDs, record.ID)
+	}
+
+	return infer.ReadResponse[DNSRecordSetArgs, DNSRecordSetState]{
+		ID:     req.ID,
+		Inputs: state.DNSRecordSetArgs,
+		State:  state,
+	}, nil
+}
+
+// Update applies the minimal change from the current to the desired values.
+func (r *DNSRecordSet) Update(
+	ctx context.Context,
+	req infer.UpdateRequest[DNSRecordSetArgs, DNSRecordSetState],
+) (infer.UpdateResponse[DNSRecordSetState], error) {
+	if req.DryRun {
+		state := DNSRecordSetState{
+			DNSRecordSetArgs: req.Inputs,
+			FQDN:             buildFQDN(req.Inputs.Name, req.Inputs.Domain),
+		}
+		return infer.UpdateResponse[DNSRecordSetState]{Output: state}, nil
+	}
+
+	state, err := applyDNSRecordSet(ctx, req.Inputs)
+	if err != nil {
+		return infer.UpdateResponse[DNSRecordSetState]{}, fmt.Errorf("failed to update DNS record set: %w", err)
+	}
+
+	return infer.UpdateResponse[DNSRecordSetState]{Output: state}, nil
+}
+
+// Diff computes the differences between the desired and current record set. The order of
+// values does not matter.
+func (r *DNSRecordSet) Diff(
+	ctx context.Context,
+	req infer.DiffRequest[DNSRecordSetArgs, DNSRecordSetState],
+) (infer.DiffResponse, error) {
+	detailedDiff := make(map[string]p.PropertyDiff)
+
+	replaced := map[string]bool{
+		"domain": req.Inputs.Domain != req.State.Domain,
+		"name":   req.Inputs.Name != req.State.Name,
+		"type":   req.Inputs.Type != req.State.Type,
+	}
+	for property, changed := range replaced {
+		if changed {
+			detailedDiff[property] = p.PropertyDiff{
+				Kind:      p.UpdateReplace,
+				InputDiff: true,
+			}
+		}
+	}
+	replace := len(detailedDiff) > 0
+
+	if !sameDNSRecordSet(req.Inputs.recordInfos(), req.State.recordInfos()) {
+		detailedDiff["values"] = p.PropertyDiff{
+			Kind:      p.Update,
+			InputDiff: true,
+		}
+	}
+
+	return infer.DiffResponse{
+		DeleteBeforeReplace: replace,
+		HasChanges:          len(detailedDiff) > 0,
+		DetailedDiff:        detailedDiff,
+	}, nil
+}
+
+// Delete deletes the records of the set in a single update of the zone.
+func (r *DNSRecordSet) Delete(
+	ctx context.Context,
+	req infer.DeleteRequest[DNSRecordSetState],
+) (infer.DeleteResponse, error) {
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.netcupClient()
+	if err != nil {
+		return infer.DeleteResponse{}, err
+	}
+
+	if err := client.DeleteDNSRecords(ctx, req.State.Domain, req.State.RecordIDs); err != nil {
+		return infer.DeleteResponse{}, fmt.Errorf("failed to delete DNS record set %s: %w", req.ID, err)
+	}
+
+	return infer.DeleteResponse{}, nil
+}
+
+// Check validates and normalizes the resource inputs.
+func (r *DNSRecordSet) Check(
+	ctx context.Context,
+	req infer.CheckRequest,
+) (infer.CheckResponse[DNSRecordSetArgs], error) {
+	args, failures, err := infer.DefaultCheck[DNSRecordSetArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[DNSRecordSetArgs]{
+			Inputs:   args,
+			Failures: failures,
+		}, err
+	}
+
+	base := normalizeInputs(DNSRecordArgs{Domain: args.Domain, Name: args.Name, Type: args.Type})
+	args.Domain, args.Name, args.Type = base.Domain, base.Name, base.Type
+
+	// Failures of the shared fields are reported once, failures of the values per value
+	isValueFailure := func(failure p.CheckFailure) bool {
+		return failure.Property == "value" || failure.Property == "priority"
+	}
+	for _, failure := range validateDNSRecordWithFailures(base) {
+		if !isValueFailure(failure) {
+			failures = append(failures, failure)
+		}
+	}
+
+	// Host keys may be outputs of other resources that are unknown during previews
+	if args.SSHFP != nil && !req.NewInputs.Get("sshfp").HasComputed() {
+		failures = append(failures, composeSSHFPRecordSet(&args)...)
+	}
+
+	if len(args.Values) == 0 && args.SSHFP == nil {
+		failures = append(failures, p.CheckFailure{
+			Property: "values",
+			Reason:   "At least one value is required",
+		})
+	}
+
+	for i, value := range args.Values {
+		normalized := base
+		normalized.Value = value.Value
+		normalized.Priority = value.Priority
+		normalized = normalizeInputs(normalized)
+		args.Values[i] = DNSRecordSetValue{Value: normalized.Value, Priority: normalized.Priority}
+
+		for _, failure := range validateDNSRecordWithFailures(normalized) {
+			if isValueFailure(failure) {
+				failure.Property = fmt.Sprintf("values[%d].%s", i, failure.Property)
+				failures = append(failures, failure)
+			}
+		}
+	}
+
+	return infer.CheckResponse[DNSRecordSetArgs]{
+		Inputs:   args,
+		Failures: failures,
+	}, nil
+}
+
+// applyDNSRecordSet makes the values the only records of the hostname and type
+func applyDNSRecordSet(ctx context.Context, args DNSRecordSetArgs) (DNSRecordSetState, error) {
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.netcupClient()
+	if err != nil {
+		return DNSRecordSetState{}, err
+	}
+
+	inSet := inRecordSet(args.Name, args.Type)
+	recordIDs, err := client.ReplaceDNSRecords(ctx, args.Domain, args.recordInfos(),
+		func(record *DNSRecordInfo) bool {
+			return !inSet(record)
+		})
+	if err != nil {
+		return DNSRecordSetState{}, err
+	}
+
+	return DNSRecordSetState{
+		DNSRecordSetArgs: args,
+		RecordIDs:        recordIDs,
+		FQDN:             buildFQDN(args.Name, args.Domain),
+	}, nil
+}
+
+// recordInfos returns the records making up the set
+func (args DNSRecordSetArgs) recordInfos() []*DNSRecordInfo {
+	records := make([]DNSZoneRecord, 0, len(args.Values))
+	for _, value := range args.Values {
+		records = append(records, DNSZoneRecord{
+			Name:     args.Name,
+			Type:     args.Type,
+			Value:    value.Value,
+			Priority: value.Priority,
+		})
+	}
+	return dnsZoneRecordInfos(records)
+}
+
+// inRecordSet returns a function reporting whether a record belongs to the hostname and type
+func inRecordSet(name, recordType string) func(record *DNSRecordInfo) bool {
+	return func(record *DNSRecordInfo) bool {
+		return strings.EqualFold(record.Hostname, name) && strings.EqualFold(record.Type, recordType)
+	}
+}
+
+// createRecordSetID creates an ID in the format "domain:name:type"
+func createRecordSetID(domain, name, recordType string) string {
+	return fmt.Sprintf("%s:%s:%s", domain, name, recordType)
+}
+
+// parseRecordSetID parses an ID in the format "domain:name:type"
+func parseRecordSetID(id string) (domain, name, recordType string, err error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("record set ID must be in format 'domain:name:type', got: %s", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
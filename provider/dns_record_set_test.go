@@ -0,0 +1,140 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+	integration "github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+
+	"github.com/blackdark/pulumi-netcup/provider/netcuptest"
+)
+
+func TestDnsRecordSetCheck(t *testing.T) {
+	t.Parallel()
+	value := func(value string) property.Value {
+		return property.New(map[string]property.Value{"value": property.New(value)})
+	}
+
+	response, err := (&DNSRecordSet{}).Check(t.Context(), infer.CheckRequest{
+		NewInputs: property.NewMap(map[string]property.Value{
+			"domain": property.New(" Example.COM "),
+			"name":   property.New("@"),
+			"type":   property.New("mx"),
+			"values": property.New([]property.Value{value(" mail.example.com "), value("")}),
+		}),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "example.com", response.Inputs.Domain)
+	assert.Equal(t, "MX", response.Inputs.Type)
+	assert.Equal(t, "mail.example.com", response.Inputs.Values[0].Value)
+
+	var properties []string
+	for _, failure := range response.Failures {
+		properties = append(properties, failure.Property)
+	}
+	assert.Equal(t, []string{"values[0].priority", "values[1].value", "values[1].priority"}, properties)
+}
+
+func TestRecordSetID(t *testing.T) {
+	t.Parallel()
+	id := createRecordSetID("example.com", "@", "TXT")
+	assert.Equal(t, "example.com:@:TXT", id)
+
+	domain, name, recordType, err := parseRecordSetID(id)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"example.com", "@", "TXT"}, []string{domain, name, recordType})
+
+	_, _, _, err = parseRecordSetID("example.com:123")
+	assert.Error(t, err)
+}
+
+func TestDnsRecordSetLifecycle(t *testing.T) {
+	t.Parallel()
+	server, netcup := newTestProviderServer(t)
+	otherID := netcup.AddRecord("example.com", netcuptest.Record{Hostname: "www", Type: "TXT", Destination: "other"})
+
+	values := func(values ...string) property.Value {
+		elements := make([]property.Value, 0, len(values))
+		for _, value := range values {
+			elements = append(elements, property.New(map[string]property.Value{"value": property.New(value)}))
+		}
+		return property.New(elements)
+	}
+	recordIDs := func(output property.Map) []string {
+		var ids []string
+		for _, id := range output.Get("recordIds").AsArray().AsSlice() {
+			ids = append(ids, id.AsString())
+		}
+		return ids
+	}
+	destinations := func() map[string]string {
+		byID := make(map[string]string)
+		for _, record := range netcup.Records("example.com") {
+			byID[record.ID] = record.Hostname + " " + record.Destination
+		}
+		return byID
+	}
+
+	var createdIDs []string
+	integration.LifeCycleTest{
+		Resource: "netcup:index:DNSRecordSet",
+		Create: integration.Operation{
+			Inputs: property.NewMap(map[string]property.Value{
+				"domain": property.New("example.com"),
+				"name":   property.New("@"),
+				"type":   property.New("TXT"),
+				"values": values("a", "b"),
+			}),
+			Hook: func(inputs, output property.Map) {
+				createdIDs = recordIDs(output)
+				require.Len(t, createdIDs, 2)
+				assert.Equal(t, map[string]string{
+					otherID:       "www other",
+					createdIDs[0]: "@ a",
+					createdIDs[1]: "@ b",
+				}, destinations())
+				assert.Equal(t, "example.com", output.Get("fqdn").AsString())
+			},
+		},
+		Updates: []integration.Operation{
+			{
+				Inputs: property.NewMap(map[string]property.Value{
+					"domain": property.New("example.com"),
+					"name":   property.New("@"),
+					"type":   property.New("TXT"),
+					"values": values("b", "c"),
+				}),
+				Hook: func(inputs, output property.Map) {
+					// "b" is kept and "a" is changed to "c" in place
+					assert.Equal(t, []string{createdIDs[1], createdIDs[0]}, recordIDs(output))
+					assert.Equal(t, map[string]string{
+						otherID:       "www other",
+						createdIDs[0]: "@ c",
+						createdIDs[1]: "@ b",
+					}, destinations())
+				},
+			},
+		},
+	}.Run(t, server)
+
+	assert.Equal(t, map[string]string{otherID: "www other"}, destinations())
+}
@@ -17,13 +17,15 @@ package provider
 import (
 	"testing"
 
-	"github.com/blang/semver"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	integration "github.com/pulumi/pulumi-go-provider/integration"
 	presource "github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/property"
+
+	"github.com/blackdark/pulumi-netcup/provider/netcuptest"
 )
 
 func TestDnsRecordValidation(t *testing.T) {
@@ -178,102 +180,147 @@ func TestBuildFQDN(t *testing.T) {
 }
 
 // TestDnsRecordLifecycle tests the complete CRUD lifecycle for DNS records
-// Note: This test requires valid Netcup credentials and will be skipped in CI
 func TestDnsRecordLifecycle(t *testing.T) {
 	t.Parallel()
-	t.Skip("Integration test requiring valid Netcup credentials - enable manually for testing")
-
-	server, err := integration.NewServer(t.Context(),
-		"netcup",
-		semver.Version{Minor: 1},
-		integration.WithProvider(Provider()),
-	)
-	require.NoError(t, err)
-
-	// Test configuration - replace with valid test credentials when running manually
-	// config := map[string]interface{}{
-	//	"apiKey":     "test-api-key",
-	//	"apiPassword": "test-api-password",
-	//	"customerId": "test-customer-id",
-	// }
+	server, netcup := newTestProviderServer(t)
+	netcup.AddDomain("example.com")
 
 	integration.LifeCycleTest{
-		Resource: "netcup:index:DnsRecord",
+		Resource: "netcup:index:DNSRecord",
 		Create: integration.Operation{
-			Inputs: presource.FromResourcePropertyMap(presource.NewPropertyMapFromMap(map[string]interface{}{
-				"domain": "test-domain.com",
-				"name":   "test",
-				"type":   "A",
-				"value":  "1.2.3.4",
-			})),
+			Inputs: property.NewMap(map[string]property.Value{
+				"domain": property.New("example.com"),
+				"name":   property.New("test"),
+				"type":   property.New("A"),
+				"value":  property.New("1.2.3.4"),
+			}),
 			Hook: func(inputs, output property.Map) {
-				t.Logf("Create Outputs: %v", output)
-
 				// Verify required fields are present
 				assert.NotEmpty(t, output.Get("recordId").AsString(), "recordId should be set")
-				assert.Equal(t, "test-domain.com", output.Get("domain").AsString())
+				assert.Equal(t, "example.com", output.Get("domain").AsString())
 				assert.Equal(t, "test", output.Get("name").AsString())
 				assert.Equal(t, "A", output.Get("type").AsString())
 				assert.Equal(t, "1.2.3.4", output.Get("value").AsString())
-				assert.Equal(t, "test.test-domain.com", output.Get("fqdn").AsString())
+				assert.Equal(t, "test.example.com", output.Get("fqdn").AsString())
+
+				records := netcup.Records("example.com")
+				require.Len(t, records, 1)
+				assert.Equal(t, output.Get("recordId").AsString(), records[0].ID)
 			},
 		},
 		Updates: []integration.Operation{
 			{
 				// Test updating the value
-				Inputs: presource.FromResourcePropertyMap(presource.NewPropertyMapFromMap(map[string]interface{}{
-					"domain": "test-domain.com",
-					"name":   "test",
-					"type":   "A",
-					"value":  "5.6.7.8", // Changed value
-				})),
+				Inputs: property.NewMap(map[string]property.Value{
+					"domain": property.New("example.com"),
+					"name":   property.New("test"),
+					"type":   property.New("A"),
+					"value":  property.New("5.6.7.8"),
+				}),
 				Hook: func(inputs, output property.Map) {
-					t.Logf("Update Outputs: %v", output)
-
 					// Verify the value was updated
 					assert.Equal(t, "5.6.7.8", output.Get("value").AsString())
 					assert.NotEmpty(t, output.Get("recordId").AsString(), "recordId should still be set")
+
+					records := netcup.Records("example.com")
+					require.Len(t, records, 1)
+					assert.Equal(t, "5.6.7.8", records[0].Destination)
 				},
 			},
 		},
 	}.Run(t, server)
+
+	assert.Empty(t, netcup.Records("example.com"))
 }
 
 // TestDnsRecordMXWithPriority tests MX record creation with priority
 func TestDnsRecordMXWithPriority(t *testing.T) {
 	t.Parallel()
-	t.Skip("Integration test requiring valid Netcup credentials - enable manually for testing")
-
-	server, err := integration.NewServer(t.Context(),
-		"netcup",
-		semver.Version{Minor: 1},
-		integration.WithProvider(Provider()),
-	)
-	require.NoError(t, err)
+	server, netcup := newTestProviderServer(t)
+	netcup.AddDomain("example.com")
 
 	integration.LifeCycleTest{
-		Resource: "netcup:index:DnsRecord",
+		Resource: "netcup:index:DNSRecord",
 		Create: integration.Operation{
-			Inputs: presource.FromResourcePropertyMap(presource.NewPropertyMapFromMap(map[string]interface{}{
-				"domain":   "test-domain.com",
-				"name":     "@",
-				"type":     "MX",
-				"value":    "mail.test-domain.com",
-				"priority": "10",
-			})),
+			Inputs: property.NewMap(map[string]property.Value{
+				"domain":   property.New("example.com"),
+				"name":     property.New("@"),
+				"type":     property.New("MX"),
+				"value":    property.New("mail.example.com"),
+				"priority": property.New("10"),
+			}),
 			Hook: func(inputs, output property.Map) {
-				t.Logf("MX Create Outputs: %v", output)
-
 				// Verify MX record fields
 				assert.Equal(t, "MX", output.Get("type").AsString())
-				assert.Equal(t, "mail.test-domain.com", output.Get("value").AsString())
+				assert.Equal(t, "mail.example.com", output.Get("value").AsString())
 				assert.Equal(t, "10", output.Get("priority").AsString())
-				assert.Equal(t, "test-domain.com", output.Get("fqdn").AsString()) // Root domain
+				assert.Equal(t, "example.com", output.Get("fqdn").AsString()) // Root domain
+
+				records := netcup.Records("example.com")
+				require.Len(t, records, 1)
+				assert.Equal(t, "10", records[0].Priority)
 			},
 		},
 	}.Run(t, server)
 }
 
+func TestParseNaturalKeyID(t *testing.T) {
+	t.Parallel()
+	key, hasValue, err := parseNaturalKeyID("Example.com/WWW/txt/v=spf1 a/b -all")
+	require.NoError(t, err)
+	assert.True(t, hasValue)
+	assert.Equal(t, DNSRecordArgs{Domain: "example.com", Name: "WWW", Type: "TXT", Value: "v=spf1 a/b -all"}, key)
+
+	key, hasValue, err = parseNaturalKeyID("example.com/@/MX")
+	require.NoError(t, err)
+	assert.False(t, hasValue)
+	assert.Equal(t, "MX", key.Type)
+
+	_, _, err = parseNaturalKeyID("example.com/www")
+	assert.Error(t, err)
+	assert.False(t, isNaturalKeyID("example.com:1001"))
+}
+
+func TestDnsRecordImportByNaturalKey(t *testing.T) {
+	t.Parallel()
+	server, netcup := newTestProviderServer(t)
+	wwwID := netcup.AddRecord("example.com", netcuptest.Record{Hostname: "www", Type: "AAAA", Destination: "2001:db8::1"})
+	netcup.AddRecord("example.com", netcuptest.Record{Hostname: "@", Type: "A", Destination: "1.2.3.4"})
+	netcup.AddRecord("example.com", netcuptest.Record{Hostname: "@", Type: "A", Destination: "1.2.3.5"})
+	caaID := netcup.AddRecord("example.com", netcuptest.Record{
+		Hostname: "@", Type: "CAA", Destination: "0 issue letsencrypt.org",
+	})
+
+	read := func(id string) (p.ReadResponse, error) {
+		return server.Read(p.ReadRequest{
+			ID:  id,
+			Urn: presource.NewURN("stack", "project", "", "netcup:index:DNSRecord", "record"),
+		})
+	}
+
+	response, err := read("example.com/www/AAAA")
+	require.NoError(t, err)
+	assert.Equal(t, "example.com:"+wwwID, response.ID)
+	assert.Equal(t, "2001:db8::1", response.Inputs.Get("value").AsString())
+
+	response, err = read("example.com/@/A/1.2.3.5")
+	require.NoError(t, err)
+	assert.Equal(t, "1.2.3.5", response.Properties.Get("value").AsString())
+
+	// CAA values match regardless of the quoting Netcup stores them with
+	response, err = read(`example.com/@/CAA/0 issue "letsencrypt.org"`)
+	require.NoError(t, err)
+	assert.Equal(t, "example.com:"+caaID, response.ID)
+
+	_, err = read("example.com/@/A")
+	assert.ErrorContains(t, err, "matches 2 DNS records")
+	assert.ErrorContains(t, err, "(@ A 1.2.3.4)")
+
+	_, err = read("example.com/www/A")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorContains(t, err, "(www AAAA 2001:db8::1)")
+}
+
 // stringPtr is a helper function to get a pointer to a string
 func stringPtr(s string) *string {
 	return &s
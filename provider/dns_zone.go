@@ -0,0 +1,460 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// Recommended lower bounds for zone settings, in seconds, following RFC 1912. Settings below
+// them are only warned about, since Netcup accepts them. Every value is capped at the largest
+// 32-bit signed integer as required by RFC 2181.
+const (
+	minZoneTTL     = 300
+	minZoneRefresh = 1200
+	minZoneRetry   = 180
+	minZoneExpire  = 604800
+	maxZoneValue   = math.MaxInt32
+)
+
+// DNSZone represents the settings of a DNS zone managed by Netcup DNS service.
+type DNSZone struct{}
+
+// Annotate provides metadata about the DNSZone resource.
+func (z *DNSZone) Annotate(a infer.Annotator) {
+	a.Describe(&z, "The settings of a DNS zone managed by Netcup DNS service. "+
+		"The zone of the domain must already exist; creating the resource adopts it and deleting the "+
+		"resource leaves the zone and its settings untouched")
+}
+
+// DNSZoneArgs contains the input arguments for a DNS zone resource.
+type DNSZoneArgs struct {
+	Domain  string `pulumi:"domain"`
+	TTL     *int   `pulumi:"ttl,optional"`
+	Refresh *int   `pulumi:"refresh,optional"`
+	Retry   *int   `pulumi:"retry,optional"`
+	Expire  *int   `pulumi:"expire,optional"`
+	DNSSEC  *bool  `pulumi:"dnssec,optional"`
+}
+
+// Annotate provides metadata about the DNSZoneArgs.
+func (args *DNSZoneArgs) Annotate(a infer.Annotator) {
+	a.Describe(&args.Domain, "The domain name of the zone (e.g., 'example.com')")
+	a.Describe(&args.TTL, fmt.Sprintf(
+		"The default TTL of the zone in seconds (RFC 1912 recommends at least %d). Left unchanged when not set",
+		minZoneTTL,
+	))
+	a.Describe(&args.Refresh, fmt.Sprintf(
+		"The SOA refresh interval in seconds (RFC 1912 recommends at least %d). Left unchanged when not set",
+		minZoneRefresh,
+	))
+	a.Describe(&args.Retry, fmt.Sprintf(
+		"The SOA retry interval in seconds (RFC 1912 recommends at least %d). Left unchanged when not set",
+		minZoneRetry,
+	))
+	a.Describe(&args.Expire, fmt.Sprintf(
+		"The SOA expire time in seconds (RFC 1912 recommends at least %d). Left unchanged when not set",
+		minZoneExpire,
+	))
+	a.Describe(&args.DNSSEC, "Whether the zone is signed with DNSSEC. Left unchanged when not set")
+}
+
+// DNSZoneState contains the state of a DNS zone resource.
+type DNSZoneState struct {
+	DNSZoneArgs
+	Serial    string   `pulumi:"serial"`
+	DNSKEYs   []string `pulumi:"dnskeys"`
+	DSRecords []string `pulumi:"dsRecords"`
+}
+
+// Annotate provides metadata about the DNSZoneState.
+func (state *DNSZoneState) Annotate(a infer.Annotator) {
+	a.Describe(&state.Domain, "The domain name of the zone")
+	a.Describe(&state.TTL, "The default TTL of the zone in seconds")
+	a.Describe(&state.Refresh, "The SOA refresh interval in seconds")
+	a.Describe(&state.Retry, "The SOA retry interval in seconds")
+	a.Describe(&state.Expire, "The SOA expire time in seconds")
+	a.Describe(&state.DNSSEC, "Whether the zone is signed with DNSSEC")
+	a.Describe(&state.Serial, "The current SOA serial of the zone")
+	a.Describe(&state.DNSKEYs, "The DNSKEY records of the signed zone in presentation format. "+
+		"Empty while DNSSEC is disabled or Netcup has not finished signing the zone")
+	a.Describe(&state.DSRecords, "The SHA-256 DS records of the key signing keys, "+
+		"to be published in the parent zone or at the registrar")
+}
+
+// Create adopts the existing zone of the domain and applies the configured settings.
+func (z *DNSZone) Create(
+	ctx context.Context,
+	req infer.CreateRequest[DNSZoneArgs],
+) (infer.CreateResponse[DNSZoneState], error) {
+	input := req.Inputs
+
+	if req.DryRun {
+		return infer.CreateResponse[DNSZoneState]{ID: input.Domain, Output: DNSZoneState{DNSZoneArgs: input}}, nil
+	}
+
+	if failures := validateDNSZoneWithFailures(input); len(failures) > 0 {
+		return infer.CreateResponse[DNSZoneState]{}, fmt.Errorf("validation failed: %v", failures)
+	}
+
+	state, err := applyDNSZone(ctx, input)
+	if err != nil {
+		return infer.CreateResponse[DNSZoneState]{}, fmt.Errorf("failed to adopt DNS zone: %w", err)
+	}
+
+	return infer.CreateResponse[DNSZoneState]{ID: input.Domain, Output: state}, nil
+}
+
+// Read reads the current settings of a DNS zone in Netcup.
+func (z *DNSZone) Read(
+	ctx context.Context,
+	req infer.ReadRequest[DNSZoneArgs, DNSZoneState],
+) (infer.ReadResponse[DNSZoneArgs, DNSZoneState], error) {
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.netcupClient()
+	if err != nil {
+		return infer.ReadResponse[DNSZoneArgs, DNSZoneState]{}, err
+	}
+
+	zone, err := client.GetDNSZone(ctx, req.ID)
+	if err != nil {
+		// The domain is gone from the account
+		if errors.Is(err, ErrDomainInaccessible) {
+			return infer.ReadResponse[DNSZoneArgs, DNSZoneState]{}, nil
+		}
+		return infer.ReadResponse[DNSZoneArgs, DNSZoneState]{},
+			fmt.Errorf("failed to read DNS zone %s: %w", req.ID, err)
+	}
+
+	state, err := dnsZoneState(req.ID, zone)
+	if err != nil {
+		return infer.ReadResponse[DNSZoneArgs, DNSZoneState]{}, err
+	}
+	state = withDNSSECKeys(ctx, client, state)
+
+	return infer.ReadResponse[DNSZoneArgs, DNSZoneState]{
+		ID:     req.ID,
+		Inputs: state.DNSZoneArgs,
+		State:  state,
+	}, nil
+}
+
+// Update applies changed settings to a DNS zone in Netcup.
+func (z *DNSZone) Update(
+	ctx context.Context,
+	req infer.UpdateRequest[DNSZoneArgs, DNSZoneState],
+) (infer.UpdateResponse[DNSZoneState], error) {
+	if req.DryRun {
+		return infer.UpdateResponse[DNSZoneState]{Output: mergeDNSZoneState(req.State, req.Inputs)}, nil
+	}
+
+	if failures := validateDNSZoneWithFailures(req.Inputs); len(failures) > 0 {
+		return infer.UpdateResponse[DNSZoneState]{}, fmt.Errorf("validation failed: %v", failures)
+	}
+
+	state, err := applyDNSZone(ctx, req.Inputs)
+	if err != nil {
+		return infer.UpdateResponse[DNSZoneState]{}, fmt.Errorf("failed to update DNS zone: %w", err)
+	}
+
+	return infer.UpdateResponse[DNSZoneState]{Output: state}, nil
+}
+
+// Diff computes the differences between the desired and current settings of a DNS zone.
+// Settings that are not configured are not managed and never cause a diff. The DNSSEC keys
+// are outputs only, so a zone that is still being signed does not show up as changed.
+func (z *DNSZone) Diff(
+	ctx context.Context,
+	req infer.DiffRequest[DNSZoneArgs, DNSZoneState],
+) (infer.DiffResponse, error) {
+	detailedDiff := make(map[string]p.PropertyDiff)
+
+	if req.Inputs.Domain != req.State.Domain {
+		detailedDiff["domain"] = p.PropertyDiff{
+			Kind:      p.UpdateReplace,
+			InputDiff: true,
+		}
+	}
+
+	settings := []struct {
+		name         string
+		input, state *int
+	}{
+		{"ttl", req.Inputs.TTL, req.State.TTL},
+		{"refresh", req.Inputs.Refresh, req.State.Refresh},
+		{"retry", req.Inputs.Retry, req.State.Retry},
+		{"expire", req.Inputs.Expire, req.State.Expire},
+	}
+	for _, setting := range settings {
+		if setting.input != nil && (setting.state == nil || *setting.input != *setting.state) {
+			detailedDiff[setting.name] = p.PropertyDiff{
+				Kind:      p.Update,
+				InputDiff: true,
+			}
+		}
+	}
+
+	if req.Inputs.DNSSEC != nil && (req.State.DNSSEC == nil || *req.Inputs.DNSSEC != *req.State.DNSSEC) {
+		detailedDiff["dnssec"] = p.PropertyDiff{
+			Kind:      p.Update,
+			InputDiff: true,
+		}
+	}
+
+	return infer.DiffResponse{
+		HasChanges:   len(detailedDiff) > 0,
+		DetailedDiff: detailedDiff,
+	}, nil
+}
+
+// Delete removes the zone from the stack. The zone itself cannot be deleted through the API
+// and keeps its current settings.
+func (z *DNSZone) Delete(_ context.Context, _ infer.DeleteRequest[DNSZoneState]) (infer.DeleteResponse, error) {
+	return infer.DeleteResponse{}, nil
+}
+
+// Check validates and normalizes the resource inputs.
+func (z *DNSZone) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[DNSZoneArgs], error) {
+	args, failures, err := infer.DefaultCheck[DNSZoneArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[DNSZoneArgs]{
+			Inputs:   args,
+			Failures: failures,
+		}, err
+	}
+
+	args.Domain = strings.ToLower(strings.TrimSpace(args.Domain))
+	failures = append(failures, validateDNSZoneWithFailures(args)...)
+	for _, warning := range dnsZoneWarnings(args) {
+		p.GetLogger(ctx).Warningf("%s: %s", warning.Property, warning.Reason)
+	}
+
+	return infer.CheckResponse[DNSZoneArgs]{
+		Inputs:   args,
+		Failures: failures,
+	}, nil
+}
+
+// applyDNSZone reads the current zone settings, merges the configured ones into them and
+// writes the zone if anything differs
+func applyDNSZone(ctx context.Context, args DNSZoneArgs) (DNSZoneState, error) {
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.netcupClient()
+	if err != nil {
+		return DNSZoneState{}, err
+	}
+
+	zone, err := client.GetDNSZone(ctx, args.Domain)
+	if err != nil {
+		return DNSZoneState{}, err
+	}
+
+	current, err := dnsZoneState(args.Domain, zone)
+	if err != nil {
+		return DNSZoneState{}, err
+	}
+
+	desired := mergeDNSZoneState(current, args)
+	if *desired.TTL == *current.TTL && *desired.Refresh == *current.Refresh &&
+		*desired.Retry == *current.Retry && *desired.Expire == *current.Expire &&
+		*desired.DNSSEC == *current.DNSSEC {
+		return withDNSSECKeys(ctx, client, current), nil
+	}
+
+	zone.TTL = strconv.Itoa(*desired.TTL)
+	zone.Refresh = strconv.Itoa(*desired.Refresh)
+	zone.Retry = strconv.Itoa(*desired.Retry)
+	zone.Expire = strconv.Itoa(*desired.Expire)
+	zone.DNSSECStatus = *desired.DNSSEC
+
+	updated, err := client.UpdateDNSZone(ctx, args.Domain, zone)
+	if err != nil {
+		return DNSZoneState{}, err
+	}
+
+	state, err := dnsZoneState(args.Domain, updated)
+	if err != nil {
+		return DNSZoneState{}, err
+	}
+	return withDNSSECKeys(ctx, client, state), nil
+}
+
+// withDNSSECKeys adds the published DNSKEY and DS records of a signed zone to its state.
+// Keys that cannot be looked up are left empty and picked up by a later refresh, since
+// Netcup signs zones asynchronously and the nameserver may not be reachable from everywhere.
+func withDNSSECKeys(ctx context.Context, client *NetcupClient, state DNSZoneState) DNSZoneState {
+	state.DNSKEYs = []string{}
+	state.DSRecords = []string{}
+	if state.DNSSEC == nil || !*state.DNSSEC {
+		return state
+	}
+
+	keys, err := client.GetDNSKEYs(ctx, state.Domain)
+	if err != nil {
+		p.GetLogger(ctx).Warningf("Unable to look up DNSKEY records of %s: %v", state.Domain, err)
+		return state
+	}
+
+	for _, key := range keys {
+		state.DNSKEYs = append(state.DNSKEYs, key.String())
+		if !key.IsKSK() {
+			continue
+		}
+		ds, err := key.DSRecord(state.Domain)
+		if err != nil {
+			p.GetLogger(ctx).Warningf("Unable to compute DS record of %s: %v", state.Domain, err)
+			continue
+		}
+		state.DSRecords = append(state.DSRecords, ds)
+	}
+	return state
+}
+
+// mergeDNSZoneState overlays the configured settings onto the state of a zone
+func mergeDNSZoneState(state DNSZoneState, args DNSZoneArgs) DNSZoneState {
+	return DNSZoneState{
+		DNSZoneArgs: DNSZoneArgs{
+			Domain:  args.Domain,
+			TTL:     mergeSetting(args.TTL, state.TTL),
+			Refresh: mergeSetting(args.Refresh, state.Refresh),
+			Retry:   mergeSetting(args.Retry, state.Retry),
+			Expire:  mergeSetting(args.Expire, state.Expire),
+			DNSSEC:  mergeSetting(args.DNSSEC, state.DNSSEC),
+		},
+		Serial:    state.Serial,
+		DNSKEYs:   state.DNSKEYs,
+		DSRecords: state.DSRecords,
+	}
+}
+
+// mergeSetting returns the configured value of a setting, or the current one if it is not configured
+func mergeSetting[T any](input, current *T) *T {
+	if input != nil {
+		return input
+	}
+	return current
+}
+
+// dnsZoneState converts zone settings returned by the API into resource state
+func dnsZoneState(domain string, zone *DNSZoneInfo) (DNSZoneState, error) {
+	values := make([]*int, 0, 4)
+	for _, setting := range []struct{ name, value string }{
+		{"ttl", zone.TTL},
+		{"refresh", zone.Refresh},
+		{"retry", zone.Retry},
+		{"expire", zone.Expire},
+	} {
+		value, err := strconv.Atoi(setting.value)
+		if err != nil {
+			return DNSZoneState{}, fmt.Errorf("invalid %s %q in DNS zone %s: %w", setting.name, setting.value, domain, err)
+		}
+		values = append(values, &value)
+	}
+
+	return DNSZoneState{
+		DNSZoneArgs: DNSZoneArgs{
+			Domain:  domain,
+			TTL:     values[0],
+			Refresh: values[1],
+			Retry:   values[2],
+			Expire:  values[3],
+			DNSSEC:  &zone.DNSSECStatus,
+		},
+		Serial: zone.Serial,
+	}, nil
+}
+
+func validateDNSZoneWithFailures(args DNSZoneArgs) []p.CheckFailure {
+	var failures []p.CheckFailure
+
+	if args.Domain == "" {
+		failures = append(failures, p.CheckFailure{
+			Property: "domain",
+			Reason:   "Domain is required",
+		})
+	} else if !isValidDomain(args.Domain) {
+		failures = append(failures, p.CheckFailure{
+			Property: "domain",
+			Reason:   "Domain format is invalid",
+		})
+	}
+
+	for _, setting := range []struct {
+		name  string
+		value *int
+	}{
+		{"ttl", args.TTL},
+		{"refresh", args.Refresh},
+		{"retry", args.Retry},
+		{"expire", args.Expire},
+	} {
+		if setting.value != nil && (*setting.value < 0 || *setting.value > maxZoneValue) {
+			failures = append(failures, p.CheckFailure{
+				Property: setting.name,
+				Reason:   fmt.Sprintf("%s must be between 0 and %d seconds", setting.name, maxZoneValue),
+			})
+		}
+	}
+
+	return failures
+}
+
+// dnsZoneWarnings reports settings that Netcup accepts but that deviate from the
+// recommendations of RFC 1912
+func dnsZoneWarnings(args DNSZoneArgs) []p.CheckFailure {
+	var warnings []p.CheckFailure
+
+	for _, setting := range []struct {
+		name  string
+		value *int
+		min   int
+	}{
+		{"ttl", args.TTL, minZoneTTL},
+		{"refresh", args.Refresh, minZoneRefresh},
+		{"retry", args.Retry, minZoneRetry},
+		{"expire", args.Expire, minZoneExpire},
+	} {
+		if setting.value != nil && *setting.value < setting.min {
+			warnings = append(warnings, p.CheckFailure{
+				Property: setting.name,
+				Reason:   fmt.Sprintf("%s should be at least %d seconds", setting.name, setting.min),
+			})
+		}
+	}
+
+	if args.Refresh != nil && args.Retry != nil && *args.Retry >= *args.Refresh {
+		warnings = append(warnings, p.CheckFailure{
+			Property: "retry",
+			Reason:   "retry should be shorter than refresh",
+		})
+	}
+
+	if args.Refresh != nil && args.Expire != nil && *args.Expire <= *args.Refresh {
+		warnings = append(warnings, p.CheckFailure{
+			Property: "expire",
+			Reason:   "expire should be longer than refresh",
+		})
+	}
+
+	return warnings
+}
@@ -0,0 +1,410 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// DNSZoneRecords represents the complete record set of a domain managed by Netcup DNS service.
+type DNSZoneRecords struct{}
+
+// Annotate provides metadata about the DNSZoneRecords resource.
+func (r *DNSZoneRecords) Annotate(a infer.Annotator) {
+	a.Describe(&r, "The complete record set of a domain managed by Netcup DNS service. "+
+		"Records of the domain that are not listed are deleted unless they match an ignore rule. "+
+		"Do not combine with DNSRecord resources for the same domain")
+}
+
+// DNSZoneRecordsArgs contains the input arguments for a DNS zone records resource.
+type DNSZoneRecordsArgs struct {
+	Domain   string            `pulumi:"domain"`
+	Records  []DNSZoneRecord   `pulumi:"records,optional"`
+	ZoneFile *string           `pulumi:"zoneFile,optional"`
+	Ignore   []DNSRecordFilter `pulumi:"ignore,optional"`
+}
+
+// Annotate provides metadata about the DNSZoneRecordsArgs.
+func (args *DNSZoneRecordsArgs) Annotate(a infer.Annotator) {
+	a.Describe(&args.Domain, "The domain name whose records are managed (e.g., 'example.com')")
+	a.Describe(&args.Records, "The complete list of records the domain should have")
+	a.Describe(&args.ZoneFile, "An RFC 1035 zone file (BIND format) to take the records from instead of records. "+
+		"SOA and apex NS records are skipped and record TTLs are ignored")
+	a.Describe(&args.Ignore, "Existing records matching any of these rules are left alone instead of being deleted")
+}
+
+// DNSZoneRecord is a single record of a managed record set.
+type DNSZoneRecord struct {
+	Name     string  `pulumi:"name"`
+	Type     string  `pulumi:"type"`
+	Value    string  `pulumi:"value"`
+	Priority *string `pulumi:"priority,optional"`
+}
+
+// Annotate provides metadata about the DNSZoneRecord.
+func (record *DNSZoneRecord) Annotate(a infer.Annotator) {
+	a.Describe(&record.Name, "The hostname of the record. Use '@' for the root domain")
+	a.Describe(&record.Type, "The DNS record type")
+	a.Describe(&record.Value, "The value/destination of the record")
+	a.Describe(&record.Priority, "The priority for MX and SRV records")
+}
+
+// DNSRecordFilter selects existing records by hostname and/or type.
+type DNSRecordFilter struct {
+	Name *string `pulumi:"name,optional"`
+	Type *string `pulumi:"type,optional"`
+}
+
+// Annotate provides metadata about the DNSRecordFilter.
+func (filter *DNSRecordFilter) Annotate(a infer.Annotator) {
+	a.Describe(&filter.Name, "The hostname to match. Matches every hostname when not set")
+	a.Describe(&filter.Type, "The record type to match. Matches every type when not set")
+}
+
+// DNSZoneRecordsState contains the state of a DNS zone records resource.
+type DNSZoneRecordsState struct {
+	DNSZoneRecordsArgs
+	RecordIDs []string `pulumi:"recordIds"`
+}
+
+// Annotate provides metadata about the DNSZoneRecordsState.
+func (state *DNSZoneRecordsState) Annotate(a infer.Annotator) {
+	a.Describe(&state.Domain, "The domain name whose records are managed")
+	a.Describe(&state.Records, "The records of the domain")
+	a.Describe(&state.ZoneFile, "The zone file the records were taken from")
+	a.Describe(&state.Ignore, "The rules selecting records that are left alone")
+	a.Describe(&state.RecordIDs, "The Netcup IDs of the records, in the order of records")
+}
+
+// Create makes the configured records the record set of the domain.
+func (r *DNSZoneRecords) Create(
+	ctx context.Context,
+	req infer.CreateRequest[DNSZoneRecordsArgs],
+) (infer.CreateResponse[DNSZoneRecordsState], error) {
+	input := req.Inputs
+
+	if req.DryRun {
+		return infer.CreateResponse[DNSZoneRecordsState]{
+			ID:     input.Domain,
+			Output: DNSZoneRecordsState{DNSZoneRecordsArgs: input},
+		}, nil
+	}
+
+	state, err := applyDNSZoneRecords(ctx, input)
+	if err != nil {
+		return infer.CreateResponse[DNSZoneRecordsState]{}, err
+	}
+
+	return infer.CreateResponse[DNSZoneRecordsState]{ID: input.Domain, Output: state}, nil
+}
+
+// Read reads the current record set of the domain. Records that are not ignored but missing
+// from the state are reported as inputs, so they show up as drift.
+func (r *DNSZoneRecords) Read(
+	ctx context.Context,
+	req infer.ReadRequest[DNSZoneRecordsArgs, DNSZoneRecordsState],
+) (infer.ReadResponse[DNSZoneRecordsArgs, DNSZoneRecordsState], error) {
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.netcupClient()
+	if err != nil {
+		return infer.ReadResponse[DNSZoneRecordsArgs, DNSZoneRecordsState]{}, err
+	}
+
+	records, err := client.getAllDNSRecords(ctx, req.ID)
+	if err != nil {
+		if errors.Is(err, ErrDomainInaccessible) {
+			return infer.ReadResponse[DNSZoneRecordsArgs, DNSZoneRecordsState]{}, nil
+		}
+		return infer.ReadResponse[DNSZoneRecordsArgs, DNSZoneRecordsState]{},
+			fmt.Errorf("failed to read DNS records of %s: %w", req.ID, err)
+	}
+
+	// Keep the order of the known records and append the ones added elsewhere
+	slices.SortStableFunc(records, func(a, b *DNSRecordInfo) int {
+		return recordIndex(req.State.RecordIDs, a.ID) - recordIndex(req.State.RecordIDs, b.ID)
+	})
+
+	// The zone file is kept, so refreshing a zone file managed record set shows no diff
+	state := DNSZoneRecordsState{
+		DNSZoneRecordsArgs: DNSZoneRecordsArgs{
+			Domain:   req.ID,
+			Records:  []DNSZoneRecord{},
+			ZoneFile: req.Inputs.ZoneFile,
+			Ignore:   req.State.Ignore,
+		},
+		RecordIDs: []string{},
+	}
+	for _, record := range records {
+		if !slices.Contains(req.State.RecordIDs, record.ID) && ignoreDNSRecord(req.State.Ignore, record) {
+			continue
+		}
+		state.Records = append(state.Records, dnsZoneRecordFromInfo(record))
+		state.RecordIDs = append(state.RecordIDs, record.ID)
+	}
+
+	return infer.ReadResponse[DNSZoneRecordsArgs, DNSZoneRecordsState]{
+		ID:     req.ID,
+		Inputs: state.DNSZoneRecordsArgs,
+		State:  state,
+	}, nil
+}
+
+// Update makes the changed records the record set of the domain.
+func (r *DNSZoneRecords) Update(
+	ctx context.Context,
+	req infer.UpdateRequest[DNSZoneRecordsArgs, DNSZoneRecordsState],
+) (infer.UpdateResponse[DNSZoneRecordsState], error) {
+	if req.DryRun {
+		return infer.UpdateResponse[DNSZoneRecordsState]{
+			Output: DNSZoneRecordsState{DNSZoneRecordsArgs: req.Inputs},
+		}, nil
+	}
+
+	state, err := applyDNSZoneRecords(ctx, req.Inputs)
+	if err != nil {
+		return infer.UpdateResponse[DNSZoneRecordsState]{}, err
+	}
+
+	return infer.UpdateResponse[DNSZoneRecordsState]{Output: state}, nil
+}
+
+// Diff computes the differences between the desired and current record set. The order of
+// records does not matter.
+func (r *DNSZoneRecords) Diff(
+	ctx context.Context,
+	req infer.DiffRequest[DNSZoneRecordsArgs, DNSZoneRecordsState],
+) (infer.DiffResponse, error) {
+	detailedDiff := make(map[string]p.PropertyDiff)
+	replace := req.Inputs.Domain != req.State.Domain
+
+	if replace {
+		detailedDiff["domain"] = p.PropertyDiff{
+			Kind:      p.UpdateReplace,
+			InputDiff: true,
+		}
+	}
+
+	if !sameDNSRecordSet(dnsZoneRecordInfos(req.Inputs.Records), dnsZoneRecordInfos(req.State.Records)) {
+		detailedDiff["records"] = p.PropertyDiff{
+			Kind:      p.Update,
+			InputDiff: true,
+		}
+	}
+
+	if !slices.EqualFunc(req.Inputs.Ignore, req.State.Ignore, sameDNSRecordFilter) {
+		detailedDiff["ignore"] = p.PropertyDiff{
+			Kind:      p.Update,
+			InputDiff: true,
+		}
+	}
+
+	return infer.DiffResponse{
+		DeleteBeforeReplace: replace,
+		HasChanges:          len(detailedDiff) > 0,
+		DetailedDiff:        detailedDiff,
+	}, nil
+}
+
+// Delete deletes the managed records from the domain. Ignored records stay in place.
+func (r *DNSZoneRecords) Delete(
+	ctx context.Context,
+	req infer.DeleteRequest[DNSZoneRecordsState],
+) (infer.DeleteResponse, error) {
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.netcupClient()
+	if err != nil {
+		return infer.DeleteResponse{}, err
+	}
+
+	if err := client.DeleteDNSRecords(ctx, req.ID, req.State.RecordIDs); err != nil {
+		return infer.DeleteResponse{}, err
+	}
+
+	return infer.DeleteResponse{}, nil
+}
+
+// Check validates and normalizes the resource inputs.
+func (r *DNSZoneRecords) Check(
+	ctx context.Context,
+	req infer.CheckRequest,
+) (infer.CheckResponse[DNSZoneRecordsArgs], error) {
+	args, failures, err := infer.DefaultCheck[DNSZoneRecordsArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[DNSZoneRecordsArgs]{
+			Inputs:   args,
+			Failures: failures,
+		}, err
+	}
+
+	args.Domain = strings.ToLower(strings.TrimSpace(args.Domain))
+	fromZoneFile := false
+	if args.ZoneFile != nil {
+		if len(args.Records) > 0 {
+			failures = append(failures, p.CheckFailure{
+				Property: "zoneFile",
+				Reason:   "Only one of records and zoneFile can be set",
+			})
+		} else {
+			records, zoneErrors := parseZoneFile(*args.ZoneFile, args.Domain)
+			for _, err := range zoneErrors {
+				failures = append(failures, p.CheckFailure{Property: "zoneFile", Reason: err.Error()})
+			}
+			args.Records = records
+			fromZoneFile = true
+		}
+	}
+	for i, record := range args.Records {
+		normalized := normalizeInputs(DNSRecordArgs{
+			Domain:   args.Domain,
+			Name:     record.Name,
+			Type:     record.Type,
+			Value:    record.Value,
+			Priority: record.Priority,
+		})
+		args.Records[i] = DNSZoneRecord{
+			Name:     normalized.Name,
+			Type:     normalized.Type,
+			Value:    normalized.Value,
+			Priority: normalized.Priority,
+		}
+
+		for _, failure := range validateDNSRecordWithFailures(normalized) {
+			switch {
+			case failure.Property == "domain":
+			case fromZoneFile:
+				failure.Reason = fmt.Sprintf("%s record %s: %s", normalized.Type, normalized.Name, failure.Reason)
+				failure.Property = "zoneFile"
+			default:
+				failure.Property = fmt.Sprintf("records[%d].%s", i, failure.Property)
+			}
+			if !slices.Contains(failures, failure) {
+				failures = append(failures, failure)
+			}
+		}
+	}
+
+	return infer.CheckResponse[DNSZoneRecordsArgs]{
+		Inputs:   args,
+		Failures: failures,
+	}, nil
+}
+
+// applyDNSZoneRecords writes the record set and returns the resulting state
+func applyDNSZoneRecords(ctx context.Context, args DNSZoneRecordsArgs) (DNSZoneRecordsState, error) {
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.netcupClient()
+	if err != nil {
+		return DNSZoneRecordsState{}, err
+	}
+
+	recordIDs, err := client.ReplaceDNSRecords(ctx, args.Domain, dnsZoneRecordInfos(args.Records),
+		func(record *DNSRecordInfo) bool {
+			return ignoreDNSRecord(args.Ignore, record)
+		})
+	if err != nil {
+		return DNSZoneRecordsState{}, err
+	}
+
+	return DNSZoneRecordsState{
+		DNSZoneRecordsArgs: args,
+		RecordIDs:          recordIDs,
+	}, nil
+}
+
+// ignoreDNSRecord reports whether a record matches any of the filters
+func ignoreDNSRecord(filters []DNSRecordFilter, record *DNSRecordInfo) bool {
+	for _, filter := range filters {
+		if filter.Name != nil && !strings.EqualFold(*filter.Name, record.Hostname) {
+			continue
+		}
+		if filter.Type != nil && !strings.EqualFold(*filter.Type, record.Type) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func sameDNSRecordFilter(a, b DNSRecordFilter) bool {
+	equal := func(x, y *string) bool {
+		return (x == nil && y == nil) || (x != nil && y != nil && strings.EqualFold(*x, *y))
+	}
+	return equal(a.Name, b.Name) && equal(a.Type, b.Type)
+}
+
+// sameDNSRecordSet reports whether two record sets have the same content regardless of order
+func sameDNSRecordSet(a, b []*DNSRecordInfo) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	used := make([]bool, len(b))
+	for _, record := range a {
+		i := slices.IndexFunc(b, func(other *DNSRecordInfo) bool { return sameDNSRecord(record, other) })
+		for i >= 0 && used[i] {
+			next := slices.IndexFunc(b[i+1:], func(other *DNSRecordInfo) bool { return sameDNSRecord(record, other) })
+			if next < 0 {
+				return false
+			}
+			i += next + 1
+		}
+		if i < 0 {
+			return false
+		}
+		used[i] = true
+	}
+	return true
+}
+
+func dnsZoneRecordInfos(records []DNSZoneRecord) []*DNSRecordInfo {
+	infos := make([]*DNSRecordInfo, 0, len(records))
+	for _, record := range records {
+		var priority string
+		if record.Priority != nil {
+			priority = *record.Priority
+		}
+		infos = append(infos, &DNSRecordInfo{
+			Hostname:    record.Name,
+			Type:        record.Type,
+			Destination: record.Value,
+			Priority:    priority,
+		})
+	}
+	return infos
+}
+
+func dnsZoneRecordFromInfo(record *DNSRecordInfo) DNSZoneRecord {
+	return DNSZoneRecord{
+		Name:     record.Hostname,
+		Type:     record.Type,
+		Value:    record.Destination,
+		Priority: recordPriority(record),
+	}
+}
+
+// recordIndex returns the position of a record ID, sorting unknown IDs last
+func recordIndex(recordIDs []string, recordID string) int {
+	if i := slices.Index(recordIDs, recordID); i >= 0 {
+		return i
+	}
+	return len(recordIDs)
+}
@@ -0,0 +1,186 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	integration "github.com/pulumi/pulumi-go-provider/integration"
+	presource "github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+
+	"github.com/blackdark/pulumi-netcup/provider/netcuptest"
+)
+
+func TestPlanRecordSet(t *testing.T) {
+	t.Parallel()
+	existing := []*DNSRecordInfo{
+		{ID: "1", Hostname: "@", Type: "A", Destination: "1.2.3.4", Priority: "0"},
+		{ID: "2", Hostname: "www", Type: "A", Destination: "1.2.3.4", Priority: "0"},
+		{ID: "3", Hostname: "old", Type: "CNAME", Destination: "www.example.com", Priority: "0"},
+		{ID: "4", Hostname: "@", Type: "TXT", Destination: "verification", Priority: "0"},
+	}
+	desired := []*DNSRecordInfo{
+		{Hostname: "www", Type: "A", Destination: "5.6.7.8"},
+		{Hostname: "@", Type: "A", Destination: "1.2.3.4"},
+		{Hostname: "@", Type: "MX", Destination: "mail.example.com", Priority: "10"},
+	}
+
+	plan := planRecordSet(existing, desired, func(record *DNSRecordInfo) bool {
+		return record.Type == "TXT"
+	})
+
+	assert.Equal(t, []string{"2", "1", ""}, plan.ids)
+	assert.Equal(t, map[int]*DNSRecordInfo{2: desired[2]}, plan.created)
+	require.Len(t, plan.records, 5)
+	assert.Equal(t, "5.6.7.8", plan.records[1].Destination)
+	assert.False(t, plan.records[1].DeleteRecord)
+	assert.True(t, plan.records[2].DeleteRecord, "unmanaged record is deleted")
+	assert.False(t, plan.records[3].DeleteRecord, "ignored record is kept")
+	assert.Equal(t, "mail.example.com", plan.records[4].Destination)
+
+	unchanged := planRecordSet(
+		[]*DNSRecordInfo{{ID: "1", Hostname: "@", Type: "A", Destination: "1.2.3.4", Priority: "0"}},
+		[]*DNSRecordInfo{{Hostname: "@", Type: "A", Destination: "1.2.3.4"}},
+		func(*DNSRecordInfo) bool { return false },
+	)
+	assert.Nil(t, unchanged.records)
+	assert.Equal(t, []string{"1"}, unchanged.ids)
+
+	// Netcup stores CAA values without quotes around the value
+	requoted := planRecordSet(
+		[]*DNSRecordInfo{{ID: "1", Hostname: "@", Type: "CAA", Destination: "0 issue letsencrypt.org", Priority: "0"}},
+		[]*DNSRecordInfo{{Hostname: "@", Type: "CAA", Destination: `0 issue "letsencrypt.org"`}},
+		func(*DNSRecordInfo) bool { return false },
+	)
+	assert.Nil(t, requoted.records)
+	assert.Equal(t, []string{"1"}, requoted.ids)
+}
+
+func TestSameDNSRecordSet(t *testing.T) {
+	t.Parallel()
+	a := &DNSRecordInfo{Hostname: "@", Type: "A", Destination: "1.2.3.4"}
+	b := &DNSRecordInfo{Hostname: "www", Type: "A", Destination: "1.2.3.4", Priority: "0"}
+
+	assert.True(t, sameDNSRecordSet([]*DNSRecordInfo{a, b}, []*DNSRecordInfo{b, a}))
+	assert.False(t, sameDNSRecordSet([]*DNSRecordInfo{a, a}, []*DNSRecordInfo{a, b}))
+	assert.False(t, sameDNSRecordSet([]*DNSRecordInfo{a}, []*DNSRecordInfo{a, b}))
+}
+
+func TestDnsZoneRecordFromInfo(t *testing.T) {
+	t.Parallel()
+	mx := dnsZoneRecordFromInfo(&DNSRecordInfo{Hostname: "@", Type: "MX", Destination: "mail.example.com", Priority: "0"})
+	require.NotNil(t, mx.Priority)
+	assert.Equal(t, "0", *mx.Priority)
+
+	a := dnsZoneRecordFromInfo(&DNSRecordInfo{Hostname: "www", Type: "A", Destination: "1.2.3.4", Priority: "0"})
+	assert.Nil(t, a.Priority)
+}
+
+func TestDnsZoneRecordsLifecycle(t *testing.T) {
+	t.Parallel()
+	server, netcup := newTestProviderServer(t)
+	netcup.AddRecord("example.com", netcuptest.Record{Hostname: "www", Type: "A", Destination: "1.2.3.4"})
+	netcup.AddRecord("example.com", netcuptest.Record{Hostname: "stale", Type: "A", Destination: "1.2.3.4"})
+	netcup.AddRecord("example.com", netcuptest.Record{Hostname: "@", Type: "TXT", Destination: "verification"})
+
+	record := func(name, typ, value string) property.Value {
+		return property.New(map[string]property.Value{
+			"name":  property.New(name),
+			"type":  property.New(typ),
+			"value": property.New(value),
+		})
+	}
+	ignoreTXT := property.New([]property.Value{
+		property.New(map[string]property.Value{"type": property.New("TXT")}),
+	})
+
+	hostnames := func() []string {
+		var names []string
+		for _, record := range netcup.Records("example.com") {
+			names = append(names, record.Hostname+" "+record.Type+" "+record.Destination)
+		}
+		return names
+	}
+
+	integration.LifeCycleTest{
+		Resource: "netcup:index:DNSZoneRecords",
+		Create: integration.Operation{
+			Inputs: property.NewMap(map[string]property.Value{
+				"domain": property.New("example.com"),
+				"records": property.New([]property.Value{
+					record("www", "A", "1.2.3.4"),
+					record("@", "A", "5.6.7.8"),
+				}),
+				"ignore": ignoreTXT,
+			}),
+			Hook: func(inputs, output property.Map) {
+				assert.ElementsMatch(t, []string{
+					"www A 1.2.3.4",
+					"@ TXT verification",
+					"@ A 5.6.7.8",
+				}, hostnames())
+				assert.Len(t, output.Get("recordIds").AsArray().AsSlice(), 2)
+			},
+		},
+		Updates: []integration.Operation{
+			{
+				Inputs: property.NewMap(map[string]property.Value{
+					"domain": property.New("example.com"),
+					"records": property.New([]property.Value{
+						record("www", "A", "9.9.9.9"),
+					}),
+					"ignore": ignoreTXT,
+				}),
+				Hook: func(inputs, output property.Map) {
+					assert.ElementsMatch(t, []string{
+						"www A 9.9.9.9",
+						"@ TXT verification",
+					}, hostnames())
+				},
+			},
+		},
+	}.Run(t, server)
+
+	assert.Equal(t, []string{"@ TXT verification"}, hostnames())
+}
+
+func TestDnsZoneRecordsReadKeepsZoneFile(t *testing.T) {
+	t.Parallel()
+	server, netcup := newTestProviderServer(t)
+	wwwID := netcup.AddRecord("example.com", netcuptest.Record{Hostname: "www", Type: "A", Destination: "1.2.3.4"})
+	zoneFile := "$ORIGIN example.com.\nwww 3600 IN A 1.2.3.4\n"
+
+	response, err := server.Read(p.ReadRequest{
+		ID:  "example.com",
+		Urn: presource.NewURN("stack", "project", "", "netcup:index:DNSZoneRecords", "records"),
+		Properties: property.NewMap(map[string]property.Value{
+			"domain":    property.New("example.com"),
+			"zoneFile":  property.New(zoneFile),
+			"recordIds": property.New([]property.Value{property.New(wwwID)}),
+		}),
+		Inputs: property.NewMap(map[string]property.Value{
+			"domain":   property.New("example.com"),
+			"zoneFile": property.New(zoneFile),
+		}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, zoneFile, response.Inputs.Get("zoneFile").AsString())
+	assert.Len(t, response.Inputs.Get("records").AsArray().AsSlice(), 1)
+}
@@ -0,0 +1,213 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	integration "github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestDnsZoneValidation(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		args     DNSZoneArgs
+		failures []string
+		warnings []string
+	}{
+		{
+			name: "only domain",
+			args: DNSZoneArgs{Domain: "example.com"},
+		},
+		{
+			name: "valid settings",
+			args: DNSZoneArgs{
+				Domain:  "example.com",
+				TTL:     intPtr(3600),
+				Refresh: intPtr(28800),
+				Retry:   intPtr(7200),
+				Expire:  intPtr(1209600),
+			},
+		},
+		{
+			name:     "invalid domain",
+			args:     DNSZoneArgs{Domain: "localhost"},
+			failures: []string{"domain"},
+		},
+		{
+			name:     "negative ttl",
+			args:     DNSZoneArgs{Domain: "example.com", TTL: intPtr(-1)},
+			failures: []string{"ttl"},
+			warnings: []string{"ttl"},
+		},
+		{
+			name:     "expire too high",
+			args:     DNSZoneArgs{Domain: "example.com", Expire: intPtr(1 << 31)},
+			failures: []string{"expire"},
+		},
+		{
+			name:     "below recommendations",
+			args:     DNSZoneArgs{Domain: "example.com", TTL: intPtr(60), Expire: intPtr(86400)},
+			warnings: []string{"ttl", "expire"},
+		},
+		{
+			name:     "retry not shorter than refresh",
+			args:     DNSZoneArgs{Domain: "example.com", Refresh: intPtr(3600), Retry: intPtr(3600)},
+			warnings: []string{"retry"},
+		},
+		{
+			name:     "expire not longer than refresh",
+			args:     DNSZoneArgs{Domain: "example.com", Refresh: intPtr(1209600), Expire: intPtr(1209600)},
+			warnings: []string{"expire"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var failures, warnings []string
+			for _, failure := range validateDNSZoneWithFailures(tt.args) {
+				failures = append(failures, failure.Property)
+			}
+			for _, warning := range dnsZoneWarnings(tt.args) {
+				warnings = append(warnings, warning.Property)
+			}
+			assert.Equal(t, tt.failures, failures)
+			assert.Equal(t, tt.warnings, warnings)
+		})
+	}
+}
+
+func TestDnsZoneLifecycle(t *testing.T) {
+	t.Parallel()
+	ksk := testDNSKEY
+	ksk.Flags = 257
+	nameserver := newTestNameserver(t, ksk)
+	server, netcup := newTestProviderServerWithConfig(t, map[string]property.Value{
+		"nameserver": property.New(nameserver),
+	})
+	netcup.AddDomain("example.com")
+
+	initial, ok := netcup.Zone("example.com")
+	require.True(t, ok)
+
+	integration.LifeCycleTest{
+		Resource: "netcup:index:DNSZone",
+		Create: integration.Operation{
+			Inputs: property.NewMap(map[string]property.Value{
+				"domain": property.New("example.com"),
+				"ttl":    property.New(3600.0),
+			}),
+			Hook: func(inputs, output property.Map) {
+				assert.Equal(t, 3600.0, output.Get("ttl").AsNumber())
+				assert.Equal(t, 28800.0, output.Get("refresh").AsNumber())
+				assert.NotEqual(t, initial.Serial, output.Get("serial").AsString())
+
+				zone, _ := netcup.Zone("example.com")
+				assert.Equal(t, "3600", zone.TTL)
+				assert.Equal(t, zone.Serial, output.Get("serial").AsString())
+			},
+		},
+		Updates: []integration.Operation{
+			{
+				Inputs: property.NewMap(map[string]property.Value{
+					"domain":  property.New("example.com"),
+					"ttl":     property.New(3600.0),
+					"refresh": property.New(14400.0),
+				}),
+				Hook: func(inputs, output property.Map) {
+					assert.Equal(t, 14400.0, output.Get("refresh").AsNumber())
+					assert.False(t, output.Get("dnssec").AsBool())
+					assert.Empty(t, output.Get("dnskeys").AsArray().AsSlice())
+
+					zone, _ := netcup.Zone("example.com")
+					assert.Equal(t, "14400", zone.Refresh)
+					assert.Equal(t, "3600", zone.TTL)
+				},
+			},
+			{
+				Inputs: property.NewMap(map[string]property.Value{
+					"domain":  property.New("example.com"),
+					"ttl":     property.New(3600.0),
+					"refresh": property.New(14400.0),
+					"dnssec":  property.New(true),
+				}),
+				Hook: func(inputs, output property.Map) {
+					assert.True(t, output.Get("dnssec").AsBool())
+					assert.Equal(t, []property.Value{property.New(ksk.String())},
+						output.Get("dnskeys").AsArray().AsSlice())
+					dsRecord, err := ksk.DSRecord("example.com")
+					require.NoError(t, err)
+					assert.Equal(t, []property.Value{property.New(dsRecord)},
+						output.Get("dsRecords").AsArray().AsSlice())
+
+					zone, _ := netcup.Zone("example.com")
+					assert.True(t, zone.DNSSECStatus)
+				},
+			},
+		},
+	}.Run(t, server)
+
+	// Deleting the resource leaves the zone in place
+	zone, ok := netcup.Zone("example.com")
+	require.True(t, ok)
+	assert.Equal(t, "14400", zone.Refresh)
+}
+
+func TestDnsZoneDiff(t *testing.T) {
+	t.Parallel()
+	enabled := true
+	disabled := false
+	state := DNSZoneState{
+		DNSZoneArgs: DNSZoneArgs{
+			Domain:  "example.com",
+			TTL:     intPtr(3600),
+			Refresh: intPtr(28800),
+			Retry:   intPtr(7200),
+			Expire:  intPtr(1209600),
+			DNSSEC:  &enabled,
+		},
+		// Netcup has not published the keys yet
+		DNSKEYs:   []string{},
+		DSRecords: []string{},
+	}
+
+	zone := &DNSZone{}
+	diff, err := zone.Diff(t.Context(), infer.DiffRequest[DNSZoneArgs, DNSZoneState]{
+		Inputs: DNSZoneArgs{Domain: "example.com", TTL: intPtr(3600), DNSSEC: &enabled},
+		State:  state,
+	})
+	require.NoError(t, err)
+	assert.False(t, diff.HasChanges)
+
+	diff, err = zone.Diff(t.Context(), infer.DiffRequest[DNSZoneArgs, DNSZoneState]{
+		Inputs: DNSZoneArgs{Domain: "example.com", DNSSEC: &disabled},
+		State:  state,
+	})
+	require.NoError(t, err)
+	assert.True(t, diff.HasChanges)
+	assert.Equal(t, p.Update, diff.DetailedDiff["dnssec"].Kind)
+}
+
+func intPtr(i int) *int {
+	return &i
+}
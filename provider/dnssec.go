@@ -0,0 +1,256 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// DefaultNameserver is the authoritative Netcup nameserver DNSKEY records are looked up on.
+// The Netcup API does not expose the keys of signed zones, so they are read from DNS.
+const DefaultNameserver = "root-dns.netcup.net:53"
+
+// DNS wire format constants used for DNSKEY lookups
+const (
+	dnsTypeDNSKEY   = 48
+	dnsClassIN      = 1
+	dnsHeaderLen    = 12
+	dnsKeyFlagSEP   = 1
+	dnsDigestSHA256 = 2
+)
+
+// DNSKEYInfo is a DNSKEY record published for a signed zone
+type DNSKEYInfo struct {
+	Flags     uint16
+	Protocol  uint8
+	Algorithm uint8
+	PublicKey []byte
+}
+
+// String formats the key in zone file presentation format
+func (k DNSKEYInfo) String() string {
+	return fmt.Sprintf("%d %d %d %s", k.Flags, k.Protocol, k.Algorithm, base64.StdEncoding.EncodeToString(k.PublicKey))
+}
+
+// IsKSK reports whether the key is a key signing key that a DS record in the parent zone refers to
+func (k DNSKEYInfo) IsKSK() bool {
+	return k.Flags&dnsKeyFlagSEP != 0
+}
+
+// rdata returns the DNSKEY RDATA in wire format
+func (k DNSKEYInfo) rdata() []byte {
+	rdata := make([]byte, 4, 4+len(k.PublicKey))
+	binary.BigEndian.PutUint16(rdata, k.Flags)
+	rdata[2] = k.Protocol
+	rdata[3] = k.Algorithm
+	return append(rdata, k.PublicKey...)
+}
+
+// KeyTag computes the key tag of the key as defined in RFC 4034, Appendix B
+func (k DNSKEYInfo) KeyTag() uint16 {
+	var sum uint32
+	for i, b := range k.rdata() {
+		if i%2 == 0 {
+			sum += uint32(b) << 8
+		} else {
+			sum += uint32(b)
+		}
+	}
+	sum += sum >> 16 & 0xffff
+	return uint16(sum & 0xffff)
+}
+
+// DSRecord returns the SHA-256 DS record for the key in zone file presentation format
+func (k DNSKEYInfo) DSRecord(domain string) (string, error) {
+	owner, err := encodeDNSName(domain)
+	if err != nil {
+		return "", err
+	}
+
+	digest := sha256.Sum256(append(owner, k.rdata()...))
+	return fmt.Sprintf("%d %d %d %s",
+		k.KeyTag(), k.Algorithm, dnsDigestSHA256, strings.ToUpper(hex.EncodeToString(digest[:])),
+	), nil
+}
+
+// GetDNSKEYs looks up the DNSKEY records of a domain on the authoritative nameserver.
+// A zone that is not signed (yet) has no keys and returns an empty list.
+func (c *NetcupClient) GetDNSKEYs(ctx context.Context, domain string) ([]DNSKEYInfo, error) {
+	query, id, err := newDNSKEYQuery(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without a context deadline a stalled nameserver is bounded by the request timeout
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(c.requestTimeout())
+	}
+
+	dialer := net.Dialer{Deadline: deadline}
+	conn, err := dialer.DialContext(ctx, "tcp", c.nameserver)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to nameserver %s: %w", c.nameserver, err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	_ = conn.SetDeadline(deadline)
+
+	// DNS over TCP prefixes every message with its length
+	message := binary.BigEndian.AppendUint16(nil, uint16(len(query)))
+	if _, err := conn.Write(append(message, query...)); err != nil {
+		return nil, fmt.Errorf("failed to send DNSKEY query: %w", err)
+	}
+
+	var length [2]byte
+	if _, err := io.ReadFull(conn, length[:]); err != nil {
+		return nil, fmt.Errorf("failed to read DNSKEY response: %w", err)
+	}
+	response := make([]byte, binary.BigEndian.Uint16(length[:]))
+	if _, err := io.ReadFull(conn, response); err != nil {
+		return nil, fmt.Errorf("failed to read DNSKEY response: %w", err)
+	}
+
+	return parseDNSKEYResponse(response, id)
+}
+
+// newDNSKEYQuery builds a DNSKEY query for a domain and returns it with its message ID
+func newDNSKEYQuery(domain string) ([]byte, uint16, error) {
+	name, err := encodeDNSName(domain)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var idBytes [2]byte
+	if _, err := rand.Read(idBytes[:]); err != nil {
+		return nil, 0, err
+	}
+	id := binary.BigEndian.Uint16(idBytes[:])
+
+	query := make([]byte, dnsHeaderLen, dnsHeaderLen+len(name)+4)
+	binary.BigEndian.PutUint16(query[0:], id)
+	binary.BigEndian.PutUint16(query[4:], 1) // one question
+	query = append(query, name...)
+	query = binary.BigEndian.AppendUint16(query, dnsTypeDNSKEY)
+	query = binary.BigEndian.AppendUint16(query, dnsClassIN)
+	return query, id, nil
+}
+
+// parseDNSKEYResponse extracts the DNSKEY records from the answer section of a response
+func parseDNSKEYResponse(response []byte, id uint16) ([]DNSKEYInfo, error) {
+	if len(response) < dnsHeaderLen {
+		return nil, errors.New("invalid DNSKEY response: truncated header")
+	}
+	if binary.BigEndian.Uint16(response[0:]) != id {
+		return nil, errors.New("invalid DNSKEY response: message ID mismatch")
+	}
+
+	// NXDOMAIN means the zone is unknown to the nameserver, other errors are failures
+	switch rcode := response[3] & 0x0f; rcode {
+	case 0:
+	case 3:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("DNSKEY lookup failed with response code %d", rcode)
+	}
+
+	questions := int(binary.BigEndian.Uint16(response[4:]))
+	answers := int(binary.BigEndian.Uint16(response[6:]))
+
+	offset := dnsHeaderLen
+	for range questions {
+		next, err := skipDNSName(response, offset)
+		if err != nil {
+			return nil, err
+		}
+		offset = next + 4
+	}
+
+	var keys []DNSKEYInfo
+	for range answers {
+		next, err := skipDNSName(response, offset)
+		if err != nil {
+			return nil, err
+		}
+		if next+10 > len(response) {
+			return nil, errors.New("invalid DNSKEY response: truncated record")
+		}
+		recordType := binary.BigEndian.Uint16(response[next:])
+		rdLength := int(binary.BigEndian.Uint16(response[next+8:]))
+		rdata := next + 10
+		if rdata+rdLength > len(response) {
+			return nil, errors.New("invalid DNSKEY response: truncated record data")
+		}
+		offset = rdata + rdLength
+
+		if recordType != dnsTypeDNSKEY || rdLength < 4 {
+			continue
+		}
+		keys = append(keys, DNSKEYInfo{
+			Flags:     binary.BigEndian.Uint16(response[rdata:]),
+			Protocol:  response[rdata+2],
+			Algorithm: response[rdata+3],
+			PublicKey: append([]byte(nil), response[rdata+4:offset]...),
+		})
+	}
+
+	return keys, nil
+}
+
+// encodeDNSName encodes a domain name in canonical (lowercase) wire format
+func encodeDNSName(domain string) ([]byte, error) {
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+
+	var name []byte
+	if domain != "" {
+		for _, label := range strings.Split(domain, ".") {
+			if label == "" || len(label) > 63 {
+				return nil, fmt.Errorf("invalid domain name: %s", domain)
+			}
+			name = append(name, byte(len(label)))
+			name = append(name, label...)
+		}
+	}
+	return append(name, 0), nil
+}
+
+// skipDNSName returns the offset after the possibly compressed name starting at offset
+func skipDNSName(message []byte, offset int) (int, error) {
+	for offset < len(message) {
+		length := int(message[offset])
+		switch {
+		case length == 0:
+			return offset + 1, nil
+		case length&0xc0 == 0xc0:
+			// A compression pointer ends the name
+			return offset + 2, nil
+		default:
+			offset += length + 1
+		}
+	}
+	return 0, errors.New("invalid DNSKEY response: truncated name")
+}
@@ -0,0 +1,169 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testDNSKEY is the example key of RFC 4509, section 2.3
+var testDNSKEY = DNSKEYInfo{
+	Flags:     256,
+	Protocol:  3,
+	Algorithm: 5,
+	PublicKey: mustDecodeBase64("AQOeiiR0GOMYkDshWoSKz9XzfwJr1AYtsmx3TGkJaNXVbfi/2pHm822aJ5iI9BMzNXxeYCmZ" +
+		"DRD99WYwYqUSdjMmmAphXdvxegXd/M5+X7OrzKBaMbCVdFLUUh6DhweJBjEVv5f2wwjM9XzcnOf+EPbtG9DMBmADjFDc2w/rljwvFw=="),
+}
+
+func TestDNSKEYInfo_DSRecord(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, uint16(60485), testDNSKEY.KeyTag())
+	assert.False(t, testDNSKEY.IsKSK())
+
+	ds, err := testDNSKEY.DSRecord("dskey.example.com.")
+	require.NoError(t, err)
+	assert.Equal(t, "60485 5 2 D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A", ds)
+}
+
+func TestNetcupClient_GetDNSKEYs(t *testing.T) {
+	t.Parallel()
+	nameserver := newTestNameserver(t, testDNSKEY)
+
+	client := newTestClient("http://unused", WithNameserver(nameserver))
+	keys, err := client.GetDNSKEYs(t.Context(), "dskey.example.com")
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, testDNSKEY, keys[0])
+	assert.Equal(t, "256 3 5 "+base64.StdEncoding.EncodeToString(testDNSKEY.PublicKey), keys[0].String())
+}
+
+func TestNetcupClient_GetDNSKEYsTimesOutWithoutDeadline(t *testing.T) {
+	t.Parallel()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	// The nameserver accepts connections but never answers
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer func() { _ = conn.Close() }()
+				_, _ = io.Copy(io.Discard, conn)
+			}()
+		}
+	}()
+
+	client := newTestClient("http://unused",
+		WithNameserver(listener.Addr().String()),
+		WithHTTPClient(&http.Client{Timeout: 50 * time.Millisecond}))
+	_, err = client.GetDNSKEYs(t.Context(), "example.com")
+	var netErr net.Error
+	require.ErrorAs(t, err, &netErr)
+	assert.True(t, netErr.Timeout())
+}
+
+func TestParseDNSKEYResponse_Errors(t *testing.T) {
+	t.Parallel()
+	query, id, err := newDNSKEYQuery("example.com")
+	require.NoError(t, err)
+
+	_, err = parseDNSKEYResponse(query[:5], id)
+	assert.ErrorContains(t, err, "truncated header")
+
+	_, err = parseDNSKEYResponse(query, id+1)
+	assert.ErrorContains(t, err, "message ID mismatch")
+
+	nxdomain := append([]byte(nil), query...)
+	nxdomain[3] = 3
+	keys, err := parseDNSKEYResponse(nxdomain, id)
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	servfail := append([]byte(nil), query...)
+	servfail[3] = 2
+	_, err = parseDNSKEYResponse(servfail, id)
+	assert.ErrorContains(t, err, "response code 2")
+}
+
+// newTestNameserver starts a DNS over TCP server that answers every query with the given keys
+func newTestNameserver(t *testing.T, keys ...DNSKEYInfo) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveTestDNSKEYs(conn, keys)
+		}
+	}()
+
+	return listener.Addr().String()
+}
+
+func serveTestDNSKEYs(conn net.Conn, keys []DNSKEYInfo) {
+	defer func() { _ = conn.Close() }()
+
+	var length [2]byte
+	if _, err := io.ReadFull(conn, length[:]); err != nil {
+		return
+	}
+	query := make([]byte, binary.BigEndian.Uint16(length[:]))
+	if _, err := io.ReadFull(conn, query); err != nil {
+		return
+	}
+
+	// Echo the question and answer with compressed owner names pointing at it
+	response := append([]byte(nil), query...)
+	response[2] |= 0x84 // response, authoritative
+	binary.BigEndian.PutUint16(response[6:], uint16(len(keys)))
+	for _, key := range keys {
+		rdata := key.rdata()
+		response = append(response, 0xc0, dnsHeaderLen)
+		response = binary.BigEndian.AppendUint16(response, dnsTypeDNSKEY)
+		response = binary.BigEndian.AppendUint16(response, dnsClassIN)
+		response = binary.BigEndian.AppendUint32(response, 3600)
+		response = binary.BigEndian.AppendUint16(response, uint16(len(rdata)))
+		response = append(response, rdata...)
+	}
+
+	message := binary.BigEndian.AppendUint16(nil, uint16(len(response)))
+	_, _ = conn.Write(append(message, response...))
+}
+
+func mustDecodeBase64(s string) []byte {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
@@ -0,0 +1,171 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// ListDomains lists the domains owned by the Netcup customer account.
+type ListDomains struct{}
+
+// Annotate provides metadata about the listDomains function.
+func (f *ListDomains) Annotate(a infer.Annotator) {
+	a.SetToken("index", "listDomains")
+	a.Describe(&f, "Returns all domains owned by the Netcup customer account")
+}
+
+// ListDomainsArgs contains the input arguments for the listDomains function.
+type ListDomainsArgs struct{}
+
+// ListDomainsResult contains the result of the listDomains function.
+type ListDomainsResult struct {
+	Domains []DomainDetails `pulumi:"domains"`
+}
+
+// Annotate provides metadata about the ListDomainsResult.
+func (result *ListDomainsResult) Annotate(a infer.Annotator) {
+	a.Describe(&result.Domains, "The domains of the account, sorted by name")
+}
+
+// Invoke lists the domains of the account.
+func (f *ListDomains) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[ListDomainsArgs],
+) (infer.FunctionResponse[ListDomainsResult], error) {
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.netcupClient()
+	if err != nil {
+		return infer.FunctionResponse[ListDomainsResult]{}, err
+	}
+
+	domains, err := client.ListDomains(ctx)
+	if err != nil {
+		return infer.FunctionResponse[ListDomainsResult]{}, fmt.Errorf("failed to list domains: %w", err)
+	}
+
+	result := ListDomainsResult{Domains: make([]DomainDetails, 0, len(domains))}
+	for _, domain := range domains {
+		result.Domains = append(result.Domains, domainDetails(domain))
+	}
+	slices.SortFunc(result.Domains, func(a, b DomainDetails) int {
+		return strings.Compare(a.Domain, b.Domain)
+	})
+
+	return infer.FunctionResponse[ListDomainsResult]{Output: result}, nil
+}
+
+// GetDomainInfo looks up the registration details of a Netcup domain.
+type GetDomainInfo struct{}
+
+// Annotate provides metadata about the getDomainInfo function.
+func (f *GetDomainInfo) Annotate(a infer.Annotator) {
+	a.SetToken("index", "getDomainInfo")
+	a.Describe(&f, "Returns the registration details of a domain owned by the Netcup customer account")
+}
+
+// GetDomainInfoArgs contains the input arguments for the getDomainInfo function.
+type GetDomainInfoArgs struct {
+	Domain string `pulumi:"domain"`
+}
+
+// Annotate provides metadata about the GetDomainInfoArgs.
+func (args *GetDomainInfoArgs) Annotate(a infer.Annotator) {
+	a.Describe(&args.Domain, "The domain name to look up (e.g., 'example.com')")
+}
+
+// Invoke looks up the domain.
+func (f *GetDomainInfo) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[GetDomainInfoArgs],
+) (infer.FunctionResponse[DomainDetails], error) {
+	domain := strings.ToLower(strings.TrimSpace(req.Input.Domain))
+	if !isValidDomain(domain) {
+		return infer.FunctionResponse[DomainDetails]{}, fmt.Errorf("invalid domain name: %q", req.Input.Domain)
+	}
+
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.netcupClient()
+	if err != nil {
+		return infer.FunctionResponse[DomainDetails]{}, err
+	}
+
+	info, err := client.GetDomainInfo(ctx, domain)
+	if err != nil {
+		return infer.FunctionResponse[DomainDetails]{}, fmt.Errorf("failed to get domain %s: %w", domain, err)
+	}
+
+	details := domainDetails(info)
+	if details.Domain == "" {
+		details.Domain = domain
+	}
+	return infer.FunctionResponse[DomainDetails]{Output: details}, nil
+}
+
+// DomainDetails describes a domain owned by the customer account.
+type DomainDetails struct {
+	Domain       string                `pulumi:"domain"`
+	State        string                `pulumi:"state"`
+	Nameservers  []string              `pulumi:"nameservers"`
+	RuntimeStart string                `pulumi:"runtimeStart"`
+	RuntimeEnd   string                `pulumi:"runtimeEnd"`
+	Handles      []DomainHandleDetails `pulumi:"handles"`
+}
+
+// Annotate provides metadata about the DomainDetails.
+func (details *DomainDetails) Annotate(a infer.Annotator) {
+	a.Describe(&details.Domain, "The domain name")
+	a.Describe(&details.State, "The registration state of the domain as reported by Netcup")
+	a.Describe(&details.Nameservers, "The hostnames of the nameservers the domain is delegated to")
+	a.Describe(&details.RuntimeStart, "The start date of the current contract runtime")
+	a.Describe(&details.RuntimeEnd, "The end date of the current contract runtime")
+	a.Describe(&details.Handles, "The contact handles assigned to the domain")
+}
+
+// DomainHandleDetails is a contact handle assigned to a domain.
+type DomainHandleDetails struct {
+	Type     string `pulumi:"type"`
+	HandleID string `pulumi:"handleId"`
+}
+
+// Annotate provides metadata about the DomainHandleDetails.
+func (handle *DomainHandleDetails) Annotate(a infer.Annotator) {
+	a.Describe(&handle.Type, "The role of the handle, such as 'ownerc', 'adminc', 'techc' or 'zonec'")
+	a.Describe(&handle.HandleID, "The Netcup ID of the handle")
+}
+
+// domainDetails converts a domain returned by the API into its function output
+func domainDetails(info *DomainInfo) DomainDetails {
+	details := DomainDetails{
+		Domain:       strings.ToLower(info.Name),
+		State:        info.State,
+		Nameservers:  info.Nameservers,
+		RuntimeStart: info.RuntimeStart,
+		RuntimeEnd:   info.RuntimeEnd,
+		Handles:      make([]DomainHandleDetails, 0, len(info.AssignedHandles)),
+	}
+	if details.Nameservers == nil {
+		details.Nameservers = []string{}
+	}
+	for _, handle := range info.AssignedHandles {
+		details.Handles = append(details.Handles, DomainHandleDetails{Type: handle.Type, HandleID: handle.HandleID})
+	}
+	return details
+}
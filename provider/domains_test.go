@@ -0,0 +1,69 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+
+	"github.com/blackdark/pulumi-netcup/provider/netcuptest"
+)
+
+func TestDomainFunctions(t *testing.T) {
+	t.Parallel()
+	server, netcup := newTestProviderServer(t)
+	netcup.AddDomain("example.org")
+	netcup.SetDomain(netcuptest.Domain{
+		Name:        "example.com",
+		State:       "active",
+		Nameservers: []netcuptest.Nameserver{{Hostname: "ns1.example.net"}, {Hostname: "ns2.example.net"}},
+		RuntimeEnd:  "2026-01-01",
+		AssignedHandles: []netcuptest.Handle{
+			{Type: "ownerc", HandleID: "1001"},
+			{Type: "adminc", HandleID: "1002"},
+		},
+	})
+
+	listed, err := server.Invoke(p.InvokeRequest{Token: "netcup:index:listDomains"})
+	require.NoError(t, err)
+	var names []string
+	for _, domain := range listed.Return.Get("domains").AsArray().AsSlice() {
+		names = append(names, domain.AsMap().Get("domain").AsString())
+	}
+	assert.Equal(t, []string{"example.com", "example.org"}, names)
+
+	info, err := server.Invoke(p.InvokeRequest{
+		Token: "netcup:index:getDomainInfo",
+		Args:  property.NewMap(map[string]property.Value{"domain": property.New("Example.com")}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "active", info.Return.Get("state").AsString())
+	assert.Equal(t, "2026-01-01", info.Return.Get("runtimeEnd").AsString())
+	assert.Len(t, info.Return.Get("nameservers").AsArray().AsSlice(), 2)
+	handles := info.Return.Get("handles").AsArray().AsSlice()
+	require.Len(t, handles, 2)
+	assert.Equal(t, "1001", handles[0].AsMap().Get("handleId").AsString())
+
+	_, err = server.Invoke(p.InvokeRequest{
+		Token: "netcup:index:getDomainInfo",
+		Args:  property.NewMap(map[string]property.Value{"domain": property.New("unknown.com")}),
+	})
+	assert.ErrorIs(t, err, ErrDomainInaccessible)
+}
@@ -0,0 +1,428 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// Labels the records of an email address are published under, as defined in RFC 7929 and RFC 8162
+const (
+	openPGPKeyLabel = "_openpgpkey"
+	smimeCertLabel  = "_smimecert"
+)
+
+// emailHashLength is the number of octets of the SHA-256 hash of the local part used in owner names
+const emailHashLength = 28
+
+// OpenPGP packet tags as defined in RFC 4880
+const (
+	openPGPTagPublicKey = 6
+	openPGPTagUserID    = 13
+)
+
+// emailAddressOID is the PKCS #9 emailAddress attribute of certificate subjects
+var emailAddressOID = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 1}
+
+// OPENPGPKEYRecord contains the inputs of an OPENPGPKEY record as defined in RFC 7929
+type OPENPGPKEYRecord struct {
+	Email     string `pulumi:"email"`
+	PublicKey string `pulumi:"publicKey"`
+}
+
+// Annotate provides metadata about the OPENPGPKEYRecord.
+func (openPGPKey *OPENPGPKEYRecord) Annotate(a infer.Annotator) {
+	a.Describe(&openPGPKey.Email, "The email address the key belongs to, within the domain of the record")
+	a.Describe(&openPGPKey.PublicKey, "The ASCII armored OpenPGP public key, with a user ID of the email address")
+}
+
+// SMIMEARecord contains the inputs of an SMIMEA record as defined in RFC 8162
+type SMIMEARecord struct {
+	Email        string `pulumi:"email"`
+	Certificate  string `pulumi:"certificate"`
+	Usage        *int   `pulumi:"usage,optional"`
+	Selector     *int   `pulumi:"selector,optional"`
+	MatchingType *int   `pulumi:"matchingType,optional"`
+}
+
+// Annotate provides metadata about the SMIMEARecord.
+func (smimea *SMIMEARecord) Annotate(a infer.Annotator) {
+	a.Describe(&smimea.Email, "The email address the certificate is issued for, within the domain of the record")
+	a.Describe(&smimea.Certificate, "The PEM encoded S/MIME certificate of the email address")
+	a.Describe(&smimea.Usage, "The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)")
+	a.SetDefault(&smimea.Usage, TLSAUsageDANEEE)
+	a.Describe(&smimea.Selector, "The selector: 0 for the full certificate or 1 for the public key")
+	a.SetDefault(&smimea.Selector, TLSASelectorCertificate)
+	a.Describe(
+		&smimea.MatchingType,
+		"The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512. "+
+			"Senders need the full certificate to encrypt, so it defaults to 0",
+	)
+	a.SetDefault(&smimea.MatchingType, TLSAMatchingTypeFull)
+}
+
+// parameters returns the usage, selector and matching type, applying the defaults
+func (smimea SMIMEARecord) parameters() (usage, selector, matchingType int) {
+	usage, selector, matchingType = TLSAUsageDANEEE, TLSASelectorCertificate, TLSAMatchingTypeFull
+	if smimea.Usage != nil {
+		usage = *smimea.Usage
+	}
+	if smimea.Selector != nil {
+		selector = *smimea.Selector
+	}
+	if smimea.MatchingType != nil {
+		matchingType = *smimea.MatchingType
+	}
+	return usage, selector, matchingType
+}
+
+// emailOwnerName returns the name of the record of an email address relative to the domain:
+// the hex encoded, truncated SHA-256 hash of the local part below the label and the domain of
+// the address. The local part is hashed as is, without case folding.
+func emailOwnerName(email, label, domain string) (string, error) {
+	at := strings.LastIndex(email, "@")
+	if at <= 0 {
+		return "", fmt.Errorf("invalid email address %q", email)
+	}
+	localPart, emailDomain := email[:at], strings.ToLower(email[at+1:])
+
+	digest := sha256.Sum256([]byte(localPart))
+	name := hex.EncodeToString(digest[:emailHashLength]) + "." + label
+
+	switch {
+	case emailDomain == domain:
+		return name, nil
+	case strings.HasSuffix(emailDomain, "."+domain):
+		return name + "." + strings.TrimSuffix(emailDomain, "."+domain), nil
+	default:
+		return "", fmt.Errorf("email domain %s is outside of domain %s", emailDomain, domain)
+	}
+}
+
+// composeEmailRecordName derives the name of a record of an email address, unless a name other
+// than the root domain is given, which validation compares to the derived one
+func composeEmailRecordName(args DNSRecordArgs, email, label string) DNSRecordArgs {
+	if name, err := emailOwnerName(email, label, args.Domain); err == nil && args.Name == "@" {
+		args.Name = name
+	}
+	return args
+}
+
+// composeOPENPGPKEYRecord derives the name and value of a record with OPENPGPKEY inputs
+func composeOPENPGPKEYRecord(args DNSRecordArgs) DNSRecordArgs {
+	if args.OPENPGPKEY == nil {
+		return args
+	}
+
+	args = composeEmailRecordName(args, args.OPENPGPKEY.Email, openPGPKeyLabel)
+	if args.Value == "" {
+		// Invalid keys are reported by validation
+		if key, err := dearmorOpenPGPKey(args.OPENPGPKEY.PublicKey); err == nil {
+			args.Value = base64.StdEncoding.EncodeToString(key)
+		}
+	}
+	return args
+}
+
+// composeSMIMEARecord derives the name and value of a record with SMIMEA inputs
+func composeSMIMEARecord(args DNSRecordArgs) DNSRecordArgs {
+	if args.SMIMEA == nil {
+		return args
+	}
+
+	args = composeEmailRecordName(args, args.SMIMEA.Email, smimeCertLabel)
+	if args.Value == "" {
+		usage, selector, matchingType := args.SMIMEA.parameters()
+		// Invalid certificates are reported by validation
+		args.Value, _ = certificateAssociation(args.SMIMEA.Certificate, usage, selector, matchingType)
+	}
+	return args
+}
+
+// validateEmailRecord validates the record type, email address and name shared by the inputs
+// of OPENPGPKEY and SMIMEA records
+func validateEmailRecord(args DNSRecordArgs, property, recordType, email, label string) []p.CheckFailure {
+	if !strings.EqualFold(args.Type, recordType) {
+		return []p.CheckFailure{{
+			Property: property,
+			Reason:   fmt.Sprintf("%s can only be set for %s records, not %s", property, recordType, args.Type),
+		}}
+	}
+
+	if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+		return []p.CheckFailure{{
+			Property: property + ".email",
+			Reason:   fmt.Sprintf("%q must be an email address", email),
+		}}
+	}
+
+	name, err := emailOwnerName(email, label, args.Domain)
+	if err != nil {
+		return []p.CheckFailure{{Property: property + ".email", Reason: err.Error()}}
+	}
+	if args.Name != name {
+		return []p.CheckFailure{{
+			Property: "name",
+			Reason:   fmt.Sprintf("Name %q does not match the name %q derived from the email address", args.Name, name),
+		}}
+	}
+	return nil
+}
+
+// validateOPENPGPKEYRecord validates the OPENPGPKEY inputs of a record
+func validateOPENPGPKEYRecord(args DNSRecordArgs) []p.CheckFailure {
+	openPGPKey := args.OPENPGPKEY
+	if openPGPKey == nil {
+		return nil
+	}
+	failures := validateEmailRecord(args, "openpgpkey", "OPENPGPKEY", openPGPKey.Email, openPGPKeyLabel)
+	if len(failures) > 0 {
+		return failures
+	}
+
+	key, err := dearmorOpenPGPKey(openPGPKey.PublicKey)
+	if err == nil {
+		err = validateOpenPGPKey(key, openPGPKey.Email)
+	}
+	if err != nil {
+		return []p.CheckFailure{{Property: "openpgpkey.publicKey", Reason: err.Error()}}
+	}
+
+	if args.Value != base64.StdEncoding.EncodeToString(key) {
+		return []p.CheckFailure{{
+			Property: "value",
+			Reason:   "Only one of value and openpgpkey can be set",
+		}}
+	}
+	return nil
+}
+
+// validateSMIMEARecord validates the SMIMEA inputs of a record
+func validateSMIMEARecord(args DNSRecordArgs) []p.CheckFailure {
+	smimea := args.SMIMEA
+	if smimea == nil {
+		return nil
+	}
+	failures := validateEmailRecord(args, "smimea", "SMIMEA", smimea.Email, smimeCertLabel)
+	if len(failures) > 0 {
+		return failures
+	}
+
+	usage, selector, matchingType := smimea.parameters()
+	if failures := certificateAssociationFailures("smimea", usage, selector, matchingType); len(failures) > 0 {
+		return failures
+	}
+
+	value, err := certificateAssociation(smimea.Certificate, usage, selector, matchingType)
+	if err == nil {
+		err = validateSMIMECertificate(smimea.Certificate, smimea.Email)
+	}
+	if err != nil {
+		return []p.CheckFailure{{Property: "smimea.certificate", Reason: err.Error()}}
+	}
+
+	if args.Value != value {
+		return []p.CheckFailure{{
+			Property: "value",
+			Reason:   "Only one of value and smimea can be set",
+		}}
+	}
+	return nil
+}
+
+// validateSMIMECertificate checks that the certificate is issued for the email address, either
+// as subject alternative name or as emailAddress attribute of the subject
+func validateSMIMECertificate(certificate, email string) error {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(certificate)))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return errors.New("S/MIME certificate must be a PEM encoded CERTIFICATE")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("invalid certificate: %w", err)
+	}
+
+	for _, address := range cert.EmailAddresses {
+		if strings.EqualFold(address, email) {
+			return nil
+		}
+	}
+	for _, name := range cert.Subject.Names {
+		if address, ok := name.Value.(string); ok && name.Type.Equal(emailAddressOID) && strings.EqualFold(address, email) {
+			return nil
+		}
+	}
+	return fmt.Errorf("certificate is not issued for %s", email)
+}
+
+// dearmorOpenPGPKey decodes an ASCII armored OpenPGP public key block as defined in RFC 4880,
+// section 6.2, and verifies its checksum
+func dearmorOpenPGPKey(armored string) ([]byte, error) {
+	const header = "-----BEGIN PGP PUBLIC KEY BLOCK-----"
+	const footer = "-----END PGP PUBLIC KEY BLOCK-----"
+
+	start := strings.Index(armored, header)
+	end := strings.Index(armored, footer)
+	if start < 0 || end < start {
+		return nil, errors.New("public key must be an ASCII armored PGP PUBLIC KEY BLOCK")
+	}
+
+	// Armor headers such as "Comment:" end with an empty line
+	lines := strings.Split(strings.TrimSpace(armored[start+len(header):end]), "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			lines = lines[i+1:]
+			break
+		}
+		if !strings.Contains(line, ":") {
+			break
+		}
+	}
+
+	var body strings.Builder
+	var checksum string
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "=") {
+			checksum = line[1:]
+			continue
+		}
+		body.WriteString(line)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(body.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key encoding: %w", err)
+	}
+	if checksum != "" {
+		expected, err := base64.StdEncoding.DecodeString(checksum)
+		if err != nil || len(expected) != 3 {
+			return nil, errors.New("invalid public key checksum")
+		}
+		crc := crc24(key)
+		if expected[0] != byte(crc>>16) || expected[1] != byte(crc>>8) || expected[2] != byte(crc) {
+			return nil, errors.New("public key checksum does not match")
+		}
+	}
+	return key, nil
+}
+
+// crc24 computes the checksum of ASCII armored data as defined in RFC 4880, section 6.1
+func crc24(data []byte) uint32 {
+	const crc24Init, crc24Poly = 0xb704ce, 0x1864cfb
+	crc := uint32(crc24Init)
+	for _, b := range data {
+		crc ^= uint32(b) << 16
+		for range 8 {
+			crc <<= 1
+			if crc&0x1000000 != 0 {
+				crc ^= crc24Poly
+			}
+		}
+	}
+	return crc & 0xffffff
+}
+
+// validateOpenPGPKey checks that the data is a transferable public key with a user ID of the
+// email address
+func validateOpenPGPKey(key []byte, email string) error {
+	var userIDs []string
+	for first := true; len(key) > 0; first = false {
+		tag, body, rest, err := nextOpenPGPPacket(key)
+		if err != nil {
+			return err
+		}
+		if first && tag != openPGPTagPublicKey {
+			return fmt.Errorf("public key must start with a public key packet, not packet type %d", tag)
+		}
+		if tag == openPGPTagUserID {
+			userIDs = append(userIDs, string(body))
+		}
+		key = rest
+	}
+
+	for _, userID := range userIDs {
+		if strings.Contains(strings.ToLower(userID), strings.ToLower(email)) {
+			return nil
+		}
+	}
+	return fmt.Errorf("public key has no user ID of %s", email)
+}
+
+// nextOpenPGPPacket splits the first packet of old or new format off the data
+func nextOpenPGPPacket(data []byte) (tag byte, body, rest []byte, err error) {
+	header := data[0]
+	if header&0x80 == 0 {
+		return 0, nil, nil, errors.New("invalid OpenPGP packet header")
+	}
+
+	var length, offset int
+	if header&0x40 == 0 {
+		// Old format packet with the length type in the lowest bits
+		tag = (header >> 2) & 0x0f
+		switch header & 0x03 {
+		case 0:
+			if len(data) >= 2 {
+				length, offset = int(data[1]), 2
+			}
+		case 1:
+			if len(data) >= 3 {
+				length, offset = int(binary.BigEndian.Uint16(data[1:])), 3
+			}
+		case 2:
+			if len(data) >= 5 {
+				length, offset = int(binary.BigEndian.Uint32(data[1:])), 5
+			}
+		default:
+			return 0, nil, nil, errors.New("OpenPGP packets of indeterminate length are not supported")
+		}
+	} else {
+		tag = header & 0x3f
+		if len(data) >= 2 {
+			switch first := int(data[1]); {
+			case first < 192:
+				length, offset = first, 2
+			case first < 224:
+				if len(data) >= 3 {
+					length, offset = (first-192)<<8+int(data[2])+192, 3
+				}
+			case first == 255:
+				if len(data) >= 6 {
+					length, offset = int(binary.BigEndian.Uint32(data[2:])), 6
+				}
+			default:
+				return 0, nil, nil, errors.New("OpenPGP packets of partial length are not supported")
+			}
+		}
+	}
+
+	if offset == 0 || len(data) < offset+length {
+		return 0, nil, nil, errors.New("truncated OpenPGP packet")
+	}
+	return tag, data[offset : offset+length], data[offset+length:], nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Error categories that API errors can be matched against with errors.Is
+var (
+	ErrNotFound           = errors.New("not found")
+	ErrAuthFailed         = errors.New("authentication failed")
+	ErrRateLimited        = errors.New("rate limit exceeded")
+	ErrInvalidFormat      = errors.New("invalid format")
+	ErrDomainInaccessible = errors.New("domain not found or not accessible")
+)
+
+// Netcup API status codes the provider reacts to
+const (
+	statusCodeInvalidCredentials  = 2011
+	statusCodeDomainNotFound      = 2016
+	statusCodeCustomerNotFound    = 2029
+	statusCodeRateLimited         = 2057
+	statusCodeSessionExpired      = 4001
+	statusCodeInvalidRecordFormat = 4013
+)
+
+// NetcupAPIError is returned when the Netcup API answers a request with a non-success status
+type NetcupAPIError struct {
+	Action          string
+	StatusCode      int
+	ShortMessage    string
+	LongMessage     string
+	ServerRequestID string
+}
+
+// newAPIError builds the error for an unsuccessful response to the given action
+func newAPIError(action string, response *NetcupAPIResponse) *NetcupAPIError {
+	if response.Action != "" {
+		action = response.Action
+	}
+
+	return &NetcupAPIError{
+		Action:          action,
+		StatusCode:      response.StatusCode,
+		ShortMessage:    response.ShortMessage,
+		LongMessage:     response.LongMessage,
+		ServerRequestID: response.ServerRequestID,
+	}
+}
+
+// Error implements the error interface
+func (e *NetcupAPIError) Error() string {
+	return fmt.Sprintf("%s failed: %s (status code: %d)", e.Action, e.message(), e.StatusCode)
+}
+
+// message returns an explanation of the status, preferring hints for well-known codes
+func (e *NetcupAPIError) message() string {
+	switch e.StatusCode {
+	case statusCodeInvalidCredentials:
+		return "Invalid API credentials (API key or password incorrect)"
+	case statusCodeCustomerNotFound:
+		return "Customer account not found (check customer ID)"
+	case statusCodeRateLimited:
+		return "Rate limit exceeded (more than 180 requests per minute). Please wait and retry later"
+	case statusCodeInvalidRecordFormat:
+		return "The DNS records are not in valid format. Check record type, hostname format, and destination value"
+	case statusCodeDomainNotFound:
+		return "Domain not found or not accessible with current credentials"
+	}
+
+	if e.LongMessage != "" {
+		return e.LongMessage
+	}
+	return e.ShortMessage
+}
+
+// Is reports whether the error belongs to one of the sentinel categories
+func (e *NetcupAPIError) Is(target error) bool {
+	switch e.StatusCode {
+	case statusCodeInvalidCredentials, statusCodeCustomerNotFound:
+		return target == ErrAuthFailed
+	case statusCodeRateLimited:
+		return target == ErrRateLimited
+	case statusCodeInvalidRecordFormat:
+		return target == ErrInvalidFormat
+	case statusCodeDomainNotFound:
+		return target == ErrDomainInaccessible
+	case statusCodeSessionExpired:
+		return target == errSessionExpired
+	}
+	return false
+}
@@ -0,0 +1,182 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// GetDNSRecords looks up the DNS records of a Netcup domain, including records not managed by Pulumi.
+type GetDNSRecords struct{}
+
+// Annotate provides metadata about the getDnsRecords function.
+func (f *GetDNSRecords) Annotate(a infer.Annotator) {
+	a.SetToken("index", "getDnsRecords")
+	a.Describe(&f, "Returns the DNS records of a domain, optionally filtered by hostname, type or value. "+
+		"Records created outside of Pulumi, such as by the hosting panel, are included")
+}
+
+// GetDNSRecordsArgs contains the input arguments for the getDnsRecords function.
+type GetDNSRecordsArgs struct {
+	Domain     string  `pulumi:"domain"`
+	Name       *string `pulumi:"name,optional"`
+	Type       *string `pulumi:"type,optional"`
+	ValueRegex *string `pulumi:"valueRegex,optional"`
+}
+
+// Annotate provides metadata about the GetDNSRecordsArgs.
+func (args *GetDNSRecordsArgs) Annotate(a infer.Annotator) {
+	a.Describe(&args.Domain, "The domain name to look up the DNS records of (e.g., 'example.com')")
+	a.Describe(&args.Name, "Only return records of this hostname. Use '@' for root domain")
+	a.Describe(&args.Type, "Only return records of this DNS record type")
+	a.Describe(&args.ValueRegex, "Only return records whose value/destination matches this regular expression")
+}
+
+// GetDNSRecordsResult contains the result of the getDnsRecords function.
+type GetDNSRecordsResult struct {
+	Domain  string           `pulumi:"domain"`
+	Records []DNSRecordEntry `pulumi:"records"`
+}
+
+// Annotate provides metadata about the GetDNSRecordsResult.
+func (result *GetDNSRecordsResult) Annotate(a infer.Annotator) {
+	a.Describe(&result.Domain, "The domain name the DNS records belong to")
+	a.Describe(&result.Records, "The DNS records matching the filters, in the order Netcup returns them")
+}
+
+// DNSRecordEntry is a single DNS record returned by getDnsRecords.
+type DNSRecordEntry struct {
+	RecordID string  `pulumi:"recordId"`
+	Name     string  `pulumi:"name"`
+	Type     string  `pulumi:"type"`
+	Value    string  `pulumi:"value"`
+	Priority *string `pulumi:"priority,optional"`
+	FQDN     string  `pulumi:"fqdn"`
+}
+
+// Annotate provides metadata about the DNSRecordEntry.
+func (entry *DNSRecordEntry) Annotate(a infer.Annotator) {
+	a.Describe(&entry.RecordID, "The Netcup record ID")
+	a.Describe(&entry.Name, "The hostname of the DNS record")
+	a.Describe(&entry.Type, "The DNS record type")
+	a.Describe(&entry.Value, "The value/destination of the DNS record")
+	a.Describe(&entry.Priority, "The priority of MX and SRV records")
+	a.Describe(&entry.FQDN, "The fully qualified domain name")
+}
+
+// Invoke looks up the records of the domain and applies the filters.
+func (f *GetDNSRecords) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[GetDNSRecordsArgs],
+) (infer.FunctionResponse[GetDNSRecordsResult], error) {
+	filter, err := newDNSRecordFilter(req.Input)
+	if err != nil {
+		return infer.FunctionResponse[GetDNSRecordsResult]{}, err
+	}
+
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.netcupClient()
+	if err != nil {
+		return infer.FunctionResponse[GetDNSRecordsResult]{}, err
+	}
+
+	records, err := client.getAllDNSRecords(ctx, filter.domain)
+	if err != nil {
+		return infer.FunctionResponse[GetDNSRecordsResult]{},
+			fmt.Errorf("failed to get DNS records of %s: %w", filter.domain, err)
+	}
+
+	result := GetDNSRecordsResult{
+		Domain:  filter.domain,
+		Records: make([]DNSRecordEntry, 0, len(records)),
+	}
+	for _, record := range records {
+		if !filter.matches(record) {
+			continue
+		}
+		zoneRecord := dnsZoneRecordFromInfo(record)
+		result.Records = append(result.Records, DNSRecordEntry{
+			RecordID: record.ID,
+			Name:     zoneRecord.Name,
+			Type:     zoneRecord.Type,
+			Value:    zoneRecord.Value,
+			Priority: zoneRecord.Priority,
+			FQDN:     buildFQDN(zoneRecord.Name, filter.domain),
+		})
+	}
+
+	return infer.FunctionResponse[GetDNSRecordsResult]{Output: result}, nil
+}
+
+// dnsRecordFilter holds the normalized filters of a getDnsRecords call
+type dnsRecordFilter struct {
+	domain     string
+	name       string
+	recordType string
+	value      *regexp.Regexp
+}
+
+// newDNSRecordFilter normalizes the arguments the same way as the DNSRecord inputs and
+// validates them
+func newDNSRecordFilter(args GetDNSRecordsArgs) (dnsRecordFilter, error) {
+	record := DNSRecordArgs{Domain: args.Domain}
+	if args.Name != nil {
+		record.Name = *args.Name
+	}
+	if args.Type != nil {
+		record.Type = *args.Type
+	}
+	record = normalizeInputs(record)
+
+	filter := dnsRecordFilter{domain: record.Domain}
+	if !isValidDomain(filter.domain) {
+		return dnsRecordFilter{}, fmt.Errorf("invalid domain name: %q", args.Domain)
+	}
+	if args.Name != nil {
+		filter.name = record.Name
+	}
+	if args.Type != nil && record.Type != "" {
+		if !getValidTypesMap()[record.Type] {
+			return dnsRecordFilter{}, fmt.Errorf("unsupported DNS record type: %s. Valid types are: %v",
+				record.Type, getValidTypesList())
+		}
+		filter.recordType = record.Type
+	}
+	if args.ValueRegex != nil {
+		value, err := regexp.Compile(*args.ValueRegex)
+		if err != nil {
+			return dnsRecordFilter{}, fmt.Errorf("invalid value regular expression: %w", err)
+		}
+		filter.value = value
+	}
+
+	return filter, nil
+}
+
+// matches reports whether a record passes all filters
+func (f dnsRecordFilter) matches(record *DNSRecordInfo) bool {
+	if f.name != "" && !strings.EqualFold(record.Hostname, f.name) {
+		return false
+	}
+	if f.recordType != "" && !strings.EqualFold(record.Type, f.recordType) {
+		return false
+	}
+	return f.value == nil || f.value.MatchString(record.Destination)
+}
@@ -0,0 +1,105 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+
+	"github.com/blackdark/pulumi-netcup/provider/netcuptest"
+)
+
+func TestNewDNSRecordFilter(t *testing.T) {
+	t.Parallel()
+	filter, err := newDNSRecordFilter(GetDNSRecordsArgs{
+		Domain: " Example.COM ",
+		Name:   stringPtr(" "),
+		Type:   stringPtr("mx"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, dnsRecordFilter{domain: "example.com", name: "@", recordType: "MX"}, filter)
+
+	_, err = newDNSRecordFilter(GetDNSRecordsArgs{Domain: "example.com", Type: stringPtr("SPF")})
+	assert.ErrorContains(t, err, "unsupported DNS record type")
+
+	_, err = newDNSRecordFilter(GetDNSRecordsArgs{Domain: "example.com", ValueRegex: stringPtr("(")})
+	assert.ErrorContains(t, err, "invalid value regular expression")
+
+	_, err = newDNSRecordFilter(GetDNSRecordsArgs{Domain: "localhost"})
+	assert.ErrorContains(t, err, "invalid domain name")
+}
+
+func TestGetDnsRecords(t *testing.T) {
+	t.Parallel()
+	server, netcup := newTestProviderServer(t)
+	netcup.AddRecord("example.com", netcuptest.Record{Hostname: "@", Type: "A", Destination: "1.2.3.4"})
+	mailID := netcup.AddRecord("example.com", netcuptest.Record{
+		Hostname: "@", Type: "MX", Destination: "mail.example.com", Priority: "10",
+	})
+	netcup.AddRecord("example.com", netcuptest.Record{Hostname: "www", Type: "A", Destination: "5.6.7.8"})
+	netcup.AddRecord("example.com", netcuptest.Record{Hostname: "www", Type: "A", Destination: "1.2.3.5"})
+
+	getDNSRecords := func(args map[string]property.Value) []property.Map {
+		response, err := server.Invoke(p.InvokeRequest{
+			Token: "netcup:index:getDnsRecords",
+			Args:  property.NewMap(args),
+		})
+		require.NoError(t, err)
+		require.Empty(t, response.Failures)
+
+		var records []property.Map
+		for _, record := range response.Return.Get("records").AsArray().AsSlice() {
+			records = append(records, record.AsMap())
+		}
+		return records
+	}
+	values := func(records []property.Map) []string {
+		var values []string
+		for _, record := range records {
+			values = append(values, record.Get("fqdn").AsString()+" "+record.Get("value").AsString())
+		}
+		return values
+	}
+
+	all := getDNSRecords(map[string]property.Value{"domain": property.New("Example.com")})
+	assert.Len(t, all, 4)
+
+	mx := getDNSRecords(map[string]property.Value{
+		"domain": property.New("example.com"),
+		"type":   property.New("mx"),
+	})
+	require.Len(t, mx, 1)
+	assert.Equal(t, mailID, mx[0].Get("recordId").AsString())
+	assert.Equal(t, "10", mx[0].Get("priority").AsString())
+
+	www := getDNSRecords(map[string]property.Value{
+		"domain":     property.New("example.com"),
+		"name":       property.New("WWW"),
+		"valueRegex": property.New(`^1\.`),
+	})
+	assert.Equal(t, []string{"www.example.com 1.2.3.5"}, values(www))
+
+	root := getDNSRecords(map[string]property.Value{
+		"domain": property.New("example.com"),
+		"name":   property.New(""),
+		"type":   property.New("A"),
+	})
+	assert.Equal(t, []string{"example.com 1.2.3.4"}, values(root))
+}
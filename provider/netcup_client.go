@@ -16,6 +16,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -37,6 +38,15 @@ type NetcupClient struct {
 	customerID  string
 	httpClient  *http.Client
 	endpoint    string
+	userAgent   string
+	nameserver  string
+	session     *sessionManager
+	limiter     *rateLimiter
+
+	maxRetries     int
+	retryBaseDelay time.Duration
+	retryMaxDelay  time.Duration
+	batchWindow    time.Duration
 }
 
 // ClientOption represents a functional option for configuring NetcupClient
@@ -56,6 +66,42 @@ func WithHTTPClient(client *http.Client) ClientOption {
 	}
 }
 
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *NetcupClient) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithNameserver sets the nameserver DNSKEY records of signed zones are looked up on
+func WithNameserver(address string) ClientOption {
+	return func(c *NetcupClient) {
+		c.nameserver = address
+	}
+}
+
+// WithMaxRetries sets how often a rate-limited or transiently failed request is retried
+func WithMaxRetries(maxRetries int) ClientOption {
+	return func(c *NetcupClient) {
+		c.maxRetries = maxRetries
+	}
+}
+
+// WithBatchWindow sets how long record changes for a zone are collected before they are written together
+func WithBatchWindow(window time.Duration) ClientOption {
+	return func(c *NetcupClient) {
+		c.batchWindow = window
+	}
+}
+
+// requestTimeout returns the timeout of a single request, as configured on the HTTP client
+func (c *NetcupClient) requestTimeout() time.Duration {
+	if c.httpClient.Timeout > 0 {
+		return c.httpClient.Timeout
+	}
+	return APITimeout
+}
+
 // NetcupAPIRequest represents the structure of API requests
 type NetcupAPIRequest struct {
 	Action string      `json:"action"`
@@ -64,14 +110,14 @@ type NetcupAPIRequest struct {
 
 // NetcupAPIResponse represents the structure of API responses
 type NetcupAPIResponse struct {
-	ServerRequestID string      `json:"serverrequestid"`
-	ClientRequestID string      `json:"clientrequestid"`
-	Action          string      `json:"action"`
-	Status          string      `json:"status"`
-	StatusCode      int         `json:"statuscode"`
-	ShortMessage    string      `json:"shortmessage"`
-	LongMessage     string      `json:"longmessage"`
-	ResponseData    interface{} `json:"responsedata"`
+	ServerRequestID string          `json:"serverrequestid"`
+	ClientRequestID string          `json:"clientrequestid"`
+	Action          string          `json:"action"`
+	Status          string          `json:"status"`
+	StatusCode      int             `json:"statuscode"`
+	ShortMessage    string          `json:"shortmessage"`
+	LongMessage     string          `json:"longmessage"`
+	ResponseData    json.RawMessage `json:"responsedata"`
 }
 
 // DNSRecordInfo represents DNS record information from the API
@@ -85,6 +131,13 @@ type DNSRecordInfo struct {
 	State        string `json:"state,omitempty"`
 }
 
+// sessionAuth holds the authentication parameters shared by all session-bound API calls
+type sessionAuth struct {
+	CustomerNumber string `json:"customernumber"`
+	APIKey         string `json:"apikey"`
+	SessionID      string `json:"apisessionid"`
+}
+
 // LoginParams represents login parameters
 type LoginParams struct {
 	CustomerNumber string `json:"customernumber"`
@@ -101,7 +154,14 @@ func NewNetcupClient(apiKey, apiPassword, customerID string, opts ...ClientOptio
 		httpClient: &http.Client{
 			Timeout: APITimeout,
 		},
-		endpoint: NetcupAPIEndpoint,
+		endpoint:       NetcupAPIEndpoint,
+		userAgent:      defaultUserAgent(),
+		nameserver:     DefaultNameserver,
+		limiter:        apiLimiter,
+		maxRetries:     DefaultMaxRetries,
+		retryBaseDelay: DefaultRetryBaseDelay,
+		retryMaxDelay:  DefaultRetryMaxDelay,
+		batchWindow:    DefaultBatchWindow,
 	}
 
 	// Apply options
@@ -109,10 +169,20 @@ func NewNetcupClient(apiKey, apiPassword, customerID string, opts ...ClientOptio
 		opt(client)
 	}
 
+	client.session = sharedSession(client)
+
 	return client
 }
 
-func (c *NetcupClient) login() (string, error) {
+// defaultUserAgent identifies the provider and its version to the Netcup API
+func defaultUserAgent() string {
+	if Version == "" {
+		return "pulumi-netcup"
+	}
+	return "pulumi-netcup/" + Version
+}
+
+func (c *NetcupClient) login(ctx context.Context) (string, error) {
 	params := LoginParams{
 		CustomerNumber: c.customerID,
 		APIKey:         c.apiKey,
@@ -124,423 +194,519 @@ func (c *NetcupClient) login() (string, error) {
 		Param:  params,
 	}
 
-	response, err := c.makeAPICall(request)
+	response, err := c.makeAPICall(ctx, request)
 	if err != nil {
-		return "", fmt.Errorf("login failed: %w", err)
-	}
-
-	if response.Status != "success" {
-		switch response.StatusCode {
-		case 2011:
-			return "", errors.New("login failed: Invalid API credentials (API key or password incorrect)")
-		case 2029:
-			return "", errors.New("login failed: Customer account not found (check customer ID)")
-		case 2057:
-			return "", errors.New("login failed: Rate limit exceeded (more than 180 requests per minute). " +
-				"Please wait and retry later")
-		default:
-			return "", fmt.Errorf("login failed: %s (status code: %d)", response.LongMessage, response.StatusCode)
+		var apiErr *NetcupAPIError
+		if errors.As(err, &apiErr) {
+			return "", err
 		}
+		return "", fmt.Errorf("login failed: %w", err)
 	}
 
-	sessionData, ok := response.ResponseData.(map[string]interface{})
-	if !ok {
-		return "", errors.New("login failed: invalid response format")
+	var data loginResponseData
+	if err := decodeResponseData(response, &data); err != nil {
+		return "", fmt.Errorf("login failed: %w", err)
 	}
 
-	sessionID, ok := sessionData["apisessionid"].(string)
-	if !ok {
+	if data.APISessionID == "" {
 		return "", errors.New("login failed: no session ID returned in response")
 	}
 
-	return sessionID, nil
+	return string(data.APISessionID), nil
 }
 
 // logout logs out from the Netcup API session
-func (c *NetcupClient) logout(sessionID string) error {
-	logoutParams := struct {
-		CustomerNumber string `json:"customernumber"`
-		APIKey         string `json:"apikey"`
-		SessionID      string `json:"apisessionid"`
-	}{
-		CustomerNumber: c.customerID,
-		APIKey:         c.apiKey,
-		SessionID:      sessionID,
-	}
-
+func (c *NetcupClient) logout(ctx context.Context, sessionID string) error {
 	request := NetcupAPIRequest{
 		Action: "logout",
-		Param:  logoutParams,
+		Param:  c.sessionAuth(sessionID),
 	}
 
-	_, err := c.makeAPICall(request)
+	_, err := c.makeAPICall(ctx, request)
 	return err
 }
 
-func (c *NetcupClient) makeAPICall(request NetcupAPIRequest) (*NetcupAPIResponse, error) {
+// makeAPICall sends a request through the shared rate limiter. Rate limiting (status 2057) is
+// retried with exponential backoff until the context is done, as are 5xx responses, connection
+// resets and timeouts of read-only actions. Such failures of writes are returned wrapping
+// errWriteUnconfirmed for the caller to reconcile. Unsuccessful responses are returned as
+// *NetcupAPIError.
+func (c *NetcupClient) makeAPICall(ctx context.Context, request NetcupAPIRequest) (*NetcupAPIResponse, error) {
 	jsonData, err := json.Marshal(request)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	resp, err := c.httpClient.Post(c.endpoint, "application/json", bytes.NewBuffer(jsonData))
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			if err := sleepContext(ctx, backoff(attempt, c.retryBaseDelay, c.retryMaxDelay)); err != nil {
+				return nil, err
+			}
+		}
+
+		response, retryable, err := c.doAPICall(ctx, request.Action, jsonData)
+		if !retryable || attempt >= c.maxRetries {
+			return response, err
+		}
+	}
+}
+
+// doAPICall performs a single HTTP round trip and reports whether a failure may be retried
+func (c *NetcupClient) doAPICall(
+	ctx context.Context,
+	action string,
+	jsonData []byte,
+) (*NetcupAPIResponse, bool, error) {
+	if err := c.limiter.wait(ctx); err != nil {
+		return nil, false, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(jsonData))
 	if err != nil {
-		return nil, fmt.Errorf("HTTP request failed: %w", err)
+		return nil, false, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		// Cancellation by the caller is final, even though it surfaces like a timeout
+		if ctx.Err() != nil || !isRetryableHTTPError(err) {
+			return nil, false, fmt.Errorf("HTTP request failed: %w", err)
+		}
+		return transientFailure(action, fmt.Errorf("HTTP request failed: %w", err))
 	}
 	defer func() {
 		_ = resp.Body.Close()
 	}()
 
+	if isRetryableHTTPStatus(resp.StatusCode) {
+		return transientFailure(action, fmt.Errorf("HTTP request failed: %s", resp.Status))
+	}
+
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		if ctx.Err() != nil || !isRetryableHTTPError(err) {
+			return nil, false, fmt.Errorf("failed to read response: %w", err)
+		}
+		return transientFailure(action, fmt.Errorf("failed to read response: %w", err))
 	}
 
 	var apiResponse NetcupAPIResponse
 	if err := json.Unmarshal(body, &apiResponse); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		return nil, false, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	if apiResponse.Status != "success" {
+		return nil, apiResponse.StatusCode == statusCodeRateLimited, newAPIError(action, &apiResponse)
 	}
 
-	return &apiResponse, nil
+	return &apiResponse, false, nil
 }
 
-// CreateDNSRecord creates a new DNS record in the specified domain
-func (c *NetcupClient) CreateDNSRecord(domain, name, recordType, value, priority string) (string, error) {
-	sessionID, err := c.login()
+// sessionAuth returns the authentication parameters for the given session
+func (c *NetcupClient) sessionAuth(sessionID string) sessionAuth {
+	return sessionAuth{
+		CustomerNumber: c.customerID,
+		APIKey:         c.apiKey,
+		SessionID:      sessionID,
+	}
+}
+
+// makeSessionCall performs an API call on the shared session, logging in if needed.
+// If Netcup reports the session as expired, it logs in again and retries once.
+func (c *NetcupClient) makeSessionCall(
+	ctx context.Context,
+	action string,
+	params func(auth sessionAuth) interface{},
+) (*NetcupAPIResponse, error) {
+	sessionID, err := c.session.acquire(ctx, c.login)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	defer func() {
-		_ = c.logout(sessionID)
-	}()
 
-	existingRecords, err := c.getAllDNSRecords(sessionID, domain)
+	response, err := c.makeAPICall(ctx, NetcupAPIRequest{Action: action, Param: params(c.sessionAuth(sessionID))})
+	if !errors.Is(err, errSessionExpired) {
+		return response, err
+	}
+
+	c.session.invalidate(sessionID)
+	sessionID, err = c.session.acquire(ctx, c.login)
 	if err != nil {
-		return "", fmt.Errorf("failed to get existing DNS records: %w", err)
+		return nil, err
 	}
 
+	return c.makeAPICall(ctx, NetcupAPIRequest{Action: action, Param: params(c.sessionAuth(sessionID))})
+}
+
+// CreateDNSRecord creates a new DNS record in the specified domain.
+// Changes to the same domain that arrive within the batch window are written together.
+func (c *NetcupClient) CreateDNSRecord(
+	ctx context.Context,
+	domain, name, recordType, value, priority string,
+) (string, error) {
 	newRecord := &DNSRecordInfo{
 		Hostname:    name,
 		Type:        recordType,
 		Destination: value,
+		Priority:    priority,
 	}
 
-	if priority != "" {
-		newRecord.Priority = priority
-	}
-
-	existingRecords = append(existingRecords, newRecord)
-	allRecords := existingRecords
-
-	err = c.updateAllDNSRecords(sessionID, domain, allRecords)
+	recordID, err := c.submitRecordChange(ctx, domain, &recordChange{
+		apply: func(records []*DNSRecordInfo) ([]*DNSRecordInfo, error) {
+			recordCopy := *newRecord
+			return append(records, &recordCopy), nil
+		},
+		created: newRecord,
+	})
 	if err != nil {
 		return "", fmt.Errorf("failed to create DNS record: %w", err)
 	}
 
-	updatedRecords, err := c.getAllDNSRecords(sessionID, domain)
-	if err != nil {
-		return "", fmt.Errorf("failed to get updated DNS records to find new record ID: %w", err)
-	}
-
-	for _, record := range updatedRecords {
-		match := record.Hostname == name && record.Type == recordType && record.Destination == value
-		if recordType == "MX" && priority != "" {
-			match = match && record.Priority == priority
-		}
-
-		if match && record.ID != "" {
-			return record.ID, nil
-		}
-	}
-
-	return "", errors.New("DNS record was created successfully but no record ID was found. " +
-		"This may indicate an API issue")
+	return recordID, nil
 }
 
 // DeleteDNSRecord deletes a DNS record from the specified domain
-func (c *NetcupClient) DeleteDNSRecord(recordID, domain string) error {
-	sessionID, err := c.login()
-	if err != nil {
-		return err
-	}
-	defer func() {
-		_ = c.logout(sessionID)
-	}()
+func (c *NetcupClient) DeleteDNSRecord(ctx context.Context, recordID, domain string) error {
+	_, err := c.submitRecordChange(ctx, domain, &recordChange{
+		apply: func(records []*DNSRecordInfo) ([]*DNSRecordInfo, error) {
+			for _, record := range records {
+				if record.ID == recordID {
+					record.DeleteRecord = true
+					return records, nil
+				}
+			}
 
-	existingRecords, err := c.getAllDNSRecords(sessionID, domain)
+			// Already gone, nothing to write
+			return nil, nil
+		},
+	})
 	if err != nil {
-		return fmt.Errorf("failed to get existing DNS records: %w", err)
+		return fmt.Errorf("failed to delete DNS record: %w", err)
 	}
 
-	found := false
-	for _, record := range existingRecords {
-		if record.ID == recordID {
-			record.DeleteRecord = true
-			found = true
-			break
-		}
-	}
+	return nil
+}
 
-	if !found {
-		return nil
-	}
+// UpdateDNSRecord updates an existing DNS record in the specified domain
+func (c *NetcupClient) UpdateDNSRecord(
+	ctx context.Context,
+	recordID, domain, name, recordType, value, priority string,
+) error {
+	_, err := c.submitRecordChange(ctx, domain, &recordChange{
+		apply: func(records []*DNSRecordInfo) ([]*DNSRecordInfo, error) {
+			for _, record := range records {
+				if record.ID != recordID {
+					continue
+				}
+				record.Hostname = name
+				record.Type = recordType
+				record.Destination = value
+				record.Priority = priority
+				return records, nil
+			}
 
-	err = c.updateAllDNSRecords(sessionID, domain, existingRecords)
+			return nil, fmt.Errorf("DNS record %w: %s", ErrNotFound, recordID)
+		},
+	})
 	if err != nil {
-		return fmt.Errorf("failed to delete DNS record: %w", err)
+		return fmt.Errorf("failed to update DNS record: %w", err)
 	}
 
 	return nil
 }
 
-// UpdateDNSRecord updates an existing DNS record in the specified domain
-func (c *NetcupClient) UpdateDNSRecord(recordID, domain, name, recordType, value, priority string) error {
-	sessionID, err := c.login()
-	if err != nil {
-		return err
-	}
-	defer func() {
-		_ = c.logout(sessionID)
-	}()
+// modifyDNSRecords runs a read-modify-write cycle on the records of a domain. Cycles for the
+// same domain are serialized within the provider process. Right before writing, the zone is
+// read again and compared by fingerprint; if it changed, the change is recomputed on the new
+// record set instead of overwriting it. If the write fails in transit, the zone is read again
+// and the change is only recomputed and written again if the zone does not show it yet. The
+// change function returns the records to write, or nil if nothing needs to be written. The
+// record set the change was applied to is returned.
+func (c *NetcupClient) modifyDNSRecords(
+	ctx context.Context,
+	domain string,
+	change func(records []*DNSRecordInfo) ([]*DNSRecordInfo, error),
+) ([]*DNSRecordInfo, error) {
+	unlock := lockZone(zoneKey(c, domain))
+	defer unlock()
+
+	conflicts, writes := 0, 0
+	for {
+		records, err := c.getAllDNSRecords(ctx, domain)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get existing DNS records: %w", err)
+		}
+		fingerprint := fingerprintDNSRecords(records)
 
-	existingRecords, err := c.getAllDNSRecords(sessionID, domain)
-	if err != nil {
-		return fmt.Errorf("failed to get existing DNS records: %w", err)
-	}
+		existingRecords := make([]*DNSRecordInfo, 0, len(records))
+		for _, record := range records {
+			recordCopy := *record
+			existingRecords = append(existingRecords, &recordCopy)
+		}
 
-	found := false
-	for _, record := range existingRecords {
-		if record.ID != recordID {
+		updatedRecords, err := change(records)
+		if err != nil || updatedRecords == nil {
+			return existingRecords, err
+		}
+
+		currentRecords, err := c.getAllDNSRecords(ctx, domain)
+		if err != nil {
+			return nil, fmt.Errorf("failed to verify DNS records before update: %w", err)
+		}
+		if fingerprintDNSRecords(currentRecords) != fingerprint {
+			if conflicts >= maxZoneConflictRetries {
+				return nil, errZoneChanged
+			}
+			conflicts++
 			continue
 		}
-		record.Hostname = name
-		record.Type = recordType
-		record.Destination = value
-		if priority != "" {
-			record.Priority = priority
-		} else {
-			record.Priority = ""
+
+		err = c.updateAllDNSRecords(ctx, domain, updatedRecords)
+		if err == nil {
+			return existingRecords, nil
 		}
-		found = true
-		break
-	}
+		if !errors.Is(err, errWriteUnconfirmed) || writes >= c.maxRetries {
+			return nil, err
+		}
+		writes++
 
-	if !found {
-		return fmt.Errorf("DNS record not found: %s", recordID)
+		// Sending an update that Netcup applied before the connection failed again would add
+		// its new records twice
+		if err := sleepContext(ctx, backoff(writes, c.retryBaseDelay, c.retryMaxDelay)); err != nil {
+			return nil, err
+		}
+		currentRecords, err = c.getAllDNSRecords(ctx, domain)
+		if err != nil {
+			return nil, fmt.Errorf("failed to verify DNS records after update: %w", err)
+		}
+		if dnsRecordsApplied(existingRecords, updatedRecords, currentRecords) {
+			return existingRecords, nil
+		}
 	}
+}
 
-	err = c.updateAllDNSRecords(sessionID, domain, existingRecords)
-	if err != nil {
-		return fmt.Errorf("failed to update DNS record: %w", err)
+// dnsRecordsApplied reports whether the current records of a zone show an update written on top
+// of the existing records: deleted records are gone, updated records carry their new content and
+// every added record is present under an ID that did not exist before.
+func dnsRecordsApplied(existing, written, current []*DNSRecordInfo) bool {
+	existingIDs := make(map[string]bool, len(existing))
+	for _, record := range existing {
+		existingIDs[record.ID] = true
+	}
+	currentByID := make(map[string]*DNSRecordInfo, len(current))
+	for _, record := range current {
+		currentByID[record.ID] = record
+	}
+
+	claimedIDs := make(map[string]bool)
+	for _, record := range written {
+		switch {
+		case record.ID == "":
+			found := false
+			for _, candidate := range current {
+				if !existingIDs[candidate.ID] && !claimedIDs[candidate.ID] && sameDNSRecord(candidate, record) {
+					claimedIDs[candidate.ID] = true
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		case record.DeleteRecord:
+			if _, ok := currentByID[record.ID]; ok {
+				return false
+			}
+		default:
+			if have, ok := currentByID[record.ID]; !ok || !sameDNSRecord(have, record) {
+				return false
+			}
+		}
 	}
-
-	return nil
+	return true
 }
 
 // GetDNSRecordByID retrieves a DNS record by its ID from the specified domain
-func (c *NetcupClient) GetDNSRecordByID(recordID, domain string) (*DNSRecordInfo, error) {
-	sessionID, err := c.login()
+func (c *NetcupClient) GetDNSRecordByID(ctx context.Context, recordID, domain string) (*DNSRecordInfo, error) {
+	records, err := c.getAllDNSRecords(ctx, domain)
 	if err != nil {
 		return nil, err
 	}
-	defer func() {
-		_ = c.logout(sessionID)
-	}()
 
-	params := struct {
-		CustomerNumber string `json:"customernumber"`
-		APIKey         string `json:"apikey"`
-		SessionID      string `json:"apisessionid"`
-		DomainName     string `json:"domainname"`
-	}{
-		CustomerNumber: c.customerID,
-		APIKey:         c.apiKey,
-		SessionID:      sessionID,
-		DomainName:     domain,
+	for _, record := range records {
+		if record.ID == recordID {
+			return record, nil
+		}
 	}
 
-	request := NetcupAPIRequest{
-		Action: "infoDnsRecords",
-		Param:  params,
-	}
+	return nil, fmt.Errorf("DNS record %w: %s", ErrNotFound, recordID)
+}
 
-	response, err := c.makeAPICall(request)
+// GetDNSZone retrieves the zone settings of the specified domain
+func (c *NetcupClient) GetDNSZone(ctx context.Context, domain string) (*DNSZoneInfo, error) {
+	response, err := c.makeSessionCall(ctx, "infoDnsZone", func(auth sessionAuth) interface{} {
+		return struct {
+			sessionAuth
+			DomainName string `json:"domainname"`
+		}{
+			sessionAuth: auth,
+			DomainName:  domain,
+		}
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if response.Status != "success" {
-		return nil, fmt.Errorf(
-			"get DNS records failed: %s (status code: %d)",
-			response.LongMessage,
-			response.StatusCode,
-		)
-	}
-
-	responseData, ok := response.ResponseData.(map[string]interface{})
-	if !ok {
-		return nil, errors.New("invalid DNS records response format")
-	}
-
-	recordsData, ok := responseData["dnsrecords"].([]interface{})
-	if !ok {
-		return nil, fmt.Errorf("no DNS records found in response (response structure: %+v)", responseData)
+	var zone DNSZoneInfo
+	if err := decodeResponseData(response, &zone); err != nil {
+		return nil, err
 	}
-	for _, recordData := range recordsData {
-		recordMap, ok := recordData.(map[string]interface{})
-		if !ok {
-			continue
-		}
-
-		id, _ := recordMap["id"].(string)
-		if id == recordID {
-			hostname, _ := recordMap["hostname"].(string)
-			recordType, _ := recordMap["type"].(string)
-			destination, _ := recordMap["destination"].(string)
-
-			record := &DNSRecordInfo{
-				ID:          id,
-				Hostname:    hostname,
-				Type:        recordType,
-				Destination: destination,
-			}
+	return &zone, nil
+}
 
-			if priority, ok := recordMap["priority"].(string); ok {
-				record.Priority = priority
+// UpdateDNSZone writes the zone settings of the specified domain and returns the settings
+// Netcup stored, including the new serial. A write that fails in transit is only sent again
+// if the zone does not show the settings yet.
+func (c *NetcupClient) UpdateDNSZone(ctx context.Context, domain string, zone *DNSZoneInfo) (*DNSZoneInfo, error) {
+	for writes := 0; ; writes++ {
+		response, err := c.makeSessionCall(ctx, "updateDnsZone", func(auth sessionAuth) interface{} {
+			return struct {
+				sessionAuth
+				DomainName string       `json:"domainname"`
+				DNSZone    *DNSZoneInfo `json:"dnszone"`
+			}{
+				sessionAuth: auth,
+				DomainName:  domain,
+				DNSZone:     zone,
 			}
-			if state, ok := recordMap["state"].(string); ok {
-				record.State = state
+		})
+		if err == nil {
+			var updated DNSZoneInfo
+			if err := decodeResponseData(response, &updated); err != nil {
+				return nil, err
 			}
-			return record, nil
+			return &updated, nil
+		}
+		if !errors.Is(err, errWriteUnconfirmed) || writes >= c.maxRetries {
+			return nil, err
 		}
-	}
 
-	return nil, fmt.Errorf("DNS record not found: %s", recordID)
+		// The settings are only sent again if Netcup did not store them before the connection failed
+		if err := sleepContext(ctx, backoff(writes+1, c.retryBaseDelay, c.retryMaxDelay)); err != nil {
+			return nil, err
+		}
+		current, err := c.GetDNSZone(ctx, domain)
+		if err != nil {
+			return nil, fmt.Errorf("failed to verify DNS zone after update: %w", err)
+		}
+		if sameZoneSettings(current, zone) {
+			return current, nil
+		}
+	}
 }
 
-// getAllDNSRecords retrieves all DNS records for a domain
-func (c *NetcupClient) getAllDNSRecords(sessionID, domain string) ([]*DNSRecordInfo, error) {
-	params := struct {
-		CustomerNumber string `json:"customernumber"`
-		APIKey         string `json:"apikey"`
-		SessionID      string `json:"apisessionid"`
-		DomainName     string `json:"domainname"`
-	}{
-		CustomerNumber: c.customerID,
-		APIKey:         c.apiKey,
-		SessionID:      sessionID,
-		DomainName:     domain,
-	}
+// sameZoneSettings reports whether two zones have the same writable settings
+func sameZoneSettings(a, b *DNSZoneInfo) bool {
+	return a.TTL == b.TTL && a.Refresh == b.Refresh && a.Retry == b.Retry &&
+		a.Expire == b.Expire && a.DNSSECStatus == b.DNSSECStatus
+}
 
-	request := NetcupAPIRequest{
-		Action: "infoDnsRecords",
-		Param:  params,
+// ListDomains retrieves all domains of the customer account
+func (c *NetcupClient) ListDomains(ctx context.Context) ([]*DomainInfo, error) {
+	response, err := c.makeSessionCall(ctx, "listallDomains", func(auth sessionAuth) interface{} {
+		return auth
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	response, err := c.makeAPICall(request)
-	if err != nil {
+	var data []*DomainInfo
+	if err := decodeResponseData(response, &data); err != nil {
 		return nil, err
 	}
 
-	if response.Status != "success" {
-		return nil, fmt.Errorf(
-			"get DNS records failed: %s (status code: %d)",
-			response.LongMessage,
-			response.StatusCode,
-		)
+	domains := make([]*DomainInfo, 0, len(data))
+	for _, domain := range data {
+		if domain != nil {
+			domains = append(domains, domain)
+		}
 	}
+	return domains, nil
+}
 
-	responseData, ok := response.ResponseData.(map[string]interface{})
-	if !ok {
-		return nil, errors.New("invalid DNS records response format")
+// GetDomainInfo retrieves the registration details of the specified domain
+func (c *NetcupClient) GetDomainInfo(ctx context.Context, domain string) (*DomainInfo, error) {
+	response, err := c.makeSessionCall(ctx, "infoDomain", func(auth sessionAuth) interface{} {
+		return struct {
+			sessionAuth
+			DomainName string `json:"domainname"`
+		}{
+			sessionAuth: auth,
+			DomainName:  domain,
+		}
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	recordsData, ok := responseData["dnsrecords"].([]interface{})
-	if !ok {
-		return nil, errors.New("no DNS records found in response")
+	var info DomainInfo
+	if err := decodeResponseData(response, &info); err != nil {
+		return nil, err
 	}
+	return &info, nil
+}
 
-	records := make([]*DNSRecordInfo, 0, len(recordsData))
-	for _, recordData := range recordsData {
-		recordMap, ok := recordData.(map[string]interface{})
-		if !ok {
-			continue
+// getAllDNSRecords retrieves all DNS records for a domain. An empty zone yields an empty slice.
+func (c *NetcupClient) getAllDNSRecords(ctx context.Context, domain string) ([]*DNSRecordInfo, error) {
+	response, err := c.makeSessionCall(ctx, "infoDnsRecords", func(auth sessionAuth) interface{} {
+		return struct {
+			sessionAuth
+			DomainName string `json:"domainname"`
+		}{
+			sessionAuth: auth,
+			DomainName:  domain,
 		}
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		id, _ := recordMap["id"].(string)
-		hostname, _ := recordMap["hostname"].(string)
-		recordType, _ := recordMap["type"].(string)
-		destination, _ := recordMap["destination"].(string)
-
-		record := &DNSRecordInfo{
-			ID:          id,
-			Hostname:    hostname,
-			Type:        recordType,
-			Destination: destination,
-		}
+	var data dnsRecordsResponseData
+	if err := decodeResponseData(response, &data); err != nil {
+		return nil, err
+	}
 
-		if priority, ok := recordMap["priority"].(string); ok {
-			record.Priority = priority
-		}
-		if state, ok := recordMap["state"].(string); ok {
-			record.State = state
+	records := make([]*DNSRecordInfo, 0, len(data.DNSRecords))
+	for _, record := range data.DNSRecords {
+		if record != nil {
+			records = append(records, record)
 		}
-
-		records = append(records, record)
 	}
 
 	return records, nil
 }
 
 // updateAllDNSRecords updates all DNS records for a domain
-func (c *NetcupClient) updateAllDNSRecords(sessionID, domain string, records []*DNSRecordInfo) error {
-	params := struct {
-		DomainName     string `json:"domainname"`
-		CustomerNumber string `json:"customernumber"`
-		APIKey         string `json:"apikey"`
-		SessionID      string `json:"apisessionid"`
-		DNSRecordSet   struct {
-			DNSRecords []*DNSRecordInfo `json:"dnsrecords"`
-		} `json:"dnsrecordset"`
-	}{
-		DomainName:     domain,
-		CustomerNumber: c.customerID,
-		APIKey:         c.apiKey,
-		SessionID:      sessionID,
-		DNSRecordSet: struct {
-			DNSRecords []*DNSRecordInfo `json:"dnsrecords"`
+func (c *NetcupClient) updateAllDNSRecords(ctx context.Context, domain string, records []*DNSRecordInfo) error {
+	_, err := c.makeSessionCall(ctx, "updateDnsRecords", func(auth sessionAuth) interface{} {
+		return struct {
+			sessionAuth
+			DomainName   string `json:"domainname"`
+			DNSRecordSet struct {
+				DNSRecords []*DNSRecordInfo `json:"dnsrecords"`
+			} `json:"dnsrecordset"`
 		}{
-			DNSRecords: records,
-		},
-	}
-
-	request := NetcupAPIRequest{
-		Action: "updateDnsRecords",
-		Param:  params,
-	}
-
-	response, err := c.makeAPICall(request)
-	if err != nil {
-		return err
-	}
-
-	if response.Status != "success" {
-		switch response.StatusCode {
-		case 4013:
-			return errors.New("update DNS records failed: The DNS records are not in valid format. " +
-				"Check record type, hostname format, and destination value")
-		case 2016:
-			return errors.New("update DNS records failed: Domain not found or not accessible with current credentials")
-		case 2057:
-			return errors.New("update DNS records failed: Rate limit exceeded. Please wait and retry later")
-		default:
-			return fmt.Errorf(
-				"update DNS records failed: %s (status code: %d)",
-				response.LongMessage,
-				response.StatusCode,
-			)
+			sessionAuth: auth,
+			DomainName:  domain,
+			DNSRecordSet: struct {
+				DNSRecords []*DNSRecordInfo `json:"dnsrecords"`
+			}{
+				DNSRecords: records,
+			},
 		}
-	}
-
-	return nil
+	})
+	return err
 }
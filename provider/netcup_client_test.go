@@ -15,13 +15,22 @@
 package provider
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/blackdark/pulumi-netcup/provider/netcuptest"
 )
 
 func TestNetcupClient_NewClient(t *testing.T) {
@@ -62,9 +71,9 @@ func TestNetcupClient_Login_Success(t *testing.T) {
 		response := NetcupAPIResponse{
 			Status:     "success",
 			StatusCode: 2000,
-			ResponseData: map[string]interface{}{
+			ResponseData: responseData(map[string]interface{}{
 				"apisessionid": "test-session-id",
-			},
+			}),
 		}
 
 		w.Header().Set("Content-Type", "application/json")
@@ -76,123 +85,85 @@ func TestNetcupClient_Login_Success(t *testing.T) {
 	client := NewNetcupClient("test-key", "test-password", "test-customer", WithEndpoint(server.URL))
 
 	// Test the actual login method
-	sessionID, err := client.login()
+	sessionID, err := client.login(t.Context())
 	require.NoError(t, err)
 	assert.Equal(t, "test-session-id", sessionID)
 }
 
 func TestNetcupClient_Login_InvalidCredentials(t *testing.T) {
 	t.Parallel()
-	// Mock server that returns login failure
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		response := NetcupAPIResponse{
-			Status:      "error",
-			StatusCode:  2011,
-			LongMessage: "Invalid API credentials",
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		_ = json.NewEncoder(w).Encode(response)
-	}))
-	defer server.Close()
-
-	// This test would require mocking the HTTP client, which is complex
-	// For now, we'll test the error handling logic in the login method
-
-	// Test different error scenarios
 	testCases := []struct {
 		statusCode int
 		expected   string
+		category   error
 	}{
-		{2011, "Invalid API credentials"},
-		{2029, "Customer account not found"},
-		{2057, "Rate limit exceeded"},
-		{9999, "Unknown error"},
+		{2011, "Invalid API credentials", ErrAuthFailed},
+		{2029, "Customer account not found", ErrAuthFailed},
+		{2057, "Rate limit exceeded", ErrRateLimited},
+		{9999, "Unknown error", nil},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.expected, func(t *testing.T) {
 			t.Parallel()
-			// Test the error message logic
-			var errorMsg string
-			switch tc.statusCode {
-			case 2011:
-				errorMsg = "login failed: Invalid API credentials (API key or password incorrect)"
-			case 2029:
-				errorMsg = "login failed: Customer account not found (check customer ID)"
-			case 2057:
-				errorMsg = "login failed: Rate limit exceeded (more than 180 requests per minute). Please wait and retry later"
-			default:
-				errorMsg = "login failed: Unknown error (status code: 9999)"
+			// Mock server that returns login failure
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				response := NetcupAPIResponse{
+					ServerRequestID: "server-request-id",
+					Action:          "login",
+					Status:          "error",
+					StatusCode:      tc.statusCode,
+					ShortMessage:    "Login failed",
+					LongMessage:     "Unknown error",
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(response)
+			}))
+			defer server.Close()
+
+			client := newTestClient(server.URL, WithMaxRetries(0))
+
+			_, err := client.login(t.Context())
+			require.Error(t, err)
+			assert.ErrorContains(t, err, "login failed: "+tc.expected)
+			assert.ErrorContains(t, err, fmt.Sprintf("(status code: %d)", tc.statusCode))
+
+			var apiErr *NetcupAPIError
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, "login", apiErr.Action)
+			assert.Equal(t, tc.statusCode, apiErr.StatusCode)
+			assert.Equal(t, "Login failed", apiErr.ShortMessage)
+			assert.Equal(t, "server-request-id", apiErr.ServerRequestID)
+
+			if tc.category != nil {
+				assert.ErrorIs(t, err, tc.category)
 			}
-
-			assert.Contains(t, errorMsg, tc.expected)
+			assert.NotErrorIs(t, err, ErrNotFound)
 		})
 	}
 }
 
 func TestNetcupClient_GetAllDnsRecords_ParseResponse(t *testing.T) {
 	t.Parallel()
-	// Test the parsing logic for DNS records response
-	mockResponseData := map[string]interface{}{
-		"dnsrecords": []interface{}{
-			map[string]interface{}{
-				"id":          "123456",
-				"hostname":    "@",
-				"type":        "A",
-				"priority":    "0",
-				"destination": "1.2.3.4",
-				"state":       "yes",
-			},
-			map[string]interface{}{
-				"id":          "123457",
-				"hostname":    "www",
-				"type":        "A",
-				"priority":    "0",
-				"destination": "1.2.3.4",
-				"state":       "yes",
-			},
-			map[string]interface{}{
-				"id":          "123458",
-				"hostname":    "@",
-				"type":        "MX",
-				"priority":    "10",
-				"destination": "mail.example.com",
-				"state":       "yes",
-			},
-		},
+	// IDs and priorities are not always sent as strings
+	response := &NetcupAPIResponse{
+		Action: "infoDnsRecords",
+		ResponseData: json.RawMessage(`{
+			"dnsrecords": [
+				{"id": "123456", "hostname": "@", "type": "A", "priority": "0",
+				 "destination": "1.2.3.4", "deleterecord": false, "state": "yes"},
+				{"id": 123457, "hostname": "www", "type": "A", "priority": 0,
+				 "destination": "1.2.3.4", "deleterecord": "false", "state": "yes"},
+				{"id": "123458", "hostname": "@", "type": "MX", "priority": 10,
+				 "destination": "mail.example.com", "state": "yes"}
+			]
+		}`),
 	}
 
-	// Test the parsing logic that would be used in getAllDnsRecords
-	recordsData, ok := mockResponseData["dnsrecords"].([]interface{})
-	require.True(t, ok)
-
-	records := make([]*DNSRecordInfo, 0, len(recordsData))
-	for _, recordData := range recordsData {
-		recordMap, ok := recordData.(map[string]interface{})
-		require.True(t, ok)
-
-		id, _ := recordMap["id"].(string)
-		hostname, _ := recordMap["hostname"].(string)
-		recordType, _ := recordMap["type"].(string)
-		destination, _ := recordMap["destination"].(string)
-
-		record := &DNSRecordInfo{
-			ID:          id,
-			Hostname:    hostname,
-			Type:        recordType,
-			Destination: destination,
-		}
-
-		if priority, ok := recordMap["priority"].(string); ok {
-			record.Priority = priority
-		}
-		if state, ok := recordMap["state"].(string); ok {
-			record.State = state
-		}
-
-		records = append(records, record)
-	}
+	var data dnsRecordsResponseData
+	require.NoError(t, decodeResponseData(response, &data))
+	records := data.DNSRecords
 
 	// Verify parsed records
 	require.Len(t, records, 3)
@@ -210,6 +181,8 @@ func TestNetcupClient_GetAllDnsRecords_ParseResponse(t *testing.T) {
 	assert.Equal(t, "www", records[1].Hostname)
 	assert.Equal(t, "A", records[1].Type)
 	assert.Equal(t, "1.2.3.4", records[1].Destination)
+	assert.Equal(t, "0", records[1].Priority)
+	assert.False(t, records[1].DeleteRecord)
 
 	// Check third record (MX record)
 	assert.Equal(t, "123458", records[2].ID)
@@ -217,38 +190,165 @@ func TestNetcupClient_GetAllDnsRecords_ParseResponse(t *testing.T) {
 	assert.Equal(t, "MX", records[2].Type)
 	assert.Equal(t, "mail.example.com", records[2].Destination)
 	assert.Equal(t, "10", records[2].Priority)
+
+	// Malformed data is reported instead of silently dropped
+	response.ResponseData = json.RawMessage(`{"dnsrecords": [{"id": {"nested": true}}]}`)
+	assert.ErrorContains(t, decodeResponseData(response, &data), "invalid infoDnsRecords response format")
+}
+
+func TestNetcupClient_GetAllDnsRecords_EmptyZone(t *testing.T) {
+	t.Parallel()
+	for _, data := range []string{`""`, `null`, `{}`, `{"dnsrecords": null}`, `{"dnsrecords": []}`} {
+		t.Run(data, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				response := NetcupAPIResponse{
+					Status:       "success",
+					StatusCode:   2000,
+					ResponseData: json.RawMessage(data),
+				}
+				if strings.Contains(readAction(r), "login") {
+					response.ResponseData = responseData(map[string]interface{}{"apisessionid": "empty-zone"})
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(response)
+			}))
+			defer server.Close()
+
+			client := newTestClient(server.URL)
+
+			records, err := client.getAllDNSRecords(t.Context(), "example.com")
+			require.NoError(t, err)
+			assert.NotNil(t, records)
+			assert.Empty(t, records)
+
+			_, err = client.GetDNSRecordByID(t.Context(), "1", "example.com")
+			require.ErrorIs(t, err, ErrNotFound)
+		})
+	}
+}
+
+func TestNetcupClient_GetDNSZone(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response := NetcupAPIResponse{
+			Status:       "success",
+			StatusCode:   2000,
+			ResponseData: responseData(map[string]interface{}{"apisessionid": "zone-info"}),
+		}
+		if readAction(r) == "infoDnsZone" {
+			response.ResponseData = json.RawMessage(`{
+				"name": "example.com", "ttl": "86400", "serial": 2025010101,
+				"refresh": "28800", "retry": 7200, "expire": "1209600", "dnssecstatus": "true"
+			}`)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewNetcupClient("test-key", "test-password", "test-customer", WithEndpoint(server.URL))
+
+	zone, err := client.GetDNSZone(t.Context(), "example.com")
+	require.NoError(t, err)
+	assert.Equal(t, DNSZoneInfo{
+		Name:         "example.com",
+		TTL:          "86400",
+		Serial:       "2025010101",
+		Refresh:      "28800",
+		Retry:        "7200",
+		Expire:       "1209600",
+		DNSSECStatus: true,
+	}, *zone)
+}
+
+func TestNetcupClient_GetDomainInfo(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response := NetcupAPIResponse{
+			Status:       "success",
+			StatusCode:   2000,
+			ResponseData: responseData(map[string]interface{}{"apisessionid": "domain-info"}),
+		}
+		if readAction(r) == "infoDomain" {
+			response.ResponseData = json.RawMessage(`{
+				"domainname": "example.com", "state": "active",
+				"nameserverentry": [{"hostname": "root-dns.netcup.net"}, {"hostname": "", "ipv4": "1.2.3.4"}],
+				"runtimestart": "2024-01-01", "runtimeend": "2026-01-01",
+				"assignedhandles": [{"type": "ownerc", "handle_id": 12345}]
+			}`)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewNetcupClient("test-key", "test-password", "test-customer", WithEndpoint(server.URL))
+
+	domain, err := client.GetDomainInfo(t.Context(), "example.com")
+	require.NoError(t, err)
+	assert.Equal(t, DomainInfo{
+		Name:            "example.com",
+		State:           "active",
+		Nameservers:     []string{"root-dns.netcup.net"},
+		RuntimeStart:    "2024-01-01",
+		RuntimeEnd:      "2026-01-01",
+		AssignedHandles: []DomainHandle{{Type: "ownerc", HandleID: "12345"}},
+	}, *domain)
+}
+
+// readAction returns the action of a mocked API request
+func readAction(r *http.Request) string {
+	var req NetcupAPIRequest
+	_ = json.NewDecoder(r.Body).Decode(&req)
+	return req.Action
 }
 
 func TestNetcupClient_ErrorHandling(t *testing.T) {
 	t.Parallel()
-	// Test error code handling logic
 	testCases := []struct {
 		statusCode int
-		operation  string
+		action     string
 		expected   string
+		category   error
 	}{
-		{4013, "update", "The DNS records are not in valid format"},
-		{2016, "create", "Domain not found or not accessible"},
-		{2057, "delete", "Rate limit exceeded"},
+		{4013, "updateDnsRecords", "The DNS records are not in valid format", ErrInvalidFormat},
+		{2016, "infoDnsRecords", "Domain not found or not accessible", ErrDomainInaccessible},
+		{2057, "updateDnsRecords", "Rate limit exceeded", ErrRateLimited},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.expected, func(t *testing.T) {
 			t.Parallel()
-			// Test the error message logic that would be used in various methods
-			var errorMsg string
-			switch tc.statusCode {
-			case 4013:
-				errorMsg = "The DNS records are not in valid format. Check record type, hostname format, and destination value"
-			case 2016:
-				errorMsg = "Domain not found or not accessible with current credentials"
-			case 2057:
-				errorMsg = "Rate limit exceeded. Please wait and retry later"
+			err := fmt.Errorf("wrapped: %w", newAPIError(tc.action, &NetcupAPIResponse{
+				Status:     "error",
+				StatusCode: tc.statusCode,
+			}))
+
+			assert.ErrorContains(t, err, tc.action+" failed: "+tc.expected)
+			assert.ErrorIs(t, err, tc.category)
+			for _, other := range []error{ErrNotFound, ErrAuthFailed, ErrRateLimited, ErrInvalidFormat, ErrDomainInaccessible} {
+				if other != tc.category {
+					assert.NotErrorIs(t, err, other)
+				}
 			}
-
-			assert.Contains(t, errorMsg, tc.expected)
 		})
 	}
+
+	// Messages without a dedicated hint fall back to the API's own description
+	err := newAPIError("infoDnsZone", &NetcupAPIResponse{StatusCode: 5029, ShortMessage: "Short", LongMessage: "Long"})
+	assert.EqualError(t, err, "infoDnsZone failed: Long (status code: 5029)")
+
+	err = newAPIError("infoDnsZone", &NetcupAPIResponse{StatusCode: 5029, ShortMessage: "Short"})
+	assert.EqualError(t, err, "infoDnsZone failed: Short (status code: 5029)")
+
+	// A missing record is reported through the not found category
+	recordErr := fmt.Errorf("DNS record %w: %s", ErrNotFound, "42")
+	assert.EqualError(t, recordErr, "DNS record not found: 42")
+	assert.ErrorIs(t, recordErr, ErrNotFound)
 }
 
 func TestNetcupClient_UpdateRecordLogic(t *testing.T) {
@@ -341,3 +441,267 @@ func TestNetcupClient_DeleteRecordLogic(t *testing.T) {
 	assert.Equal(t, "123457", filteredRecords[0].ID)
 	assert.Equal(t, "www", filteredRecords[0].Hostname)
 }
+
+func TestNetcupClient_SessionReuse(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	logins, logouts := 0, 0
+	expireNext := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Action string `json:"action"`
+			Param  struct {
+				SessionID string `json:"apisessionid"`
+			} `json:"param"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		response := NetcupAPIResponse{Status: "success", StatusCode: 2000}
+		switch req.Action {
+		case "login":
+			logins++
+			response.ResponseData = responseData(map[string]interface{}{"apisessionid": fmt.Sprintf("session-%d", logins)})
+		case "logout":
+			logouts++
+		case "infoDnsRecords":
+			if expireNext {
+				expireNext = false
+				response = NetcupAPIResponse{Status: "error", StatusCode: 4001}
+				break
+			}
+			assert.Equal(t, fmt.Sprintf("session-%d", logins), req.Param.SessionID)
+			response.ResponseData = responseData(map[string]interface{}{
+				"dnsrecords": []interface{}{
+					map[string]interface{}{"id": "1", "hostname": "www", "type": "A", "destination": "1.2.3.4"},
+				},
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewNetcupClient("test-key", "test-password", "test-customer", WithEndpoint(server.URL))
+	other := NewNetcupClient("test-key", "test-password", "test-customer", WithEndpoint(server.URL))
+	client.limiter = newRateLimiter(6000)
+	other.limiter = client.limiter
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := client.GetDNSRecordByID(t.Context(), "1", "example.com")
+			assert.NoError(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := other.GetDNSRecordByID(t.Context(), "1", "example.com")
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	mu.Lock()
+	assert.Equal(t, 1, logins, "concurrent calls should share one session")
+	expireNext = true
+	mu.Unlock()
+
+	// An expired session is replaced transparently
+	_, err := client.GetDNSRecordByID(t.Context(), "1", "example.com")
+	require.NoError(t, err)
+
+	require.NoError(t, client.session.close(t.Context(), client.logout))
+	require.NoError(t, client.session.close(t.Context(), client.logout))
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 2, logins)
+	assert.Equal(t, 1, logouts, "the session should be logged out exactly once")
+}
+
+func TestNetcupClient_RetriesTransientFailures(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	failures := []int{http.StatusServiceUnavailable, 2057, 2057}
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts++
+
+		response := NetcupAPIResponse{
+			Status:       "success",
+			StatusCode:   2000,
+			ResponseData: responseData(map[string]interface{}{"apisessionid": "test-session-id"}),
+		}
+		if len(failures) > 0 {
+			failure := failures[0]
+			failures = failures[1:]
+			if failure < 1000 {
+				w.WriteHeader(failure)
+				return
+			}
+			response = NetcupAPIResponse{Status: "error", StatusCode: failure}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewNetcupClient("test-key", "test-password", "test-customer", WithEndpoint(server.URL))
+	client.retryBaseDelay = time.Millisecond
+
+	sessionID, err := client.login(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "test-session-id", sessionID)
+	assert.Equal(t, 4, attempts)
+
+	// Once the retries are used up the rate limit error is surfaced
+	failures = []int{2057, 2057}
+	client = NewNetcupClient("test-key", "test-password", "test-customer",
+		WithEndpoint(server.URL), WithMaxRetries(1))
+	client.retryBaseDelay = time.Millisecond
+
+	_, err = client.login(t.Context())
+	require.ErrorContains(t, err, "Rate limit exceeded")
+}
+
+func TestNetcupClient_ReconcilesUnconfirmedWrites(t *testing.T) {
+	t.Parallel()
+	netcup := netcuptest.NewServer()
+	defer netcup.Close()
+	netcup.AddDomain("example.com")
+
+	// The first update is applied, but the connection drops before the answer arrives
+	var mu sync.Mutex
+	dropped := false
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if !assert.NoError(t, err) {
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		mu.Lock()
+		drop := !dropped && bytes.Contains(body, []byte(`"updateDnsRecords"`))
+		dropped = dropped || drop
+		mu.Unlock()
+		if !drop {
+			netcup.Config.Handler.ServeHTTP(w, r)
+			return
+		}
+
+		netcup.Config.Handler.ServeHTTP(httptest.NewRecorder(), r)
+		conn, _, err := http.NewResponseController(w).Hijack()
+		if assert.NoError(t, err) {
+			_ = conn.Close()
+		}
+	}))
+	defer proxy.Close()
+
+	client := NewNetcupClient(netcuptest.APIKey, netcuptest.APIPassword, netcuptest.CustomerNumber,
+		WithEndpoint(proxy.URL))
+	client.limiter = newRateLimiter(60000)
+	client.retryBaseDelay = time.Millisecond
+
+	id, err := client.CreateDNSRecord(t.Context(), "example.com", "www", "A", "1.2.3.4", "")
+	require.NoError(t, err)
+	assert.NotEmpty(t, id)
+	assert.Len(t, netcup.Records("example.com"), 1, "an applied write must not be sent again")
+	assert.Equal(t, 1, netcup.RequestCount("updateDnsRecords"))
+
+	// A write the server rejected before applying it is sent again
+	netcup.FailNext("updateDnsRecords", 1, netcuptest.Fault{HTTPStatus: http.StatusBadGateway})
+	_, err = client.CreateDNSRecord(t.Context(), "example.com", "mail", "A", "1.2.3.5", "")
+	require.NoError(t, err)
+	assert.Len(t, netcup.Records("example.com"), 2)
+
+	// Zone settings are reconciled the same way
+	zone, err := client.GetDNSZone(t.Context(), "example.com")
+	require.NoError(t, err)
+	zone.TTL = "3600"
+	netcup.FailNext("updateDnsZone", 1, netcuptest.Fault{HTTPStatus: http.StatusServiceUnavailable})
+	updated, err := client.UpdateDNSZone(t.Context(), "example.com", zone)
+	require.NoError(t, err)
+	assert.Equal(t, "3600", updated.TTL)
+}
+
+func TestDNSRecordsApplied(t *testing.T) {
+	t.Parallel()
+	existing := []*DNSRecordInfo{
+		{ID: "1", Hostname: "www", Type: "A", Destination: "1.2.3.4"},
+		{ID: "2", Hostname: "old", Type: "A", Destination: "1.2.3.4"},
+	}
+	written := []*DNSRecordInfo{
+		{ID: "1", Hostname: "www", Type: "A", Destination: "5.6.7.8"},
+		{ID: "2", Hostname: "old", Type: "A", Destination: "1.2.3.4", DeleteRecord: true},
+		{Hostname: "new", Type: "MX", Destination: "mail.example.com", Priority: "10"},
+	}
+
+	assert.False(t, dnsRecordsApplied(existing, written, existing))
+	assert.True(t, dnsRecordsApplied(existing, written, []*DNSRecordInfo{
+		{ID: "1", Hostname: "www", Type: "A", Destination: "5.6.7.8"},
+		{ID: "3", Hostname: "new", Type: "MX", Destination: "mail.example.com", Priority: "10"},
+	}))
+	assert.False(t, dnsRecordsApplied(existing, written, []*DNSRecordInfo{
+		{ID: "1", Hostname: "www", Type: "A", Destination: "5.6.7.8"},
+		{ID: "2", Hostname: "old", Type: "A", Destination: "1.2.3.4"},
+		{ID: "3", Hostname: "new", Type: "MX", Destination: "mail.example.com", Priority: "10"},
+	}), "a record to delete is still there")
+}
+
+func TestNetcupClient_HonoursContextCancellation(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		// Hang until the client gives up
+		_, _ = io.Copy(io.Discard, r.Body)
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.GetDNSRecordByID(ctx, "1", "example.com")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), APITimeout, "the request must not outlive its context")
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 1, requests, "a cancelled request must not be retried")
+}
+
+// newTestClient creates a client for a mocked endpoint that is not slowed down by rate limiting or backoff
+func newTestClient(endpoint string, opts ...ClientOption) *NetcupClient {
+	opts = append([]ClientOption{WithEndpoint(endpoint)}, opts...)
+	client := NewNetcupClient("test-key", "test-password", "test-customer", opts...)
+	client.limiter = newRateLimiter(60000)
+	client.retryBaseDelay = time.Millisecond
+	return client
+}
+
+// responseData encodes a value as the responsedata of a mocked API response
+func responseData(v interface{}) json.RawMessage {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
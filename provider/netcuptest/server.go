@@ -0,0 +1,610 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package netcuptest provides an in-memory stand-in for the Netcup CCP JSON API.
+//
+// The server keeps accounts, domains, zones and records in memory and implements the
+// actions used by the provider, including session handling, record ID assignment and
+// the error codes Netcup returns. It is meant for tests and local development:
+//
+//	server := netcuptest.NewServer()
+//	defer server.Close()
+//	server.AddDomain("example.com")
+//	client := provider.NewNetcupClient(
+//		netcuptest.APIKey, netcuptest.APIPassword, netcuptest.CustomerNumber,
+//		provider.WithEndpoint(server.URL),
+//	)
+package netcuptest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Default credentials accepted by the server
+const (
+	CustomerNumber = "12345"
+	APIKey         = "test-api-key"
+	APIPassword    = "test-api-password"
+)
+
+// Netcup status codes returned by the server
+const (
+	StatusSuccess            = 2000
+	StatusInvalidCredentials = 2011
+	StatusDomainNotFound     = 2016
+	StatusRateLimited        = 2057
+	StatusInvalidSession     = 4001
+	StatusInvalidRecord      = 4013
+	StatusUnknownAction      = 4006
+)
+
+// Record is a DNS record as stored by the server
+type Record struct {
+	ID           string `json:"id"`
+	Hostname     string `json:"hostname"`
+	Type         string `json:"type"`
+	Priority     string `json:"priority"`
+	Destination  string `json:"destination"`
+	DeleteRecord bool   `json:"deleterecord"`
+	State        string `json:"state"`
+}
+
+// Zone holds the zone settings of a domain
+type Zone struct {
+	Name         string `json:"name"`
+	TTL          string `json:"ttl"`
+	Serial       string `json:"serial"`
+	Refresh      string `json:"refresh"`
+	Retry        string `json:"retry"`
+	Expire       string `json:"expire"`
+	DNSSECStatus bool   `json:"dnssecstatus"`
+}
+
+// Domain holds the registration details of a domain as returned by infoDomain
+type Domain struct {
+	Name            string       `json:"domainname"`
+	State           string       `json:"state"`
+	Nameservers     []Nameserver `json:"nameserverentry"`
+	RuntimeStart    string       `json:"runtimestart"`
+	RuntimeEnd      string       `json:"runtimeend"`
+	AssignedHandles []Handle     `json:"assignedhandles"`
+}
+
+// Nameserver is a nameserver entry of a domain
+type Nameserver struct {
+	Hostname string `json:"hostname"`
+	IPv4     string `json:"ipv4"`
+	IPv6     string `json:"ipv6"`
+}
+
+// Handle is a contact handle assigned to a domain
+type Handle struct {
+	Type     string `json:"type"`
+	HandleID string `json:"handle_id"`
+}
+
+// Request describes an incoming API call, as seen by fault hooks
+type Request struct {
+	Action string
+	Domain string
+}
+
+// Fault is returned by a fault hook to make the server fail a request
+type Fault struct {
+	// HTTPStatus, if set, is sent as a bare HTTP error without a JSON body
+	HTTPStatus int
+	// StatusCode is the Netcup status code of the error response
+	StatusCode int
+	// Message is used as short and long message of the error response
+	Message string
+	// Delay is waited before the request is answered
+	Delay time.Duration
+}
+
+// FaultHook decides whether a request fails. It returns nil to handle the request normally.
+// Hooks run before the server state is locked, so they may call methods on the server.
+type FaultHook func(req Request) *Fault
+
+// Option configures a Server
+type Option func(*Server)
+
+// WithCredentials sets the account the server accepts logins for
+func WithCredentials(customerNumber, apiKey, apiPassword string) Option {
+	return func(s *Server) {
+		s.customerNumber = customerNumber
+		s.apiKey = apiKey
+		s.apiPassword = apiPassword
+	}
+}
+
+// WithRateLimit makes the server answer with status 2057 once more than the given number of
+// requests arrived within one minute. Zero disables rate limiting.
+func WithRateLimit(requestsPerMinute int) Option {
+	return func(s *Server) {
+		s.rateLimit = requestsPerMinute
+	}
+}
+
+// Server is an in-memory Netcup CCP API served over HTTP
+type Server struct {
+	*httptest.Server
+
+	mu             sync.Mutex
+	customerNumber string
+	apiKey         string
+	apiPassword    string
+	rateLimit      int
+	faultHook      FaultHook
+	sessions       map[string]bool
+	domains        map[string]*domain
+	nextID         int
+	nextSession    int
+	requestTimes   []time.Time
+	requestCounts  map[string]int
+}
+
+// domain is the server-side state of a domain
+type domain struct {
+	info    Domain
+	zone    Zone
+	records []Record
+}
+
+// NewServer starts a new server. Call Close when done.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		customerNumber: CustomerNumber,
+		apiKey:         APIKey,
+		apiPassword:    APIPassword,
+		sessions:       make(map[string]bool),
+		domains:        make(map[string]*domain),
+		nextID:         1000,
+		requestCounts:  make(map[string]int),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// AddDomain registers a domain with an empty zone and default zone settings
+func (s *Server) AddDomain(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name = strings.ToLower(name)
+	if _, ok := s.domains[name]; ok {
+		return
+	}
+	s.domains[name] = &domain{
+		info: Domain{
+			Name:  name,
+			State: "active",
+			Nameservers: []Nameserver{
+				{Hostname: "root-dns.netcup.net"},
+				{Hostname: "second-dns.netcup.net"},
+				{Hostname: "third-dns.netcup.net"},
+			},
+		},
+		zone: Zone{
+			Name:    name,
+			TTL:     "86400",
+			Serial:  time.Now().UTC().Format("20060102") + "01",
+			Refresh: "28800",
+			Retry:   "7200",
+			Expire:  "1209600",
+		},
+	}
+}
+
+// SetDomain replaces the registration details of a domain, registering the domain if needed
+func (s *Server) SetDomain(info Domain) {
+	s.AddDomain(info.Name)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info.Name = strings.ToLower(info.Name)
+	s.domains[info.Name].info = info
+}
+
+// AddRecord adds a record to a domain, registering the domain if needed, and returns its ID
+func (s *Server) AddRecord(domainName string, record Record) string {
+	s.AddDomain(domainName)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d := s.domains[strings.ToLower(domainName)]
+	record.ID = s.newID()
+	if record.State == "" {
+		record.State = "yes"
+	}
+	if record.Priority == "" {
+		record.Priority = "0"
+	}
+	d.records = append(d.records, record)
+	bumpSerial(&d.zone)
+
+	return record.ID
+}
+
+// Records returns a copy of the records of a domain
+func (s *Server) Records(domainName string) []Record {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, ok := s.domains[strings.ToLower(domainName)]
+	if !ok {
+		return nil
+	}
+	return slices.Clone(d.records)
+}
+
+// Zone returns the zone settings of a domain
+func (s *Server) Zone(domainName string) (Zone, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, ok := s.domains[strings.ToLower(domainName)]
+	if !ok {
+		return Zone{}, false
+	}
+	return d.zone, true
+}
+
+// ExpireSessions invalidates all sessions, as Netcup does after a period of inactivity
+func (s *Server) ExpireSessions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	clear(s.sessions)
+}
+
+// ActiveSessions returns the number of sessions that are logged in
+func (s *Server) ActiveSessions() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.sessions)
+}
+
+// RequestCount returns how often an action was called
+func (s *Server) RequestCount(action string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requestCounts[action]
+}
+
+// SetFaultHook installs a hook that can fail requests. Passing nil removes it.
+func (s *Server) SetFaultHook(hook FaultHook) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faultHook = hook
+}
+
+// FailNext makes the next n calls of an action fail with the given fault
+func (s *Server) FailNext(action string, n int, fault Fault) {
+	var mu sync.Mutex
+	s.SetFaultHook(func(req Request) *Fault {
+		mu.Lock()
+		defer mu.Unlock()
+		if req.Action != action || n <= 0 {
+			return nil
+		}
+		n--
+		return &fault
+	})
+}
+
+// apiRequest is the envelope of every API call
+type apiRequest struct {
+	Action string          `json:"action"`
+	Param  json.RawMessage `json:"param"`
+}
+
+// apiParams holds the union of all parameters used by the implemented actions
+type apiParams struct {
+	CustomerNumber string `json:"customernumber"`
+	APIKey         string `json:"apikey"`
+	APIPassword    string `json:"apipassword"`
+	SessionID      string `json:"apisessionid"`
+	DomainName     string `json:"domainname"`
+	DNSRecordSet   struct {
+		DNSRecords []Record `json:"dnsrecords"`
+	} `json:"dnsrecordset"`
+	DNSZone *Zone `json:"dnszone"`
+}
+
+// apiResponse is the envelope of every API answer
+type apiResponse struct {
+	ServerRequestID string `json:"serverrequestid"`
+	ClientRequestID string `json:"clientrequestid"`
+	Action          string `json:"action"`
+	Status          string `json:"status"`
+	StatusCode      int    `json:"statuscode"`
+	ShortMessage    string `json:"shortmessage"`
+	LongMessage     string `json:"longmessage"`
+	ResponseData    any    `json:"responsedata"`
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	var req apiRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var params apiParams
+	if len(req.Param) > 0 {
+		if err := json.Unmarshal(req.Param, &params); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	s.mu.Lock()
+	hook := s.faultHook
+	s.mu.Unlock()
+
+	if hook != nil {
+		if fault := hook(Request{Action: req.Action, Domain: params.DomainName}); fault != nil {
+			if fault.Delay > 0 {
+				select {
+				case <-time.After(fault.Delay):
+				case <-r.Context().Done():
+					return
+				}
+			}
+			if fault.HTTPStatus != 0 {
+				http.Error(w, http.StatusText(fault.HTTPStatus), fault.HTTPStatus)
+				return
+			}
+			if fault.StatusCode != 0 {
+				writeResponse(w, errorResponse(req.Action, fault.StatusCode, fault.Message))
+				return
+			}
+		}
+	}
+
+	writeResponse(w, s.handle(req.Action, &params))
+}
+
+func writeResponse(w http.ResponseWriter, response apiResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+// handle dispatches an action while holding the server lock
+func (s *Server) handle(action string, params *apiParams) apiResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requestCounts[action]++
+	if s.rateLimited() {
+		return errorResponse(action, StatusRateLimited, "More than 180 requests per minute")
+	}
+
+	if action == "login" {
+		return s.login(params)
+	}
+
+	if params.CustomerNumber != s.customerNumber || params.APIKey != s.apiKey || !s.sessions[params.SessionID] {
+		return errorResponse(action, StatusInvalidSession, "The session id is not in a valid format")
+	}
+
+	switch action {
+	case "logout":
+		delete(s.sessions, params.SessionID)
+		return successResponse(action, "Logout successful", "")
+	case "listallDomains":
+		return s.listAllDomains()
+	}
+
+	d, ok := s.domains[strings.ToLower(params.DomainName)]
+	if !ok {
+		return errorResponse(action, StatusDomainNotFound, "Domain not found")
+	}
+
+	switch action {
+	case "infoDomain":
+		return successResponse(action, "Domain found", d.info)
+	case "infoDnsRecords":
+		return successResponse(action, "DNS records found", map[string]any{"dnsrecords": d.records})
+	case "updateDnsRecords":
+		return s.updateRecords(d, params.DNSRecordSet.DNSRecords)
+	case "infoDnsZone":
+		return successResponse(action, "DNS zone found", d.zone)
+	case "updateDnsZone":
+		return s.updateZone(d, params.DNSZone)
+	default:
+		return errorResponse(action, StatusUnknownAction, "Unknown action")
+	}
+}
+
+// rateLimited records the current request and reports whether it exceeds the limit
+func (s *Server) rateLimited() bool {
+	if s.rateLimit <= 0 {
+		return false
+	}
+
+	now := time.Now()
+	s.requestTimes = slices.DeleteFunc(s.requestTimes, func(t time.Time) bool {
+		return now.Sub(t) > time.Minute
+	})
+	if len(s.requestTimes) >= s.rateLimit {
+		return true
+	}
+	s.requestTimes = append(s.requestTimes, now)
+	return false
+}
+
+func (s *Server) login(params *apiParams) apiResponse {
+	if params.CustomerNumber != s.customerNumber || params.APIKey != s.apiKey || params.APIPassword != s.apiPassword {
+		return errorResponse("login", StatusInvalidCredentials, "The API key or password is invalid")
+	}
+
+	s.nextSession++
+	sessionID := fmt.Sprintf("session-%d", s.nextSession)
+	s.sessions[sessionID] = true
+
+	return successResponse("login", "Login successful", map[string]string{"apisessionid": sessionID})
+}
+
+func (s *Server) listAllDomains() apiResponse {
+	names := make([]string, 0, len(s.domains))
+	for name := range s.domains {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	domains := make([]Domain, 0, len(names))
+	for _, name := range names {
+		domains = append(domains, s.domains[name].info)
+	}
+	return successResponse("listallDomains", "Domains found", domains)
+}
+
+// updateRecords applies a record set the way Netcup does: records with an ID are updated or,
+// when flagged, deleted; records without an ID are added; records not mentioned are kept.
+// The whole set is validated first so that an invalid record leaves the zone untouched.
+func (s *Server) updateRecords(d *domain, changes []Record) apiResponse {
+	for _, change := range changes {
+		if msg := validateRecord(change); msg != "" {
+			return errorResponse("updateDnsRecords", StatusInvalidRecord, msg)
+		}
+		if change.ID != "" && !slices.ContainsFunc(d.records, func(r Record) bool { return r.ID == change.ID }) {
+			return errorResponse("updateDnsRecords", StatusInvalidRecord, "Unknown DNS record id "+change.ID)
+		}
+	}
+
+	for _, change := range changes {
+		change.State = "yes"
+		if change.Priority == "" {
+			change.Priority = "0"
+		}
+
+		if change.ID == "" {
+			if !change.DeleteRecord {
+				change.ID = s.newID()
+				d.records = append(d.records, change)
+			}
+			continue
+		}
+
+		i := slices.IndexFunc(d.records, func(r Record) bool { return r.ID == change.ID })
+		if change.DeleteRecord {
+			d.records = slices.Delete(d.records, i, i+1)
+			continue
+		}
+		change.DeleteRecord = false
+		d.records[i] = change
+	}
+	bumpSerial(&d.zone)
+
+	return successResponse("updateDnsRecords", "DNS records updated", map[string]any{"dnsrecords": d.records})
+}
+
+func (s *Server) updateZone(d *domain, zone *Zone) apiResponse {
+	if zone == nil {
+		return errorResponse("updateDnsZone", StatusInvalidRecord, "DNS zone is missing")
+	}
+
+	for _, value := range []string{zone.TTL, zone.Refresh, zone.Retry, zone.Expire} {
+		if _, err := strconv.Atoi(value); err != nil {
+			return errorResponse("updateDnsZone", StatusInvalidRecord, "Zone values must be numeric")
+		}
+	}
+
+	d.zone.TTL = zone.TTL
+	d.zone.Refresh = zone.Refresh
+	d.zone.Retry = zone.Retry
+	d.zone.Expire = zone.Expire
+	d.zone.DNSSECStatus = zone.DNSSECStatus
+	bumpSerial(&d.zone)
+
+	return successResponse("updateDnsZone", "DNS zone updated", d.zone)
+}
+
+func (s *Server) newID() string {
+	s.nextID++
+	return strconv.Itoa(s.nextID)
+}
+
+// validateRecord returns why Netcup would reject a record, or an empty string
+func validateRecord(record Record) string {
+	if record.DeleteRecord {
+		return ""
+	}
+
+	switch record.Type {
+	case "A", "AAAA", "MX", "CNAME", "CAA", "SRV", "TXT", "TLSA", "NS", "DS", "OPENPGPKEY", "SMIMEA", "SSHFP":
+	default:
+		return "Unsupported DNS record type " + record.Type
+	}
+
+	if record.Hostname == "" || strings.ContainsAny(record.Hostname, " \t") {
+		return "Invalid hostname " + strconv.Quote(record.Hostname)
+	}
+	if record.Destination == "" {
+		return "Destination is required"
+	}
+	if record.Type == "MX" || record.Type == "SRV" {
+		if _, err := strconv.Atoi(record.Priority); err != nil {
+			return "Priority must be numeric for " + record.Type + " records"
+		}
+	}
+	return ""
+}
+
+// bumpSerial increments the zone serial after a change
+func bumpSerial(zone *Zone) {
+	serial, err := strconv.ParseInt(zone.Serial, 10, 64)
+	if err != nil {
+		serial = 0
+	}
+	zone.Serial = strconv.FormatInt(serial+1, 10)
+}
+
+func successResponse(action, message string, data any) apiResponse {
+	return apiResponse{
+		ServerRequestID: "netcuptest",
+		Action:          action,
+		Status:          "success",
+		StatusCode:      StatusSuccess,
+		ShortMessage:    message,
+		LongMessage:     message,
+		ResponseData:    data,
+	}
+}
+
+func errorResponse(action string, statusCode int, message string) apiResponse {
+	return apiResponse{
+		ServerRequestID: "netcuptest",
+		Action:          action,
+		Status:          "error",
+		StatusCode:      statusCode,
+		ShortMessage:    message,
+		LongMessage:     message,
+		ResponseData:    "",
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netcuptest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/blackdark/pulumi-netcup/provider"
+	"github.com/blackdark/pulumi-netcup/provider/netcuptest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newClient(server *netcuptest.Server, opts ...provider.ClientOption) *provider.NetcupClient {
+	opts = append([]provider.ClientOption{
+		provider.WithEndpoint(server.URL),
+		provider.WithBatchWindow(10 * time.Millisecond),
+	}, opts...)
+	return provider.NewNetcupClient(netcuptest.APIKey, netcuptest.APIPassword, netcuptest.CustomerNumber, opts...)
+}
+
+func TestServer_RecordLifecycle(t *testing.T) {
+	t.Parallel()
+	server := netcuptest.NewServer()
+	defer server.Close()
+	server.AddDomain("example.com")
+	client := newClient(server)
+
+	recordID, err := client.CreateDNSRecord(t.Context(), "example.com", "www", "A", "1.2.3.4", "")
+	require.NoError(t, err)
+	assert.NotEmpty(t, recordID)
+
+	record, err := client.GetDNSRecordByID(t.Context(), recordID, "example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "1.2.3.4", record.Destination)
+
+	err = client.UpdateDNSRecord(t.Context(), recordID, "example.com", "www", "A", "5.6.7.8", "")
+	require.NoError(t, err)
+	records := server.Records("example.com")
+	require.Len(t, records, 1)
+	assert.Equal(t, recordID, records[0].ID)
+	assert.Equal(t, "5.6.7.8", records[0].Destination)
+
+	require.NoError(t, client.DeleteDNSRecord(t.Context(), recordID, "example.com"))
+	assert.Empty(t, server.Records("example.com"))
+
+	_, err = client.GetDNSRecordByID(t.Context(), recordID, "example.com")
+	assert.ErrorIs(t, err, provider.ErrNotFound)
+}
+
+func TestServer_Errors(t *testing.T) {
+	t.Parallel()
+	server := netcuptest.NewServer()
+	defer server.Close()
+	server.AddDomain("example.com")
+
+	_, err := newClient(server).GetDNSZone(t.Context(), "unknown.com")
+	assert.ErrorIs(t, err, provider.ErrDomainInaccessible)
+
+	_, err = newClient(server).CreateDNSRecord(t.Context(), "example.com", "www", "BOGUS", "1.2.3.4", "")
+	assert.ErrorIs(t, err, provider.ErrInvalidFormat)
+	assert.Empty(t, server.Records("example.com"))
+
+	wrongPassword := provider.NewNetcupClient(
+		netcuptest.APIKey, "wrong", netcuptest.CustomerNumber,
+		provider.WithEndpoint(server.URL),
+	)
+	_, err = wrongPassword.GetDNSZone(t.Context(), "example.com")
+	assert.ErrorIs(t, err, provider.ErrAuthFailed)
+}
+
+func TestServer_ExpiredSessionIsRenewed(t *testing.T) {
+	t.Parallel()
+	server := netcuptest.NewServer()
+	defer server.Close()
+	server.AddRecord("example.com", netcuptest.Record{Hostname: "@", Type: "A", Destination: "1.2.3.4"})
+	client := newClient(server)
+
+	_, err := client.GetDNSZone(t.Context(), "example.com")
+	require.NoError(t, err)
+
+	server.ExpireSessions()
+	zone, err := client.GetDNSZone(t.Context(), "example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "example.com", zone.Name)
+	assert.Equal(t, 2, server.RequestCount("login"))
+}
+
+func TestServer_FaultInjection(t *testing.T) {
+	t.Parallel()
+	server := netcuptest.NewServer()
+	defer server.Close()
+	server.AddDomain("example.com")
+	server.FailNext("infoDnsZone", 1, netcuptest.Fault{
+		StatusCode: netcuptest.StatusInvalidRecord,
+		Message:    "injected",
+	})
+	client := newClient(server)
+
+	_, err := client.GetDNSZone(t.Context(), "example.com")
+	var apiErr *provider.NetcupAPIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, netcuptest.StatusInvalidRecord, apiErr.StatusCode)
+
+	_, err = client.GetDNSZone(t.Context(), "example.com")
+	assert.NoError(t, err)
+}
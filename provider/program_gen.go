@@ -0,0 +1,404 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Languages of the programs RunGenerate can write
+const (
+	LanguageGo         = "go"
+	LanguageTypeScript = "typescript"
+	LanguagePython     = "python"
+	LanguageYAML       = "yaml"
+)
+
+// ImportFileName is the name of the file written next to a generated program, to be used with
+// pulumi import --file
+const ImportFileName = "import.json"
+
+// generatedProjectName is the name of the Pulumi project of a generated program
+const generatedProjectName = "netcup-dns"
+
+// generatedPulumiVersion is the version of the Pulumi SDK generated programs depend on at least
+const generatedPulumiVersion = "3.175.0"
+
+// generatedRecord is a live DNS record declared as resource of a generated program
+type generatedRecord struct {
+	resourceName string
+	id           string
+	args         DNSRecordArgs
+}
+
+// RunGenerate implements the generate command of the provider binary. It reads every record
+// of the given domains and writes a Pulumi project declaring them as DNSRecord resources, plus
+// an import file that adopts the existing records into the stack:
+//
+//	pulumi-resource-netcup generate -language typescript -out ./dns example.com example.org
+//	cd ./dns && pulumi install
+//	pulumi import --file import.json --generate-code=false
+//
+// The credentials are read from the NETCUP_* environment variables. The caller is expected to
+// close the API session with CloseSessions.
+func RunGenerate(ctx context.Context, args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+	flags.SetOutput(stdout)
+	language := flags.String("language", LanguageTypeScript,
+		fmt.Sprintf("language of the program: %s, %s, %s or %s",
+			LanguageGo, LanguageTypeScript, LanguagePython, LanguageYAML))
+	out := flags.String("out", ".", "directory the program and "+ImportFileName+" are written to")
+	endpoint := flags.String("endpoint", NetcupAPIEndpoint, "URL of the Netcup CCP JSON API")
+	flags.Usage = func() {
+		fmt.Fprintln(stdout, "Usage: pulumi-resource-netcup generate [flags] domain...")
+		fmt.Fprintln(stdout, "\nWrites a Pulumi program declaring the live DNS records of the domains and an import file.")
+		fmt.Fprintf(stdout, "Credentials are read from %s, %s and %s.\n\n", EnvAPIKey, EnvAPIPassword, EnvCustomerID)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return errors.New("at least one domain is required")
+	}
+
+	config := Config{
+		APIKey:      os.Getenv(EnvAPIKey),
+		APIPassword: os.Getenv(EnvAPIPassword),
+		CustomerID:  os.Getenv(EnvCustomerID),
+		Endpoint:    endpoint,
+	}
+	if config.APIKey == "" || config.APIPassword == "" || config.CustomerID == "" {
+		return fmt.Errorf("missing credentials: set %s, %s and %s", EnvAPIKey, EnvAPIPassword, EnvCustomerID)
+	}
+	client, err := config.newClient()
+	if err != nil {
+		return err
+	}
+
+	records, err := readGeneratedRecords(ctx, client, flags.Args())
+	if err != nil {
+		return err
+	}
+
+	fileName, program, err := renderProgram(*language, records)
+	if err != nil {
+		return err
+	}
+	importFile, err := renderImportFile(records)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(*out, fileName), []byte(program), 0o644); err != nil {
+		return err
+	}
+	for name, content := range renderProjectFiles(*language) {
+		if err := os.WriteFile(filepath.Join(*out, name), []byte(content), 0o644); err != nil {
+			return err
+		}
+	}
+	if err := os.WriteFile(filepath.Join(*out, ImportFileName), importFile, 0o644); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "Wrote %d DNS records to %s and %s\n", len(records),
+		filepath.Join(*out, fileName), filepath.Join(*out, ImportFileName))
+	return nil
+}
+
+// readGeneratedRecords reads the records of the domains and assigns each a unique resource name
+func readGeneratedRecords(ctx context.Context, client *NetcupClient, domains []string) ([]generatedRecord, error) {
+	var records []generatedRecord
+	used := make(map[string]int)
+
+	for _, domain := range domains {
+		domain = strings.ToLower(strings.TrimSpace(domain))
+		if !isValidDomain(domain) {
+			return nil, fmt.Errorf("invalid domain name: %q", domain)
+		}
+
+		infos, err := client.getAllDNSRecords(ctx, domain)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read DNS records of %s: %w", domain, err)
+		}
+
+		for _, info := range sortDNSRecords(infos) {
+			zoneRecord := dnsZoneRecordFromInfo(info)
+			args := DNSRecordArgs{
+				Domain:   domain,
+				Name:     zoneRecord.Name,
+				Type:     zoneRecord.Type,
+				Value:    zoneRecord.Value,
+				Priority: zoneRecord.Priority,
+			}
+
+			name := generatedResourceName(args)
+			used[name]++
+			if used[name] > 1 {
+				name = fmt.Sprintf("%s-%d", name, used[name])
+			}
+
+			records = append(records, generatedRecord{
+				resourceName: name,
+				id:           createCompositeID(domain, info.ID),
+				args:         args,
+			})
+		}
+	}
+
+	return records, nil
+}
+
+var resourceNameSeparators = regexp.MustCompile(`[^a-z0-9]+`)
+
+// generatedResourceName derives a readable resource name such as "example-com-www-a"
+func generatedResourceName(args DNSRecordArgs) string {
+	name := args.Name
+	if name == "@" {
+		name = ""
+	}
+	parts := []string{args.Domain, name, args.Type}
+	return strings.Trim(resourceNameSeparators.ReplaceAllString(strings.ToLower(strings.Join(parts, "-")), "-"), "-")
+}
+
+// renderProgram renders the program declaring the records in the given language and returns
+// the name of its main file
+func renderProgram(language string, records []generatedRecord) (string, string, error) {
+	var b strings.Builder
+
+	// properties returns the inputs of a record in declaration order
+	properties := func(record generatedRecord) [][2]string {
+		props := [][2]string{
+			{"domain", record.args.Domain},
+			{"name", record.args.Name},
+			{"type", record.args.Type},
+			{"value", record.args.Value},
+		}
+		if record.args.Priority != nil {
+			props = append(props, [2]string{"priority", *record.args.Priority})
+		}
+		return props
+	}
+
+	switch strings.ToLower(language) {
+	case LanguageGo:
+		b.WriteString("package main\n\n")
+		b.WriteString("import (\n")
+		b.WriteString("\tnetcup \"github.com/blackdark/pulumi-netcup/sdk/go/pulumi-netcup\"\n")
+		b.WriteString("\t\"github.com/pulumi/pulumi/sdk/v3/go/pulumi\"\n")
+		b.WriteString(")\n\n")
+		b.WriteString("func main() {\n")
+		b.WriteString("\tpulumi.Run(func(ctx *pulumi.Context) error {\n")
+		for _, record := range records {
+			fmt.Fprintf(&b, "\t\tif _, err := netcup.NewDNSRecord(ctx, %s, &netcup.DNSRecordArgs{\n",
+				strconv.Quote(record.resourceName))
+			for _, prop := range properties(record) {
+				fmt.Fprintf(&b, "\t\t\t%s: pulumi.String(%s),\n", exportedFieldName(prop[0]), strconv.Quote(prop[1]))
+			}
+			b.WriteString("\t\t}, pulumi.Protect(true)); err != nil {\n")
+			b.WriteString("\t\t\treturn err\n")
+			b.WriteString("\t\t}\n")
+		}
+		b.WriteString("\t\treturn nil\n")
+		b.WriteString("\t})\n")
+		b.WriteString("}\n")
+		program, err := format.Source([]byte(b.String()))
+		if err != nil {
+			return "", "", err
+		}
+		return "main.go", string(program), nil
+
+	case LanguageTypeScript:
+		b.WriteString("import * as netcup from \"@blackdark/netcup\";\n")
+		for _, record := range records {
+			fmt.Fprintf(&b, "\nnew netcup.DNSRecord(%s, {\n", jsonString(record.resourceName))
+			for _, prop := range properties(record) {
+				fmt.Fprintf(&b, "    %s: %s,\n", prop[0], jsonString(prop[1]))
+			}
+			b.WriteString("}, { protect: true });\n")
+		}
+		return "index.ts", b.String(), nil
+
+	case LanguagePython:
+		b.WriteString("import pulumi\n")
+		b.WriteString("import blackdark_netcup as netcup\n")
+		for _, record := range records {
+			fmt.Fprintf(&b, "\nnetcup.DNSRecord(\n    %s,\n", jsonString(record.resourceName))
+			for _, prop := range properties(record) {
+				fmt.Fprintf(&b, "    %s=%s,\n", prop[0], jsonString(prop[1]))
+			}
+			b.WriteString("    opts=pulumi.ResourceOptions(protect=True),\n")
+			b.WriteString(")\n")
+		}
+		return "__main__.py", b.String(), nil
+
+	case LanguageYAML:
+		b.WriteString(projectFile("yaml"))
+		b.WriteString("resources:\n")
+		for _, record := range records {
+			fmt.Fprintf(&b, "  %s:\n", record.resourceName)
+			b.WriteString("    type: netcup:index:DNSRecord\n")
+			b.WriteString("    properties:\n")
+			for _, prop := range properties(record) {
+				fmt.Fprintf(&b, "      %s: %s\n", prop[0], jsonString(prop[1]))
+			}
+			b.WriteString("    options:\n")
+			b.WriteString("      protect: true\n")
+		}
+		return "Pulumi.yaml", b.String(), nil
+
+	default:
+		return "", "", fmt.Errorf("unsupported language %q. Valid languages are: %s, %s, %s, %s",
+			language, LanguageGo, LanguageTypeScript, LanguagePython, LanguageYAML)
+	}
+}
+
+// renderProjectFiles renders the files besides the program that make it a Pulumi project:
+// its Pulumi.yaml and the manifest of its dependencies, keyed by file name. A YAML program
+// is its own Pulumi.yaml.
+func renderProjectFiles(language string) map[string]string {
+	switch strings.ToLower(language) {
+	case LanguageGo:
+		var goMod strings.Builder
+		fmt.Fprintf(&goMod, "module %s\n\ngo 1.24\n\nrequire (\n", generatedProjectName)
+		if version := generatedSDKVersion(); version != "" {
+			fmt.Fprintf(&goMod, "\tgithub.com/blackdark/pulumi-netcup/sdk/go/pulumi-netcup v%s\n", version)
+		}
+		fmt.Fprintf(&goMod, "\tgithub.com/pulumi/pulumi/sdk/v3 v%s\n)\n", generatedPulumiVersion)
+		return map[string]string{
+			"Pulumi.yaml": projectFile("go"),
+			"go.mod":      goMod.String(),
+		}
+
+	case LanguageTypeScript:
+		netcupVersion := generatedSDKVersion()
+		if netcupVersion == "" {
+			netcupVersion = "latest"
+		}
+		packageJSON, _ := json.MarshalIndent(map[string]any{
+			"name": generatedProjectName,
+			"main": "index.ts",
+			"dependencies": map[string]string{
+				"@blackdark/netcup": netcupVersion,
+				"@pulumi/pulumi":    "^" + generatedPulumiVersion,
+			},
+			"devDependencies": map[string]string{
+				"@types/node": "^20",
+				"typescript":  "^5.0.0",
+			},
+		}, "", "  ")
+		return map[string]string{
+			"Pulumi.yaml":  projectFile("nodejs"),
+			"package.json": string(packageJSON) + "\n",
+			"tsconfig.json": `{
+  "compilerOptions": {
+    "strict": true,
+    "outDir": "bin",
+    "target": "es2020",
+    "module": "commonjs",
+    "moduleResolution": "node",
+    "sourceMap": true
+  },
+  "files": ["index.ts"]
+}
+`,
+		}
+
+	case LanguagePython:
+		requirement := "blackdark_netcup"
+		if version := generatedSDKVersion(); version != "" {
+			requirement += "==" + version
+		}
+		return map[string]string{
+			"Pulumi.yaml":      projectFile("python") + "  options:\n    virtualenv: venv\n",
+			"requirements.txt": fmt.Sprintf("pulumi>=%s,<4.0.0\n%s\n", generatedPulumiVersion, requirement),
+		}
+
+	default:
+		return nil
+	}
+}
+
+// projectFile renders the head of the Pulumi.yaml of a generated program. The runtime is
+// written as mapping, so that runtime options can follow it.
+func projectFile(runtime string) string {
+	return fmt.Sprintf("name: %s\ndescription: DNS records read from Netcup\nruntime:\n  name: %s\n",
+		generatedProjectName, runtime)
+}
+
+// generatedSDKVersion returns the version of the netcup SDK matching this provider build,
+// without build metadata, or an empty string for development builds
+func generatedSDKVersion() string {
+	version, _, _ := strings.Cut(Version, "+")
+	return version
+}
+
+// renderImportFile renders the records in the format of pulumi import --file
+func renderImportFile(records []generatedRecord) ([]byte, error) {
+	type importResource struct {
+		Type string `json:"type"`
+		Name string `json:"name"`
+		ID   string `json:"id"`
+	}
+	resources := make([]importResource, 0, len(records))
+	for _, record := range records {
+		resources = append(resources, importResource{
+			Type: "netcup:index:DNSRecord",
+			Name: record.resourceName,
+			ID:   record.id,
+		})
+	}
+
+	content, err := json.MarshalIndent(map[string]any{"resources": resources}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(content, '\n'), nil
+}
+
+// exportedFieldName returns the Go SDK field name of an input property
+func exportedFieldName(property string) string {
+	return strings.ToUpper(property[:1]) + property[1:]
+}
+
+// jsonString renders a string as double-quoted literal, which is valid in TypeScript, Python
+// and YAML alike
+func jsonString(value string) string {
+	var b bytes.Buffer
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(value)
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,177 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blackdark/pulumi-netcup/provider/netcuptest"
+)
+
+func TestRenderProgram(t *testing.T) {
+	t.Parallel()
+	records := []generatedRecord{
+		{
+			resourceName: "example-com-a",
+			id:           "example.com:1001",
+			args:         DNSRecordArgs{Domain: "example.com", Name: "@", Type: "A", Value: "1.2.3.4"},
+		},
+		{
+			resourceName: "example-com-mx",
+			id:           "example.com:1002",
+			args: DNSRecordArgs{
+				Domain: "example.com", Name: "@", Type: "MX", Value: "mail.example.com", Priority: stringPtr("10"),
+			},
+		},
+		{
+			resourceName: "example-com-txt",
+			id:           "example.com:1003",
+			args:         DNSRecordArgs{Domain: "example.com", Name: "@", Type: "TXT", Value: `say "hi" <html>`},
+		},
+	}
+
+	for language, expected := range map[string][]string{
+		LanguageGo: {
+			`netcup.NewDNSRecord(ctx, "example-com-mx", &netcup.DNSRecordArgs{`,
+			`Priority: pulumi.String("10"),`,
+			`Value:  pulumi.String("say \"hi\" <html>"),`,
+		},
+		LanguageTypeScript: {
+			`new netcup.DNSRecord("example-com-mx", {`,
+			`    priority: "10",`,
+			`    value: "say \"hi\" <html>",`,
+		},
+		LanguagePython: {
+			"netcup.DNSRecord(\n    \"example-com-mx\",\n",
+			`    priority="10",`,
+		},
+		LanguageYAML: {
+			"  example-com-mx:\n    type: netcup:index:DNSRecord\n",
+			`      priority: "10"`,
+		},
+	} {
+		fileName, program, err := renderProgram(language, records)
+		require.NoError(t, err, language)
+		assert.NotEmpty(t, fileName)
+		for _, snippet := range expected {
+			assert.Contains(t, program, snippet, language)
+		}
+		assert.Equal(t, 3, strings.Count(program, "protect")+strings.Count(program, "Protect"), language)
+	}
+
+	_, _, err := renderProgram("cobol", records)
+	assert.ErrorContains(t, err, `unsupported language "cobol"`)
+}
+
+func TestRenderProjectFiles(t *testing.T) {
+	t.Parallel()
+	for language, expected := range map[string]map[string]string{
+		LanguageGo: {
+			"Pulumi.yaml": "runtime:\n  name: go\n",
+			"go.mod":      "github.com/pulumi/pulumi/sdk/v3 v" + generatedPulumiVersion,
+		},
+		LanguageTypeScript: {
+			"Pulumi.yaml":   "runtime:\n  name: nodejs\n",
+			"package.json":  `"@blackdark/netcup": "latest"`,
+			"tsconfig.json": `"files": ["index.ts"]`,
+		},
+		LanguagePython: {
+			"Pulumi.yaml":      "virtualenv: venv",
+			"requirements.txt": "blackdark_netcup\n",
+		},
+	} {
+		files := renderProjectFiles(language)
+		assert.Len(t, files, len(expected), language)
+		for name, snippet := range expected {
+			assert.Contains(t, files[name], snippet, language)
+			assert.Contains(t, files["Pulumi.yaml"], "name: "+generatedProjectName+"\n", language)
+		}
+	}
+
+	// A YAML program is its own project file
+	assert.Empty(t, renderProjectFiles(LanguageYAML))
+	_, program, err := renderProgram(LanguageYAML, nil)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(program, "name: "+generatedProjectName+"\n"))
+	assert.Contains(t, program, "runtime:\n  name: yaml\n")
+}
+
+func TestGeneratedResourceName(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "example-com-a", generatedResourceName(DNSRecordArgs{Domain: "example.com", Name: "@", Type: "A"}))
+	assert.Equal(t, "example-com-sip-tcp-srv",
+		generatedResourceName(DNSRecordArgs{Domain: "example.com", Name: "_sip._tcp", Type: "SRV"}))
+}
+
+// Not parallel: sets the credential environment variables
+func TestRunGenerate(t *testing.T) {
+	netcup := netcuptest.NewServer()
+	defer netcup.Close()
+	firstID := netcup.AddRecord("example.com", netcuptest.Record{Hostname: "www", Type: "A", Destination: "1.2.3.4"})
+	secondID := netcup.AddRecord("example.com", netcuptest.Record{Hostname: "www", Type: "A", Destination: "1.2.3.5"})
+	netcup.AddRecord("example.org", netcuptest.Record{Hostname: "@", Type: "TXT", Destination: "hello"})
+
+	t.Setenv(EnvAPIKey, netcuptest.APIKey)
+	t.Setenv(EnvAPIPassword, netcuptest.APIPassword)
+	t.Setenv(EnvCustomerID, netcuptest.CustomerNumber)
+
+	out := t.TempDir()
+	var stdout strings.Builder
+	err := RunGenerate(t.Context(), []string{
+		"-language", "yaml", "-out", out, "-endpoint", netcup.URL, "example.com", "example.org",
+	}, &stdout)
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "Wrote 3 DNS records")
+
+	program, err := os.ReadFile(filepath.Join(out, "Pulumi.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(program), "  example-org-txt:\n")
+
+	content, err := os.ReadFile(filepath.Join(out, ImportFileName))
+	require.NoError(t, err)
+	var importFile struct {
+		Resources []struct {
+			Type string `json:"type"`
+			Name string `json:"name"`
+			ID   string `json:"id"`
+		} `json:"resources"`
+	}
+	require.NoError(t, json.Unmarshal(content, &importFile))
+	require.Len(t, importFile.Resources, 3)
+	assert.Equal(t, "example-com-www-a", importFile.Resources[0].Name)
+	assert.Equal(t, "example.com:"+firstID, importFile.Resources[0].ID)
+	assert.Equal(t, "example-com-www-a-2", importFile.Resources[1].Name)
+	assert.Equal(t, "example.com:"+secondID, importFile.Resources[1].ID)
+	assert.Equal(t, "netcup:index:DNSRecord", importFile.Resources[2].Type)
+
+	// Programs in other languages come with their project files
+	out = t.TempDir()
+	require.NoError(t, RunGenerate(t.Context(), []string{
+		"-language", "go", "-out", out, "-endpoint", netcup.URL, "example.com",
+	}, &stdout))
+	for _, name := range []string{"main.go", "go.mod", "Pulumi.yaml", ImportFileName} {
+		assert.FileExists(t, filepath.Join(out, name))
+	}
+
+	err = RunGenerate(t.Context(), []string{"-endpoint", netcup.URL}, &stdout)
+	assert.ErrorContains(t, err, "at least one domain is required")
+}
@@ -16,7 +16,15 @@
 package provider
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
 
 	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
@@ -39,6 +47,17 @@ func Provider() p.Provider {
 		WithGoImportPath("github.com/blackdark/pulumi-netcup/sdk/go/pulumi-netcup").
 		WithResources(
 			infer.Resource(&DNSRecord{}),
+			infer.Resource(&DNSZone{}),
+			infer.Resource(&DNSRecordSet{}),
+			infer.Resource(&DNSZoneRecords{}),
+		).
+		WithFunctions(
+			infer.Function(&GetDNSRecords{}),
+			infer.Function(&ListDomains{}),
+			infer.Function(&GetDomainInfo{}),
+			infer.Function(&ParseZoneFile{}),
+			infer.Function(&ExportZone{}),
+			infer.Function(&GetSSHFPRecords{}),
 		).
 		WithConfig(infer.Config(&Config{})).
 		WithModuleMap(map[tokens.ModuleName]tokens.ModuleName{
@@ -52,15 +71,192 @@ func Provider() p.Provider {
 
 // Config defines provider-level configuration
 type Config struct {
-	// Netcup API credentials
-	APIKey      string `pulumi:"apiKey"      provider:"secret"`
-	APIPassword string `pulumi:"apiPassword" provider:"secret"`
-	CustomerID  string `pulumi:"customerId"`
+	// Netcup API credentials, falling back to the NETCUP_* environment variables
+	APIKey      string `pulumi:"apiKey,optional"      provider:"secret"`
+	APIPassword string `pulumi:"apiPassword,optional" provider:"secret"`
+	CustomerID  string `pulumi:"customerId,optional"`
+
+	// Request throttling and retry behaviour
+	MaxRetries        *int `pulumi:"maxRetries,optional"`
+	RequestsPerMinute *int `pulumi:"requestsPerMinute,optional"`
+
+	// Connection settings
+	Endpoint       *string `pulumi:"endpoint,optional"`
+	ProxyURL       *string `pulumi:"proxyUrl,optional"`
+	CABundle       *string `pulumi:"caBundle,optional"`
+	RequestTimeout *int    `pulumi:"requestTimeout,optional"`
+	UserAgent      *string `pulumi:"userAgent,optional"`
+	Nameserver     *string `pulumi:"nameserver,optional"`
+
+	// client is built once per provider instance in Configure
+	client *NetcupClient
 }
 
+// Environment variables the credentials are read from when not set in the stack configuration
+const (
+	EnvAPIKey      = "NETCUP_API_KEY"
+	EnvAPIPassword = "NETCUP_API_PASSWORD"
+	EnvCustomerID  = "NETCUP_CUSTOMER_ID"
+)
+
 // Annotate provides metadata about the Config
 func (c *Config) Annotate(a infer.Annotator) {
-	a.Describe(&c.APIKey, "The Netcup API key for authentication")
-	a.Describe(&c.APIPassword, "The Netcup API password for authentication")
-	a.Describe(&c.CustomerID, "The Netcup customer ID")
+	a.Describe(&c.APIKey, "The Netcup API key for authentication. Can also be set with "+EnvAPIKey)
+	a.SetDefault(&c.APIKey, nil, EnvAPIKey)
+	a.Describe(&c.APIPassword, "The Netcup API password for authentication. Can also be set with "+EnvAPIPassword)
+	a.SetDefault(&c.APIPassword, nil, EnvAPIPassword)
+	a.Describe(&c.CustomerID, "The Netcup customer ID. Can also be set with "+EnvCustomerID)
+	a.SetDefault(&c.CustomerID, nil, EnvCustomerID)
+	a.Describe(&c.MaxRetries, fmt.Sprintf(
+		"How often a rate-limited (status 2057) or transiently failed API request is retried. Defaults to %d",
+		DefaultMaxRetries,
+	))
+	a.Describe(&c.RequestsPerMinute, fmt.Sprintf(
+		"The maximum number of Netcup API requests per minute made by the provider. "+
+			"The limit is shared by all provider instances in the process. Defaults to %d",
+		DefaultRequestsPerMinute,
+	))
+	a.Describe(&c.Endpoint, "The URL of the Netcup CCP JSON API. Defaults to "+NetcupAPIEndpoint)
+	a.Describe(&c.ProxyURL, "The URL of an HTTP proxy for API requests. "+
+		"Defaults to the HTTPS_PROXY and NO_PROXY environment variables")
+	a.Describe(&c.CABundle, "Path to a PEM file with additional CA certificates trusted for API requests")
+	a.Describe(&c.RequestTimeout, fmt.Sprintf(
+		"The timeout of a single API request in seconds. Defaults to %d",
+		int(APITimeout/time.Second),
+	))
+	a.Describe(&c.UserAgent, "The User-Agent header sent with API requests")
+	a.Describe(&c.Nameserver, "The nameserver (host:port) DNSKEY records of DNSSEC signed zones are read from. "+
+		"Defaults to "+DefaultNameserver)
+}
+
+// Check applies the environment variable fallbacks and reports every credential that is still missing
+func (c *Config) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[*Config], error) {
+	config, failures, err := infer.DefaultCheck[Config](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[*Config]{Inputs: &config, Failures: failures}, err
+	}
+
+	failures = append(failures, validateCredentials(config)...)
+
+	return infer.CheckResponse[*Config]{Inputs: &config, Failures: failures}, nil
+}
+
+// validateCredentials returns a failure for every credential that is neither configured nor set in the environment
+func validateCredentials(c Config) []p.CheckFailure {
+	credentials := []struct {
+		property string
+		env      string
+		value    string
+	}{
+		{"apiKey", EnvAPIKey, c.APIKey},
+		{"apiPassword", EnvAPIPassword, c.APIPassword},
+		{"customerId", EnvCustomerID, c.CustomerID},
+	}
+
+	var failures []p.CheckFailure
+	for _, credential := range credentials {
+		if credential.value != "" {
+			continue
+		}
+		failures = append(failures, p.CheckFailure{
+			Property: credential.property,
+			Reason: fmt.Sprintf(
+				"Missing required configuration netcup:%s. Set it in the stack configuration or with the %s environment variable",
+				credential.property, credential.env,
+			),
+		})
+	}
+	return failures
+}
+
+// Configure builds the Netcup API client shared by all resources of this provider instance.
+// The request rate is set on the limiter shared by every client in the process, since Netcup
+// enforces its limit per account; the last configured provider instance wins.
+func (c *Config) Configure(_ context.Context) error {
+	client, err := c.newClient()
+	if err != nil {
+		return err
+	}
+	c.client = client
+
+	if c.RequestsPerMinute != nil {
+		apiLimiter.setRate(*c.RequestsPerMinute)
+	}
+	return nil
+}
+
+// netcupClient returns the client of the provider instance, building one if Configure has not run
+func (c Config) netcupClient() (*NetcupClient, error) {
+	if c.client != nil {
+		return c.client, nil
+	}
+	return c.newClient()
+}
+
+// newClient creates a Netcup API client from the provider configuration
+func (c Config) newClient() (*NetcupClient, error) {
+	httpClient, err := c.newHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []ClientOption{WithHTTPClient(httpClient)}
+	if c.Endpoint != nil {
+		opts = append(opts, WithEndpoint(*c.Endpoint))
+	}
+	if c.UserAgent != nil {
+		opts = append(opts, WithUserAgent(*c.UserAgent))
+	}
+	if c.Nameserver != nil {
+		opts = append(opts, WithNameserver(*c.Nameserver))
+	}
+	if c.MaxRetries != nil {
+		opts = append(opts, WithMaxRetries(*c.MaxRetries))
+	}
+
+	return NewNetcupClient(c.APIKey, c.APIPassword, c.CustomerID, opts...), nil
+}
+
+// newHTTPClient creates the HTTP client for API requests from the connection settings
+func (c Config) newHTTPClient() (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if c.ProxyURL != nil {
+		proxyURL, err := url.Parse(*c.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxyUrl: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if c.CABundle != nil {
+		pem, err := os.ReadFile(*c.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read caBundle: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.New("invalid caBundle: no PEM certificates found")
+		}
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	timeout := APITimeout
+	if c.RequestTimeout != nil {
+		if *c.RequestTimeout <= 0 {
+			return nil, errors.New("requestTimeout must be positive")
+		}
+		timeout = time.Duration(*c.RequestTimeout) * time.Second
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   timeout,
+	}, nil
 }
@@ -0,0 +1,169 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	integration "github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// Not parallel: the test sets environment variables
+func TestConfig_CheckCredentialsFromEnvironment(t *testing.T) {
+	t.Setenv(EnvAPIKey, "env-key")
+	t.Setenv(EnvAPIPassword, "env-password")
+	t.Setenv(EnvCustomerID, "")
+
+	server, err := integration.NewServer(t.Context(),
+		"netcup",
+		semver.Version{Minor: 1},
+		integration.WithProvider(Provider()),
+	)
+	require.NoError(t, err)
+
+	response, err := server.CheckConfig(p.CheckRequest{
+		Inputs: property.NewMap(map[string]property.Value{
+			"apiKey": property.New("config-key"),
+		}),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "config-key", response.Inputs.Get("apiKey").AsString())
+	assert.Equal(t, "env-password", response.Inputs.Get("apiPassword").AsString())
+	require.Len(t, response.Failures, 1)
+	assert.Equal(t, "customerId", response.Failures[0].Property)
+	assert.Contains(t, response.Failures[0].Reason, EnvCustomerID)
+}
+
+func TestConfig_SchemaDefaultsFromEnvironment(t *testing.T) {
+	t.Parallel()
+	server, err := integration.NewServer(t.Context(),
+		"netcup",
+		semver.Version{Minor: 1},
+		integration.WithProvider(Provider()),
+	)
+	require.NoError(t, err)
+
+	schema, err := server.GetSchema(p.GetSchemaRequest{})
+	require.NoError(t, err)
+	for _, env := range []string{EnvAPIKey, EnvAPIPassword, EnvCustomerID} {
+		assert.Contains(t, schema.Schema, `"`+env+`"`)
+	}
+}
+
+func TestConfig_Configure(t *testing.T) {
+	t.Parallel()
+	endpoint := "http://localhost:8080/endpoint.php?JSON"
+	proxyURL := "http://proxy.example.com:3128"
+	requestTimeout := 5
+	userAgent := "test-agent/1.0"
+
+	config := Config{
+		APIKey:         "test-key",
+		APIPassword:    "test-password",
+		CustomerID:     "test-customer",
+		Endpoint:       &endpoint,
+		ProxyURL:       &proxyURL,
+		RequestTimeout: &requestTimeout,
+		UserAgent:      &userAgent,
+	}
+	require.NoError(t, config.Configure(t.Context()))
+
+	client, err := config.netcupClient()
+	require.NoError(t, err)
+	assert.Same(t, config.client, client)
+	assert.Equal(t, endpoint, client.endpoint)
+	assert.Equal(t, userAgent, client.userAgent)
+	assert.Equal(t, 5*time.Second, client.httpClient.Timeout)
+
+	transport, ok := client.httpClient.Transport.(*http.Transport)
+	require.True(t, ok)
+	req, err := http.NewRequest(http.MethodPost, NetcupAPIEndpoint, nil)
+	require.NoError(t, err)
+	proxy, err := transport.Proxy(req)
+	require.NoError(t, err)
+	assert.Equal(t, proxyURL, proxy.String())
+}
+
+// Not parallel: changes the rate of the limiter shared by all clients
+func TestConfig_ConfigureSetsSharedRequestRate(t *testing.T) {
+	t.Cleanup(func() { apiLimiter.setRate(DefaultRequestsPerMinute) })
+	requestsPerMinute := 60
+
+	config := Config{APIKey: "test-key", APIPassword: "test-password", CustomerID: "test-customer"}
+	require.NoError(t, config.Configure(t.Context()))
+	assert.InDelta(t, float64(DefaultRequestsPerMinute)/60, apiLimiter.rate, 1e-9)
+
+	config.RequestsPerMinute = &requestsPerMinute
+	require.NoError(t, config.Configure(t.Context()))
+	assert.InDelta(t, 1.0, apiLimiter.rate, 1e-9)
+
+	client, err := config.netcupClient()
+	require.NoError(t, err)
+	assert.Same(t, apiLimiter, client.limiter)
+}
+
+func TestConfig_InvalidConnectionSettings(t *testing.T) {
+	t.Parallel()
+	missingFile := filepath.Join(t.TempDir(), "missing.pem")
+	emptyFile := filepath.Join(t.TempDir(), "empty.pem")
+	require.NoError(t, os.WriteFile(emptyFile, []byte("not a certificate"), 0o600))
+	invalidURL := "://proxy"
+	zero := 0
+
+	testCases := []struct {
+		name     string
+		config   Config
+		expected string
+	}{
+		{"missing CA bundle", Config{CABundle: &missingFile}, "failed to read caBundle"},
+		{"CA bundle without certificates", Config{CABundle: &emptyFile}, "no PEM certificates found"},
+		{"invalid proxy URL", Config{ProxyURL: &invalidURL}, "invalid proxyUrl"},
+		{"zero request timeout", Config{RequestTimeout: &zero}, "requestTimeout must be positive"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.config.Configure(t.Context())
+			assert.ErrorContains(t, err, tc.expected)
+		})
+	}
+}
+
+func TestNetcupClient_SendsUserAgent(t *testing.T) {
+	t.Parallel()
+	var userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		http.Error(w, "bad request", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL, WithUserAgent("test-agent/1.0"))
+	_, _ = client.login(t.Context())
+	assert.Equal(t, "test-agent/1.0", userAgent)
+}
@@ -0,0 +1,180 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// recordSetPlan is the outcome of matching the desired records of a zone against its existing ones
+type recordSetPlan struct {
+	// records is the record set to write, or nil if the zone already matches
+	records []*DNSRecordInfo
+	// ids holds the ID of the existing record each desired record maps to, empty for new records
+	ids []string
+	// created holds the desired records that have to be added, by index into ids
+	created map[int]*DNSRecordInfo
+}
+
+// planRecordSet works out how to turn the existing records into the desired ones with as few
+// changes as possible. Identical records are kept, records of the same hostname and type are
+// updated in place, the rest of the existing records is deleted unless ignored, and the rest
+// of the desired records is added.
+func planRecordSet(
+	existing, desired []*DNSRecordInfo,
+	ignore func(record *DNSRecordInfo) bool,
+) recordSetPlan {
+	plan := recordSetPlan{
+		ids:     make([]string, len(desired)),
+		created: make(map[int]*DNSRecordInfo),
+	}
+	used := make([]bool, len(existing))
+	modified := false
+
+	match := func(same func(have, want *DNSRecordInfo) bool, onMatch func(have, want *DNSRecordInfo)) {
+		for i, want := range desired {
+			if plan.ids[i] != "" {
+				continue
+			}
+			for j, have := range existing {
+				if used[j] || !same(have, want) {
+					continue
+				}
+				used[j] = true
+				plan.ids[i] = have.ID
+				onMatch(have, want)
+				break
+			}
+		}
+	}
+
+	match(sameDNSRecord, func(_, _ *DNSRecordInfo) {})
+	match(func(have, want *DNSRecordInfo) bool {
+		return strings.EqualFold(have.Hostname, want.Hostname) && strings.EqualFold(have.Type, want.Type)
+	}, func(have, want *DNSRecordInfo) {
+		have.Destination = want.Destination
+		have.Priority = want.Priority
+		modified = true
+	})
+
+	for j, have := range existing {
+		if !used[j] && !ignore(have) {
+			have.DeleteRecord = true
+			modified = true
+		}
+	}
+
+	records := existing
+	for i, want := range desired {
+		if plan.ids[i] != "" {
+			continue
+		}
+		recordCopy := *want
+		plan.created[i] = want
+		records = append(records, &recordCopy)
+		modified = true
+	}
+
+	if modified {
+		plan.records = records
+	}
+	return plan
+}
+
+// sameDNSRecord reports whether two records have the same content. Netcup reports a priority
+// of 0 for record types that have none, and may quote CAA values differently.
+func sameDNSRecord(a, b *DNSRecordInfo) bool {
+	normalizePriority := func(priority string) string {
+		if priority == "" {
+			return "0"
+		}
+		return priority
+	}
+
+	return strings.EqualFold(a.Hostname, b.Hostname) &&
+		strings.EqualFold(a.Type, b.Type) &&
+		!valueChanged(a.Destination, b.Destination, a.Type) &&
+		normalizePriority(a.Priority) == normalizePriority(b.Priority)
+}
+
+// ReplaceDNSRecords makes the desired records the complete record set of the domain in a single
+// updateDnsRecords call. Existing records for which ignore returns true are left alone. The IDs
+// of the records, in the order they were desired, are returned.
+func (c *NetcupClient) ReplaceDNSRecords(
+	ctx context.Context,
+	domain string,
+	desired []*DNSRecordInfo,
+	ignore func(record *DNSRecordInfo) bool,
+) ([]string, error) {
+	var plan recordSetPlan
+	existingRecords, err := c.modifyDNSRecords(ctx, domain, func(records []*DNSRecordInfo) ([]*DNSRecordInfo, error) {
+		plan = planRecordSet(records, desired, ignore)
+		return plan.records, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to replace DNS records: %w", err)
+	}
+
+	if len(plan.created) == 0 {
+		return plan.ids, nil
+	}
+
+	updatedRecords, err := c.getAllDNSRecords(ctx, domain)
+	claimedIDs := make(map[string]bool, len(existingRecords))
+	for _, record := range existingRecords {
+		claimedIDs[record.ID] = true
+	}
+
+	for i := range plan.ids {
+		created, ok := plan.created[i]
+		if !ok {
+			continue
+		}
+		plan.ids[i], err = resolveCreatedRecordID(created, updatedRecords, claimedIDs, err)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return plan.ids, nil
+}
+
+// DeleteDNSRecords deletes the records with the given IDs from the domain in a single
+// updateDnsRecords call. Records that are already gone are skipped.
+func (c *NetcupClient) DeleteDNSRecords(ctx context.Context, domain string, recordIDs []string) error {
+	_, err := c.modifyDNSRecords(ctx, domain, func(records []*DNSRecordInfo) ([]*DNSRecordInfo, error) {
+		modified := false
+		for _, record := range records {
+			for _, recordID := range recordIDs {
+				if record.ID == recordID {
+					record.DeleteRecord = true
+					modified = true
+				}
+			}
+		}
+
+		if !modified {
+			return nil, nil
+		}
+		return records, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete DNS records: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// loginResponseData is the response data of the login action
+type loginResponseData struct {
+	APISessionID flexString `json:"apisessionid"`
+}
+
+// dnsRecordsResponseData is the response data of the infoDnsRecords and updateDnsRecords actions
+type dnsRecordsResponseData struct {
+	DNSRecords []*DNSRecordInfo `json:"dnsrecords"`
+}
+
+// DNSZoneInfo represents the zone settings returned by the infoDnsZone action
+type DNSZoneInfo struct {
+	Name         string `json:"name"`
+	TTL          string `json:"ttl"`
+	Serial       string `json:"serial"`
+	Refresh      string `json:"refresh"`
+	Retry        string `json:"retry"`
+	Expire       string `json:"expire"`
+	DNSSECStatus bool   `json:"dnssecstatus"`
+}
+
+// UnmarshalJSON decodes zone settings, accepting numbers and strings for every field
+func (z *DNSZoneInfo) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Name         flexString `json:"name"`
+		TTL          flexString `json:"ttl"`
+		Serial       flexString `json:"serial"`
+		Refresh      flexString `json:"refresh"`
+		Retry        flexString `json:"retry"`
+		Expire       flexString `json:"expire"`
+		DNSSECStatus flexBool   `json:"dnssecstatus"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*z = DNSZoneInfo{
+		Name:         string(raw.Name),
+		TTL:          string(raw.TTL),
+		Serial:       string(raw.Serial),
+		Refresh:      string(raw.Refresh),
+		Retry:        string(raw.Retry),
+		Expire:       string(raw.Expire),
+		DNSSECStatus: bool(raw.DNSSECStatus),
+	}
+	return nil
+}
+
+// DomainInfo represents a domain as returned by the listallDomains and infoDomain actions
+type DomainInfo struct {
+	Name            string
+	State           string
+	Nameservers     []string
+	RuntimeStart    string
+	RuntimeEnd      string
+	AssignedHandles []DomainHandle
+}
+
+// DomainHandle is a contact handle assigned to a domain, such as its owner or admin contact
+type DomainHandle struct {
+	Type     string
+	HandleID string
+}
+
+// UnmarshalJSON decodes a domain, accepting numbers and strings for every field
+func (d *DomainInfo) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Name        flexString `json:"domainname"`
+		State       flexString `json:"state"`
+		Nameservers []struct {
+			Hostname flexString `json:"hostname"`
+		} `json:"nameserverentry"`
+		RuntimeStart    flexString `json:"runtimestart"`
+		RuntimeEnd      flexString `json:"runtimeend"`
+		AssignedHandles []struct {
+			Type     flexString `json:"type"`
+			HandleID flexString `json:"handle_id"`
+		} `json:"assignedhandles"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*d = DomainInfo{
+		Name:         string(raw.Name),
+		State:        string(raw.State),
+		RuntimeStart: string(raw.RuntimeStart),
+		RuntimeEnd:   string(raw.RuntimeEnd),
+	}
+	for _, nameserver := range raw.Nameservers {
+		if nameserver.Hostname != "" {
+			d.Nameservers = append(d.Nameservers, string(nameserver.Hostname))
+		}
+	}
+	for _, handle := range raw.AssignedHandles {
+		d.AssignedHandles = append(d.AssignedHandles, DomainHandle{
+			Type:     string(handle.Type),
+			HandleID: string(handle.HandleID),
+		})
+	}
+	return nil
+}
+
+// UnmarshalJSON decodes a DNS record, accepting numbers and strings for every field
+func (r *DNSRecordInfo) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		ID           flexString `json:"id"`
+		Hostname     flexString `json:"hostname"`
+		Type         flexString `json:"type"`
+		Priority     flexString `json:"priority"`
+		Destination  flexString `json:"destination"`
+		DeleteRecord flexBool   `json:"deleterecord"`
+		State        flexString `json:"state"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*r = DNSRecordInfo{
+		ID:           string(raw.ID),
+		Hostname:     string(raw.Hostname),
+		Type:         string(raw.Type),
+		Priority:     string(raw.Priority),
+		Destination:  string(raw.Destination),
+		DeleteRecord: bool(raw.DeleteRecord),
+		State:        string(raw.State),
+	}
+	return nil
+}
+
+// decodeResponseData decodes the response data of a successful response into target.
+// Netcup sends an empty string or null instead of an object when there is no data,
+// which leaves target at its zero value.
+func decodeResponseData(response *NetcupAPIResponse, target any) error {
+	data := bytes.TrimSpace(response.ResponseData)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) || bytes.Equal(data, []byte(`""`)) {
+		return nil
+	}
+
+	if err := json.Unmarshal(data, target); err != nil {
+		return fmt.Errorf("invalid %s response format: %w", response.Action, err)
+	}
+	return nil
+}
+
+// flexString decodes JSON strings, numbers and booleans into their string form
+type flexString string
+
+// UnmarshalJSON implements json.Unmarshaler
+func (s *flexString) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		*s = ""
+	case len(data) > 0 && data[0] == '"':
+		var value string
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		*s = flexString(value)
+	case bytes.Equal(data, []byte("true")), bytes.Equal(data, []byte("false")):
+		*s = flexString(data)
+	default:
+		var value json.Number
+		if err := json.Unmarshal(data, &value); err != nil {
+			return fmt.Errorf("expected a string or number, got %s", data)
+		}
+		*s = flexString(value.String())
+	}
+	return nil
+}
+
+// flexBool decodes JSON booleans as well as their string and numeric spellings
+type flexBool bool
+
+// UnmarshalJSON implements json.Unmarshaler
+func (b *flexBool) UnmarshalJSON(data []byte) error {
+	var value flexString
+	if err := value.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	switch strings.ToLower(strings.TrimSpace(string(value))) {
+	case "", "false", "no", "0":
+		*b = false
+		return nil
+	case "yes":
+		*b = true
+		return nil
+	}
+
+	parsed, err := strconv.ParseBool(string(value))
+	if err != nil {
+		return fmt.Errorf("expected a boolean, got %s", data)
+	}
+	*b = flexBool(parsed)
+	return nil
+}
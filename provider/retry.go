@@ -0,0 +1,157 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Rate limiting and retry defaults
+const (
+	DefaultRequestsPerMinute = 180
+	DefaultMaxRetries        = 5
+	DefaultRetryBaseDelay    = 1 * time.Second
+	DefaultRetryMaxDelay     = 30 * time.Second
+)
+
+// readOnlyActions are the API actions that can be sent again after a transient failure without
+// side effects. Writes may have been applied before the failure and are reconciled instead.
+var readOnlyActions = map[string]bool{
+	"login":          true,
+	"infoDnsRecords": true,
+	"infoDnsZone":    true,
+	"listallDomains": true,
+	"infoDomain":     true,
+}
+
+// errWriteUnconfirmed marks a write that failed in transit, so Netcup may or may not have applied it
+var errWriteUnconfirmed = errors.New("outcome of write unknown")
+
+// rateLimiterBurst is how many requests may be sent back to back before the limiter paces them
+const rateLimiterBurst = 10
+
+// rateLimiter is a token bucket that spaces out requests to stay below a per-minute limit
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64 // tokens per second
+	tokens float64
+	last   time.Time
+}
+
+// apiLimiter is shared by every client in the provider process, since Netcup enforces
+// its limit per account rather than per connection.
+var apiLimiter = newRateLimiter(DefaultRequestsPerMinute)
+
+func newRateLimiter(requestsPerMinute int) *rateLimiter {
+	return &rateLimiter{
+		rate:   float64(requestsPerMinute) / 60,
+		tokens: rateLimiterBurst,
+		last:   time.Now(),
+	}
+}
+
+// setRate changes the limit for all subsequent requests
+func (l *rateLimiter) setRate(requestsPerMinute int) {
+	if requestsPerMinute <= 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rate = float64(requestsPerMinute) / 60
+}
+
+// reserve takes a token from the bucket and returns how long the caller must wait before using it
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens = min(rateLimiterBurst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// wait blocks until the next request may be sent or the context is done
+func (l *rateLimiter) wait(ctx context.Context) error {
+	return sleepContext(ctx, l.reserve())
+}
+
+// sleepContext pauses for the given duration, returning early with the context's error if it is done
+func sleepContext(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// backoff returns the delay before the given retry attempt (starting at 1),
+// using exponential growth capped at maxDelay with full jitter.
+func backoff(attempt int, baseDelay, maxDelay time.Duration) time.Duration {
+	delay := maxDelay
+	if shift := attempt - 1; shift < 32 && baseDelay<<shift < maxDelay && baseDelay<<shift > 0 {
+		delay = baseDelay << shift
+	}
+	return rand.N(delay) + 1
+}
+
+// transientFailure reports whether a request that failed in transit may be sent again. Only
+// read-only actions are; the error of a write is marked with errWriteUnconfirmed.
+func transientFailure(action string, err error) (*NetcupAPIResponse, bool, error) {
+	if readOnlyActions[action] {
+		return nil, true, err
+	}
+	return nil, false, fmt.Errorf("%w: %w", errWriteUnconfirmed, err)
+}
+
+// isRetryableHTTPError reports whether a transport error is worth retrying
+func isRetryableHTTPError(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
+
+// isRetryableHTTPStatus reports whether an HTTP status indicates a transient server failure
+func isRetryableHTTPStatus(statusCode int) bool {
+	return statusCode >= http.StatusInternalServerError
+}
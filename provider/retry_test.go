@@ -0,0 +1,75 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter_Reserve(t *testing.T) {
+	t.Parallel()
+	limiter := newRateLimiter(60)
+
+	for i := 0; i < rateLimiterBurst; i++ {
+		assert.Zero(t, limiter.reserve(), "burst request %d should not wait", i)
+	}
+
+	// At one request per second the next two requests queue up behind each other
+	assert.InDelta(t, time.Second, limiter.reserve(), float64(50*time.Millisecond))
+	assert.InDelta(t, 2*time.Second, limiter.reserve(), float64(50*time.Millisecond))
+
+	limiter.setRate(0)
+	assert.InDelta(t, 1, limiter.rate, 0.001, "non-positive rates are ignored")
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+	for attempt := 1; attempt <= 40; attempt++ {
+		limit := min(time.Second<<min(attempt-1, 10), 30*time.Second)
+		for i := 0; i < 20; i++ {
+			delay := backoff(attempt, time.Second, 30*time.Second)
+			assert.Positive(t, delay)
+			assert.LessOrEqual(t, delay, limit, "attempt %d", attempt)
+		}
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	t.Parallel()
+	assert.True(t, isRetryableHTTPError(fmt.Errorf("post: %w", syscall.ECONNRESET)))
+	assert.True(t, isRetryableHTTPError(io.ErrUnexpectedEOF))
+	assert.True(t, isRetryableHTTPError(timeoutError{}))
+	assert.False(t, isRetryableHTTPError(errors.New("unsupported protocol scheme")))
+	assert.False(t, isRetryableHTTPError(context.Canceled))
+
+	assert.True(t, isRetryableHTTPStatus(http.StatusBadGateway))
+	assert.False(t, isRetryableHTTPStatus(http.StatusNotFound))
+}
+
+// timeoutError mimics the net.Error returned by an HTTP client timeout
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
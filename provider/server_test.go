@@ -0,0 +1,65 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	integration "github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+
+	"github.com/blackdark/pulumi-netcup/provider/netcuptest"
+)
+
+// newTestProviderServer starts an in-memory Netcup API and a provider configured against it.
+// The API is closed when the test finishes.
+func newTestProviderServer(t *testing.T) (integration.Server, *netcuptest.Server) {
+	t.Helper()
+	return newTestProviderServerWithConfig(t, nil)
+}
+
+// newTestProviderServerWithConfig is like newTestProviderServer, adding the given provider
+// configuration to the credentials and endpoint of the in-memory API
+func newTestProviderServerWithConfig(
+	t *testing.T,
+	config map[string]property.Value,
+) (integration.Server, *netcuptest.Server) {
+	t.Helper()
+	netcup := netcuptest.NewServer()
+	t.Cleanup(netcup.Close)
+
+	server, err := integration.NewServer(t.Context(),
+		"netcup",
+		semver.Version{Minor: 1},
+		integration.WithProvider(Provider()),
+	)
+	require.NoError(t, err)
+
+	args := map[string]property.Value{
+		"apiKey":      property.New(netcuptest.APIKey),
+		"apiPassword": property.New(netcuptest.APIPassword),
+		"customerId":  property.New(netcuptest.CustomerNumber),
+		"endpoint":    property.New(netcup.URL),
+	}
+	maps.Copy(args, config)
+	require.NoError(t, server.Configure(p.ConfigureRequest{Args: property.NewMap(args)}))
+
+	return server, netcup
+}
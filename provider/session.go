@@ -0,0 +1,133 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// errSessionExpired signals that the cached session must be discarded and the call retried
+var errSessionExpired = errors.New("API session expired")
+
+// sessionKey identifies the account and endpoint a session belongs to
+type sessionKey struct {
+	endpoint    string
+	customerID  string
+	apiKey      string
+	apiPassword string
+}
+
+// sessionManager caches a single Netcup API session and shares it between concurrent callers
+type sessionManager struct {
+	mu        sync.Mutex
+	sessionID string
+}
+
+// sessionRegistry holds one session manager per account for the lifetime of the provider process
+var sessionRegistry = struct {
+	mu       sync.Mutex
+	managers map[sessionKey]*sessionManager
+	logouts  map[sessionKey]func(ctx context.Context, sessionID string) error
+}{
+	managers: make(map[sessionKey]*sessionManager),
+	logouts:  make(map[sessionKey]func(ctx context.Context, sessionID string) error),
+}
+
+// sharedSession returns the process-wide session manager for the client's account
+func sharedSession(c *NetcupClient) *sessionManager {
+	key := sessionKey{
+		endpoint:    c.endpoint,
+		customerID:  c.customerID,
+		apiKey:      c.apiKey,
+		apiPassword: c.apiPassword,
+	}
+
+	sessionRegistry.mu.Lock()
+	defer sessionRegistry.mu.Unlock()
+
+	manager, ok := sessionRegistry.managers[key]
+	if !ok {
+		manager = &sessionManager{}
+		sessionRegistry.managers[key] = manager
+	}
+	sessionRegistry.logouts[key] = c.logout
+
+	return manager
+}
+
+// acquire returns the cached session ID, logging in first if there is none.
+// Concurrent callers wait for a single login instead of each creating a session.
+func (s *sessionManager) acquire(
+	ctx context.Context,
+	login func(ctx context.Context) (string, error),
+) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.sessionID != "" {
+		return s.sessionID, nil
+	}
+
+	sessionID, err := login(ctx)
+	if err != nil {
+		return "", err
+	}
+	s.sessionID = sessionID
+
+	return sessionID, nil
+}
+
+// invalidate drops the cached session if it is still the given one
+func (s *sessionManager) invalidate(sessionID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.sessionID == sessionID {
+		s.sessionID = ""
+	}
+}
+
+// close logs out of the cached session, if any
+func (s *sessionManager) close(ctx context.Context, logout func(ctx context.Context, sessionID string) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.sessionID == "" {
+		return nil
+	}
+
+	sessionID := s.sessionID
+	s.sessionID = ""
+
+	return logout(ctx, sessionID)
+}
+
+// CloseSessions logs out of every cached Netcup API session.
+// It is called once when the provider process shuts down.
+func CloseSessions(ctx context.Context) error {
+	sessionRegistry.mu.Lock()
+	defer sessionRegistry.mu.Unlock()
+
+	var errs []error
+	for key, manager := range sessionRegistry.managers {
+		if err := manager.close(ctx, sessionRegistry.logouts[key]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
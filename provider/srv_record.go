@@ -0,0 +1,207 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// maxServiceNameLength is the maximum length of a service name as defined in RFC 6335
+const maxServiceNameLength = 15
+
+var dnsLabelPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// SRVRecord contains the structured inputs of an SRV record as defined in RFC 2782
+type SRVRecord struct {
+	Service  string `pulumi:"service"`
+	Protocol string `pulumi:"protocol"`
+	Weight   *int   `pulumi:"weight,optional"`
+	Port     int    `pulumi:"port"`
+	Target   string `pulumi:"target"`
+}
+
+// Annotate provides metadata about the SRVRecord.
+func (srv *SRVRecord) Annotate(a infer.Annotator) {
+	a.Describe(&srv.Service, "The symbolic name of the service without leading underscore (e.g., 'sip', 'xmpp-client')")
+	a.Describe(&srv.Protocol, "The transport protocol without leading underscore (e.g., 'tcp', 'udp', 'tls')")
+	a.Describe(&srv.Weight, "The relative weight of records with the same priority, between 0 and 65535")
+	a.SetDefault(&srv.Weight, 0)
+	a.Describe(&srv.Port, "The port the service is offered on, between 0 and 65535")
+	a.Describe(&srv.Target, "The hostname of the server offering the service, or '.' if the service is not offered")
+}
+
+// hostnamePrefix returns the "_service._proto" labels the record is published under
+func (srv SRVRecord) hostnamePrefix() string {
+	return fmt.Sprintf("_%s._%s", srv.Service, srv.Protocol)
+}
+
+// value returns the destination of the record in the "weight port target" format of Netcup
+func (srv SRVRecord) value() string {
+	weight := 0
+	if srv.Weight != nil {
+		weight = *srv.Weight
+	}
+	return fmt.Sprintf("%d %d %s", weight, srv.Port, srv.Target)
+}
+
+// normalizeSRVRecord normalizes the structured SRV inputs
+func normalizeSRVRecord(srv *SRVRecord) *SRVRecord {
+	if srv == nil {
+		return nil
+	}
+	normalized := *srv
+	normalized.Service = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(srv.Service)), "_")
+	normalized.Protocol = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(srv.Protocol)), "_")
+	normalized.Target = strings.ToLower(strings.TrimSpace(srv.Target))
+	return &normalized
+}
+
+// composeSRVRecord derives the hostname and value of a record with structured SRV inputs
+func composeSRVRecord(args DNSRecordArgs) DNSRecordArgs {
+	if args.SRV == nil {
+		return args
+	}
+
+	args.Name = prefixRecordName(args.Name, args.SRV.hostnamePrefix())
+	if args.Value == "" {
+		args.Value = args.SRV.value()
+	}
+	return args
+}
+
+// validateSRVRecord validates the structured SRV inputs of a record
+func validateSRVRecord(args DNSRecordArgs) []p.CheckFailure {
+	srv := args.SRV
+	if srv == nil {
+		return nil
+	}
+
+	var failures []p.CheckFailure
+	if !strings.EqualFold(args.Type, "SRV") {
+		return append(failures, p.CheckFailure{
+			Property: "srv",
+			Reason:   fmt.Sprintf("srv can only be set for SRV records, not %s", args.Type),
+		})
+	}
+
+	if args.Value != srv.value() {
+		failures = append(failures, p.CheckFailure{
+			Property: "value",
+			Reason:   "Only one of value and srv can be set",
+		})
+	}
+
+	if !dnsLabelPattern.MatchString(srv.Service) || len(srv.Service) > maxServiceNameLength {
+		failures = append(failures, p.CheckFailure{
+			Property: "srv.service",
+			Reason: fmt.Sprintf(
+				"Service %q must be 1 to %d lowercase letters, digits and hyphens", srv.Service, maxServiceNameLength,
+			),
+		})
+	}
+
+	if !dnsLabelPattern.MatchString(srv.Protocol) {
+		failures = append(failures, p.CheckFailure{
+			Property: "srv.protocol",
+			Reason:   fmt.Sprintf("Protocol %q must be a DNS label such as tcp or udp", srv.Protocol),
+		})
+	}
+
+	if srv.Weight != nil && !isUint16(*srv.Weight) {
+		failures = append(failures, p.CheckFailure{
+			Property: "srv.weight",
+			Reason:   fmt.Sprintf("Weight must be between 0 and 65535, got %d", *srv.Weight),
+		})
+	}
+
+	if !isUint16(srv.Port) {
+		failures = append(failures, p.CheckFailure{
+			Property: "srv.port",
+			Reason:   fmt.Sprintf("Port must be between 0 and 65535, got %d", srv.Port),
+		})
+	}
+
+	if srv.Target != "." && !isValidHostname(srv.Target) {
+		failures = append(failures, p.CheckFailure{
+			Property: "srv.target",
+			Reason:   fmt.Sprintf("Target %q must be a hostname or '.'", srv.Target),
+		})
+	}
+
+	if args.Priority != nil && *args.Priority != "" {
+		if priority, err := strconv.Atoi(*args.Priority); err != nil || !isUint16(priority) {
+			failures = append(failures, p.CheckFailure{
+				Property: "priority",
+				Reason:   fmt.Sprintf("Priority must be between 0 and 65535, got %q", *args.Priority),
+			})
+		}
+	}
+
+	return failures
+}
+
+// parseSRVRecord decomposes the hostname and value of an SRV record into its structured inputs
+func parseSRVRecord(hostname, value string) (*SRVRecord, bool) {
+	labels := strings.SplitN(hostname, ".", 3)
+	if len(labels) < 2 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+		return nil, false
+	}
+
+	fields := strings.Fields(value)
+	if len(fields) != 3 {
+		return nil, false
+	}
+	weight, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return nil, false
+	}
+	port, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return nil, false
+	}
+
+	return &SRVRecord{
+		Service:  strings.TrimPrefix(labels[0], "_"),
+		Protocol: strings.TrimPrefix(labels[1], "_"),
+		Weight:   &weight,
+		Port:     port,
+		Target:   fields[2],
+	}, true
+}
+
+// isUint16 reports whether the value fits the 16 bit fields of DNS records
+func isUint16(value int) bool {
+	return value >= 0 && value <= 0xffff
+}
+
+// isValidHostname reports whether the name, with or without trailing dot, consists of valid DNS labels
+func isValidHostname(name string) bool {
+	name = strings.TrimSuffix(name, ".")
+	if name == "" || len(name) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(name, ".") {
+		if len(label) > 63 || !dnsLabelPattern.MatchString(strings.ToLower(label)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,184 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	integration "github.com/pulumi/pulumi-go-provider/integration"
+	presource "github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+
+	"github.com/blackdark/pulumi-netcup/provider/netcuptest"
+)
+
+func srvInputs(name string, srv map[string]property.Value) property.Map {
+	return property.NewMap(map[string]property.Value{
+		"domain":   property.New("example.com"),
+		"name":     property.New(name),
+		"type":     property.New("SRV"),
+		"priority": property.New("10"),
+		"srv":      property.New(srv),
+	})
+}
+
+func TestDnsRecordCheckSRV(t *testing.T) {
+	t.Parallel()
+	check := func(inputs property.Map) infer.CheckResponse[DNSRecordArgs] {
+		response, err := (&DNSRecord{}).Check(t.Context(), infer.CheckRequest{NewInputs: inputs})
+		require.NoError(t, err)
+		return response
+	}
+
+	response := check(srvInputs("@", map[string]property.Value{
+		"service":  property.New("_SIP"),
+		"protocol": property.New("tcp"),
+		"port":     property.New(5060.0),
+		"target":   property.New("sip.example.com."),
+	}))
+	assert.Empty(t, response.Failures)
+	assert.Equal(t, "_sip._tcp", response.Inputs.Name)
+	assert.Equal(t, "0 5060 sip.example.com.", response.Inputs.Value)
+
+	// Composing the checked inputs again does not change them
+	assert.Equal(t, response.Inputs, composeSRVRecord(response.Inputs))
+
+	response = check(srvInputs("voip", map[string]property.Value{
+		"service":  property.New("sip"),
+		"protocol": property.New("udp"),
+		"weight":   property.New(60.0),
+		"port":     property.New(5060.0),
+		"target":   property.New("sip"),
+	}))
+	assert.Empty(t, response.Failures)
+	assert.Equal(t, "_sip._udp.voip", response.Inputs.Name)
+	assert.Equal(t, "60 5060 sip", response.Inputs.Value)
+
+	response = check(srvInputs("@", map[string]property.Value{
+		"service":  property.New("a-very-long-service-name"),
+		"protocol": property.New("t cp"),
+		"weight":   property.New(70000.0),
+		"port":     property.New(-1.0),
+		"target":   property.New("bad_host"),
+	}))
+	var properties []string
+	for _, failure := range response.Failures {
+		properties = append(properties, failure.Property)
+	}
+	assert.Equal(t, []string{"srv.service", "srv.protocol", "srv.weight", "srv.port", "srv.target"}, properties)
+
+	inputs := srvInputs("@", map[string]property.Value{
+		"service":  property.New("sip"),
+		"protocol": property.New("tcp"),
+		"port":     property.New(5060.0),
+		"target":   property.New("."),
+	})
+	response = check(inputs.Set("value", property.New("0 5061 .")))
+	require.Len(t, response.Failures, 1)
+	assert.Equal(t, "Only one of value and srv can be set", response.Failures[0].Reason)
+
+	response = check(inputs.Set("type", property.New("MX")))
+	require.Len(t, response.Failures, 1)
+	assert.Equal(t, "srv can only be set for SRV records, not MX", response.Failures[0].Reason)
+}
+
+func TestParseSRVRecord(t *testing.T) {
+	t.Parallel()
+	srv, ok := parseSRVRecord("_xmpp-client._tcp.chat", "5 5222 xmpp.example.com")
+	require.True(t, ok)
+	weight := 5
+	assert.Equal(t, &SRVRecord{
+		Service: "xmpp-client", Protocol: "tcp", Weight: &weight, Port: 5222, Target: "xmpp.example.com",
+	}, srv)
+
+	_, ok = parseSRVRecord("sip", "5 5060 sip.example.com")
+	assert.False(t, ok)
+	_, ok = parseSRVRecord("_sip._tcp", "5060 sip.example.com")
+	assert.False(t, ok)
+}
+
+func TestDnsRecordSRVLifecycle(t *testing.T) {
+	t.Parallel()
+	server, netcup := newTestProviderServer(t)
+	netcup.AddDomain("example.com")
+
+	destinations := func() []string {
+		var records []string
+		for _, record := range netcup.Records("example.com") {
+			records = append(records, record.Hostname+" "+record.Priority+" "+record.Destination)
+		}
+		return records
+	}
+
+	var id string
+	integration.LifeCycleTest{
+		Resource: "netcup:index:DNSRecord",
+		Create: integration.Operation{
+			Inputs: srvInputs("@", map[string]property.Value{
+				"service":  property.New("sip"),
+				"protocol": property.New("tcp"),
+				"weight":   property.New(60.0),
+				"port":     property.New(5060.0),
+				"target":   property.New("sip.example.com"),
+			}),
+			Hook: func(inputs, output property.Map) {
+				id = createCompositeID("example.com", output.Get("recordId").AsString())
+				assert.Equal(t, []string{"_sip._tcp 10 60 5060 sip.example.com"}, destinations())
+				assert.Equal(t, "_sip._tcp.example.com", output.Get("fqdn").AsString())
+			},
+		},
+		Updates: []integration.Operation{
+			{
+				Inputs: srvInputs("@", map[string]property.Value{
+					"service":  property.New("sip"),
+					"protocol": property.New("tcp"),
+					"weight":   property.New(60.0),
+					"port":     property.New(5061.0),
+					"target":   property.New("sip.example.com"),
+				}),
+				Hook: func(inputs, output property.Map) {
+					assert.Equal(t, []string{"_sip._tcp 10 60 5061 sip.example.com"}, destinations())
+				},
+			},
+		},
+	}.Run(t, server)
+
+	// Reading a record declared with structured inputs decomposes the live value
+	recordID := netcup.AddRecord("example.com", netcuptest.Record{
+		Hostname: "_sip._tcp", Type: "SRV", Priority: "10", Destination: "20 5062 sip2.example.com",
+	})
+	assert.NotEqual(t, id, createCompositeID("example.com", recordID))
+	response, err := server.Read(p.ReadRequest{
+		ID:  createCompositeID("example.com", recordID),
+		Urn: presource.NewURN("stack", "project", "", "netcup:index:DNSRecord", "record"),
+		Inputs: srvInputs("@", map[string]property.Value{
+			"service":  property.New("sip"),
+			"protocol": property.New("tcp"),
+			"port":     property.New(5060.0),
+			"target":   property.New("sip.example.com"),
+		}),
+	})
+	require.NoError(t, err)
+	srv := response.Inputs.Get("srv").AsMap()
+	assert.Equal(t, 20.0, srv.Get("weight").AsNumber())
+	assert.Equal(t, 5062.0, srv.Get("port").AsNumber())
+	assert.Equal(t, "sip2.example.com", srv.Get("target").AsString())
+	assert.Equal(t, "_sip._tcp", response.Inputs.Get("name").AsString())
+}
@@ -0,0 +1,258 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"crypto/sha1" //nolint:gosec // SHA-1 fingerprints are defined by RFC 4255
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// SSHFP fingerprint types as defined in RFC 4255 and RFC 6594
+const (
+	SSHFPFingerprintSHA1   = 1
+	SSHFPFingerprintSHA256 = 2
+)
+
+// sshfpAlgorithms maps OpenSSH host key types to SSHFP algorithm numbers as defined in
+// RFC 4255, RFC 6594 and RFC 7479
+var sshfpAlgorithms = map[string]int{
+	"ssh-rsa":             1,
+	"ecdsa-sha2-nistp256": 3,
+	"ecdsa-sha2-nistp384": 3,
+	"ecdsa-sha2-nistp521": 3,
+	"ssh-ed25519":         4,
+}
+
+// GetSSHFPRecords derives SSHFP records from SSH host keys.
+type GetSSHFPRecords struct{}
+
+// Annotate provides metadata about the getSshfpRecords function.
+func (f *GetSSHFPRecords) Annotate(a infer.Annotator) {
+	a.SetToken("index", "getSshfpRecords")
+	a.Describe(&f, "Returns the SHA-1 and SHA-256 SSHFP records of SSH host keys, such as the contents of "+
+		"/etc/ssh/ssh_host_*_key.pub")
+}
+
+// GetSSHFPRecordsArgs contains the input arguments for the getSshfpRecords function.
+type GetSSHFPRecordsArgs struct {
+	PublicKeys []string `pulumi:"publicKeys"`
+}
+
+// Annotate provides metadata about the GetSSHFPRecordsArgs.
+func (args *GetSSHFPRecordsArgs) Annotate(a infer.Annotator) {
+	a.Describe(&args.PublicKeys, "The RSA, ECDSA or Ed25519 host keys in OpenSSH authorized_keys format")
+}
+
+// GetSSHFPRecordsResult contains the result of the getSshfpRecords function.
+type GetSSHFPRecordsResult struct {
+	Records []SSHFPRecordEntry `pulumi:"records"`
+}
+
+// Annotate provides metadata about the GetSSHFPRecordsResult.
+func (result *GetSSHFPRecordsResult) Annotate(a infer.Annotator) {
+	a.Describe(&result.Records, "The SSHFP records, a SHA-1 and a SHA-256 record per key")
+}
+
+// SSHFPRecordEntry is a single SSHFP record derived from a host key.
+type SSHFPRecordEntry struct {
+	Algorithm       int    `pulumi:"algorithm"`
+	FingerprintType int    `pulumi:"fingerprintType"`
+	Fingerprint     string `pulumi:"fingerprint"`
+	Value           string `pulumi:"value"`
+}
+
+// Annotate provides metadata about the SSHFPRecordEntry.
+func (entry *SSHFPRecordEntry) Annotate(a infer.Annotator) {
+	a.Describe(&entry.Algorithm, "The SSHFP algorithm number: 1 (RSA), 3 (ECDSA) or 4 (Ed25519)")
+	a.Describe(&entry.FingerprintType, "The fingerprint type: 1 (SHA-1) or 2 (SHA-256)")
+	a.Describe(&entry.Fingerprint, "The hex encoded fingerprint of the key")
+	a.Describe(&entry.Value, "The value of the SSHFP record")
+}
+
+// Invoke derives the SSHFP records of the keys.
+func (f *GetSSHFPRecords) Invoke(
+	_ context.Context,
+	req infer.FunctionRequest[GetSSHFPRecordsArgs],
+) (infer.FunctionResponse[GetSSHFPRecordsResult], error) {
+	var records []SSHFPRecordEntry
+	for i, key := range req.Input.PublicKeys {
+		keyRecords, err := sshfpRecords(key)
+		if err != nil {
+			return infer.FunctionResponse[GetSSHFPRecordsResult]{}, fmt.Errorf("invalid public key %d: %w", i, err)
+		}
+		records = append(records, keyRecords...)
+	}
+
+	return infer.FunctionResponse[GetSSHFPRecordsResult]{Output: GetSSHFPRecordsResult{Records: records}}, nil
+}
+
+// sshfpRecords derives the SHA-1 and SHA-256 records of the keys of an authorized_keys
+// formatted text. Empty lines and comments are skipped.
+func sshfpRecords(publicKeys string) ([]SSHFPRecordEntry, error) {
+	var records []SSHFPRecordEntry
+	for line := range strings.Lines(publicKeys) {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		algorithm, blob, err := parseSSHPublicKey(line)
+		if err != nil {
+			return nil, err
+		}
+
+		sha1Digest := sha1.Sum(blob) //nolint:gosec // SHA-1 fingerprints are defined by RFC 4255
+		sha256Digest := sha256.Sum256(blob)
+		for _, fingerprint := range []struct {
+			fingerprintType int
+			digest          []byte
+		}{
+			{SSHFPFingerprintSHA1, sha1Digest[:]},
+			{SSHFPFingerprintSHA256, sha256Digest[:]},
+		} {
+			hexDigest := hex.EncodeToString(fingerprint.digest)
+			records = append(records, SSHFPRecordEntry{
+				Algorithm:       algorithm,
+				FingerprintType: fingerprint.fingerprintType,
+				Fingerprint:     hexDigest,
+				Value:           fmt.Sprintf("%d %d %s", algorithm, fingerprint.fingerprintType, hexDigest),
+			})
+		}
+	}
+
+	if len(records) == 0 {
+		return nil, errors.New("no public key found")
+	}
+	return records, nil
+}
+
+// parseSSHPublicKey parses an authorized_keys line and returns the SSHFP algorithm number and
+// the key in SSH wire format. Leading options and trailing comments are ignored.
+func parseSSHPublicKey(line string) (int, []byte, error) {
+	fields := strings.Fields(line)
+	for i, field := range fields[:len(fields)-1] {
+		algorithm, ok := sshfpAlgorithms[field]
+		if !ok {
+			continue
+		}
+
+		blob, err := base64.StdEncoding.DecodeString(fields[i+1])
+		if err != nil {
+			return 0, nil, fmt.Errorf("invalid %s key: %w", field, err)
+		}
+
+		// The key starts with its length-prefixed type
+		if len(blob) < 4 {
+			return 0, nil, fmt.Errorf("invalid %s key: truncated", field)
+		}
+		length := binary.BigEndian.Uint32(blob)
+		if uint64(len(blob)) < 4+uint64(length) || string(blob[4:4+length]) != field {
+			return 0, nil, fmt.Errorf("invalid %s key: encoded key type does not match", field)
+		}
+
+		return algorithm, blob, nil
+	}
+
+	return 0, nil, fmt.Errorf("unsupported key %q, expected an ssh-rsa, ecdsa-sha2-* or ssh-ed25519 key",
+		truncateString(line, 40))
+}
+
+// truncateString shortens a string for error messages
+func truncateString(s string, length int) string {
+	if len(s) <= length {
+		return s
+	}
+	return s[:length] + "..."
+}
+
+// SSHFPRecordSet contains the host keys the values of an SSHFP record set are derived from.
+type SSHFPRecordSet struct {
+	PublicKeys []string `pulumi:"publicKeys"`
+}
+
+// Annotate provides metadata about the SSHFPRecordSet.
+func (sshfp *SSHFPRecordSet) Annotate(a infer.Annotator) {
+	a.Describe(&sshfp.PublicKeys, "The RSA, ECDSA or Ed25519 host keys in OpenSSH authorized_keys format")
+}
+
+// sshfpRecordSetValues derives the values of an SSHFP record set and reports invalid keys
+func sshfpRecordSetValues(sshfp SSHFPRecordSet) ([]DNSRecordSetValue, []p.CheckFailure) {
+	var values []DNSRecordSetValue
+	var failures []p.CheckFailure
+	seen := make(map[string]bool)
+
+	for i, key := range sshfp.PublicKeys {
+		records, err := sshfpRecords(key)
+		if err != nil {
+			failures = append(failures, p.CheckFailure{
+				Property: fmt.Sprintf("sshfp.publicKeys[%d]", i),
+				Reason:   err.Error(),
+			})
+			continue
+		}
+		for _, record := range records {
+			if !seen[record.Value] {
+				seen[record.Value] = true
+				values = append(values, DNSRecordSetValue{Value: record.Value})
+			}
+		}
+	}
+
+	if len(sshfp.PublicKeys) == 0 {
+		failures = append(failures, p.CheckFailure{
+			Property: "sshfp.publicKeys",
+			Reason:   "At least one public key is required",
+		})
+	}
+	return values, failures
+}
+
+// composeSSHFPRecordSet derives the values of a record set with host keys
+func composeSSHFPRecordSet(args *DNSRecordSetArgs) []p.CheckFailure {
+	if args.Type != "SSHFP" {
+		return []p.CheckFailure{{
+			Property: "sshfp",
+			Reason:   fmt.Sprintf("sshfp can only be set for SSHFP records, not %s", args.Type),
+		}}
+	}
+
+	values, failures := sshfpRecordSetValues(*args.SSHFP)
+	if len(failures) > 0 {
+		return failures
+	}
+
+	if len(args.Values) > 0 {
+		given := DNSRecordSetArgs{Name: args.Name, Type: args.Type, Values: args.Values}
+		derived := DNSRecordSetArgs{Name: args.Name, Type: args.Type, Values: values}
+		if !sameDNSRecordSet(given.recordInfos(), derived.recordInfos()) {
+			return []p.CheckFailure{{
+				Property: "values",
+				Reason:   "Only one of values and sshfp can be set",
+			}}
+		}
+	}
+	args.Values = values
+	return nil
+}
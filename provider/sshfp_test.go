@@ -0,0 +1,160 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // SHA-1 fingerprints are defined by RFC 4255
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// sshWireKey encodes a key type and its fields as length-prefixed strings of the SSH wire format
+func sshWireKey(keyType string, fields ...[]byte) []byte {
+	var blob []byte
+	for _, field := range append([][]byte{[]byte(keyType)}, fields...) {
+		blob = binary.BigEndian.AppendUint32(blob, uint32(len(field)))
+		blob = append(blob, field...)
+	}
+	return blob
+}
+
+func newTestEd25519HostKey(t *testing.T) (string, []byte) {
+	t.Helper()
+	public, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	blob := sshWireKey("ssh-ed25519", public)
+	return "ssh-ed25519 " + base64.StdEncoding.EncodeToString(blob) + " root@host", blob
+}
+
+func TestSSHFPRecords(t *testing.T) {
+	t.Parallel()
+	key, blob := newTestEd25519HostKey(t)
+	sha1Digest := sha1.Sum(blob) //nolint:gosec // SHA-1 fingerprints are defined by RFC 4255
+	sha256Digest := sha256.Sum256(blob)
+
+	records, err := sshfpRecords("# host keys\n\n" + `no-pty,command="echo hi" ` + key + "\n")
+	require.NoError(t, err)
+	assert.Equal(t, []SSHFPRecordEntry{
+		{
+			Algorithm: 4, FingerprintType: 1, Fingerprint: hex.EncodeToString(sha1Digest[:]),
+			Value: "4 1 " + hex.EncodeToString(sha1Digest[:]),
+		},
+		{
+			Algorithm: 4, FingerprintType: 2, Fingerprint: hex.EncodeToString(sha256Digest[:]),
+			Value: "4 2 " + hex.EncodeToString(sha256Digest[:]),
+		},
+	}, records)
+
+	for keyType, algorithm := range map[string]int{"ssh-rsa": 1, "ecdsa-sha2-nistp384": 3} {
+		line := keyType + " " + base64.StdEncoding.EncodeToString(sshWireKey(keyType, []byte{1, 2, 3}))
+		records, err = sshfpRecords(line)
+		require.NoError(t, err)
+		assert.Equal(t, algorithm, records[0].Algorithm)
+	}
+
+	_, err = sshfpRecords("ssh-dss AAAAB3NzaC1kc3MAAACBAP1")
+	assert.ErrorContains(t, err, "unsupported key")
+
+	_, err = sshfpRecords("ssh-rsa " + base64.StdEncoding.EncodeToString(sshWireKey("ssh-ed25519", []byte{1})))
+	assert.EqualError(t, err, "invalid ssh-rsa key: encoded key type does not match")
+
+	_, err = sshfpRecords("ssh-ed25519 !!!")
+	assert.ErrorContains(t, err, "invalid ssh-ed25519 key")
+
+	_, err = sshfpRecords("# no keys\n")
+	assert.EqualError(t, err, "no public key found")
+}
+
+func TestGetSSHFPRecords(t *testing.T) {
+	t.Parallel()
+	key, _ := newTestEd25519HostKey(t)
+
+	response, err := (&GetSSHFPRecords{}).Invoke(t.Context(), infer.FunctionRequest[GetSSHFPRecordsArgs]{
+		Input: GetSSHFPRecordsArgs{PublicKeys: []string{key}},
+	})
+	require.NoError(t, err)
+	assert.Len(t, response.Output.Records, 2)
+
+	_, err = (&GetSSHFPRecords{}).Invoke(t.Context(), infer.FunctionRequest[GetSSHFPRecordsArgs]{
+		Input: GetSSHFPRecordsArgs{PublicKeys: []string{key, "ssh-dss AAAA"}},
+	})
+	assert.ErrorContains(t, err, "invalid public key 1")
+}
+
+func TestDnsRecordSetCheckSSHFP(t *testing.T) {
+	t.Parallel()
+	check := func(inputs map[string]property.Value) infer.CheckResponse[DNSRecordSetArgs] {
+		inputs["domain"] = property.New("example.com")
+		inputs["name"] = property.New("host")
+		if _, ok := inputs["type"]; !ok {
+			inputs["type"] = property.New("sshfp")
+		}
+		response, err := (&DNSRecordSet{}).Check(t.Context(), infer.CheckRequest{NewInputs: property.NewMap(inputs)})
+		require.NoError(t, err)
+		return response
+	}
+	sshfp := func(keys ...property.Value) property.Value {
+		return property.New(map[string]property.Value{"publicKeys": property.New(keys)})
+	}
+
+	ed25519Key, _ := newTestEd25519HostKey(t)
+	rsaKey := "ssh-rsa " + base64.StdEncoding.EncodeToString(sshWireKey("ssh-rsa", []byte{1, 0, 1}, []byte{7}))
+	response := check(map[string]property.Value{"sshfp": sshfp(property.New(ed25519Key), property.New(rsaKey))})
+	assert.Empty(t, response.Failures)
+	require.Len(t, response.Inputs.Values, 4)
+	assert.Regexp(t, "^4 1 ", response.Inputs.Values[0].Value)
+	assert.Regexp(t, "^1 2 ", response.Inputs.Values[3].Value)
+
+	// Checking the derived values again is idempotent
+	values := make([]property.Value, 0, len(response.Inputs.Values))
+	for _, value := range response.Inputs.Values {
+		values = append(values, property.New(map[string]property.Value{"value": property.New(value.Value)}))
+	}
+	again := check(map[string]property.Value{
+		"sshfp":  sshfp(property.New(ed25519Key), property.New(rsaKey)),
+		"values": property.New(values),
+	})
+	assert.Empty(t, again.Failures)
+	assert.Equal(t, response.Inputs.Values, again.Inputs.Values)
+
+	response = check(map[string]property.Value{
+		"sshfp":  sshfp(property.New(ed25519Key)),
+		"values": property.New(values),
+	})
+	require.Len(t, response.Failures, 1)
+	assert.Equal(t, "Only one of values and sshfp can be set", response.Failures[0].Reason)
+
+	response = check(map[string]property.Value{"sshfp": sshfp(property.New("ssh-dss AAAA"))})
+	require.Len(t, response.Failures, 1)
+	assert.Equal(t, "sshfp.publicKeys[0]", response.Failures[0].Property)
+
+	response = check(map[string]property.Value{"sshfp": sshfp(property.New(property.Computed))})
+	assert.Empty(t, response.Failures)
+
+	response = check(map[string]property.Value{"type": property.New("TXT"), "sshfp": sshfp(property.New(ed25519Key))})
+	require.Len(t, response.Failures, 1)
+	assert.Equal(t, "sshfp can only be set for SSHFP records, not TXT", response.Failures[0].Reason)
+}
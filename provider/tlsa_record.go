@@ -0,0 +1,252 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// TLSA parameters as defined in RFC 6698 and RFC 7218
+const (
+	TLSAUsageDANEEE         = 3
+	TLSASelectorCertificate = 0
+	TLSASelectorSPKI        = 1
+	TLSAMatchingTypeFull    = 0
+	TLSAMatchingTypeSHA256  = 1
+	TLSAMatchingTypeSHA512  = 2
+)
+
+const (
+	tlsaMaxCertificateUsage   = 3
+	tlsaDefaultTransportProto = "tcp"
+)
+
+// TLSARecord contains the inputs of a TLSA record derived from a certificate or public key
+type TLSARecord struct {
+	Certificate  string  `pulumi:"certificate"`
+	Usage        *int    `pulumi:"usage,optional"`
+	Selector     *int    `pulumi:"selector,optional"`
+	MatchingType *int    `pulumi:"matchingType,optional"`
+	Port         int     `pulumi:"port"`
+	Protocol     *string `pulumi:"protocol,optional"`
+}
+
+// Annotate provides metadata about the TLSARecord.
+func (tlsa *TLSARecord) Annotate(a infer.Annotator) {
+	a.Describe(
+		&tlsa.Certificate,
+		"The PEM encoded certificate or public key (SubjectPublicKeyInfo) the record is derived from. "+
+			"Of a certificate chain, the first certificate is used",
+	)
+	a.Describe(&tlsa.Usage, "The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)")
+	a.SetDefault(&tlsa.Usage, TLSAUsageDANEEE)
+	a.Describe(&tlsa.Selector, "The selector: 0 for the full certificate or 1 for the public key")
+	a.SetDefault(&tlsa.Selector, TLSASelectorSPKI)
+	a.Describe(&tlsa.MatchingType, "The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512")
+	a.SetDefault(&tlsa.MatchingType, TLSAMatchingTypeSHA256)
+	a.Describe(&tlsa.Port, "The port the TLS service is offered on, e.g. 25 for SMTP")
+	a.Describe(&tlsa.Protocol, "The transport protocol of the service without leading underscore")
+	a.SetDefault(&tlsa.Protocol, tlsaDefaultTransportProto)
+}
+
+// parameters returns the usage, selector and matching type, applying the defaults
+func (tlsa TLSARecord) parameters() (usage, selector, matchingType int) {
+	usage, selector, matchingType = TLSAUsageDANEEE, TLSASelectorSPKI, TLSAMatchingTypeSHA256
+	if tlsa.Usage != nil {
+		usage = *tlsa.Usage
+	}
+	if tlsa.Selector != nil {
+		selector = *tlsa.Selector
+	}
+	if tlsa.MatchingType != nil {
+		matchingType = *tlsa.MatchingType
+	}
+	return usage, selector, matchingType
+}
+
+// hostnamePrefix returns the "_port._proto" labels the record is published under
+func (tlsa TLSARecord) hostnamePrefix() string {
+	protocol := tlsaDefaultTransportProto
+	if tlsa.Protocol != nil && *tlsa.Protocol != "" {
+		protocol = *tlsa.Protocol
+	}
+	return fmt.Sprintf("_%d._%s", tlsa.Port, protocol)
+}
+
+// value computes the destination of the record from the certificate or public key
+func (tlsa TLSARecord) value() (string, error) {
+	usage, selector, matchingType := tlsa.parameters()
+	return certificateAssociation(tlsa.Certificate, usage, selector, matchingType)
+}
+
+// certificateAssociation computes the "usage selector matchingType data" value TLSA and
+// SMIMEA records share from a certificate or public key
+func certificateAssociation(certificate string, usage, selector, matchingType int) (string, error) {
+	data, err := tlsaSelectedData(certificate, selector)
+	if err != nil {
+		return "", err
+	}
+
+	switch matchingType {
+	case TLSAMatchingTypeFull:
+	case TLSAMatchingTypeSHA256:
+		digest := sha256.Sum256(data)
+		data = digest[:]
+	case TLSAMatchingTypeSHA512:
+		digest := sha512.Sum512(data)
+		data = digest[:]
+	default:
+		return "", fmt.Errorf("unsupported matching type %d", matchingType)
+	}
+
+	return fmt.Sprintf("%d %d %d %s", usage, selector, matchingType, hex.EncodeToString(data)), nil
+}
+
+// tlsaSelectedData returns the DER encoded data a selector refers to: the full certificate or
+// its SubjectPublicKeyInfo
+func tlsaSelectedData(certificate string, selector int) ([]byte, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(certificate)))
+	if block == nil {
+		return nil, errors.New("certificate must be PEM encoded")
+	}
+
+	switch block.Type {
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate: %w", err)
+		}
+		switch selector {
+		case TLSASelectorCertificate:
+			return cert.Raw, nil
+		case TLSASelectorSPKI:
+			return cert.RawSubjectPublicKeyInfo, nil
+		}
+	case "PUBLIC KEY":
+		if _, err := x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+			return nil, fmt.Errorf("invalid public key: %w", err)
+		}
+		switch selector {
+		case TLSASelectorCertificate:
+			return nil, errors.New("selector 0 requires a certificate, not a public key")
+		case TLSASelectorSPKI:
+			return block.Bytes, nil
+		}
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q, expected CERTIFICATE or PUBLIC KEY", block.Type)
+	}
+
+	return nil, fmt.Errorf("unsupported selector %d", selector)
+}
+
+// normalizeTLSARecord normalizes the TLSA inputs
+func normalizeTLSARecord(tlsa *TLSARecord) *TLSARecord {
+	if tlsa == nil {
+		return nil
+	}
+	normalized := *tlsa
+	if tlsa.Protocol != nil {
+		protocol := strings.TrimPrefix(strings.ToLower(strings.TrimSpace(*tlsa.Protocol)), "_")
+		normalized.Protocol = &protocol
+	}
+	return &normalized
+}
+
+// composeTLSARecord derives the hostname and value of a record with TLSA inputs. The value is
+// derived from the certificate on every check, so a rotated certificate updates the record.
+func composeTLSARecord(args DNSRecordArgs) DNSRecordArgs {
+	if args.TLSA == nil {
+		return args
+	}
+
+	args.Name = prefixRecordName(args.Name, args.TLSA.hostnamePrefix())
+	if args.Value == "" {
+		// Invalid certificates are reported by validation
+		args.Value, _ = args.TLSA.value()
+	}
+	return args
+}
+
+// validateTLSARecord validates the TLSA inputs of a record
+func validateTLSARecord(args DNSRecordArgs) []p.CheckFailure {
+	tlsa := args.TLSA
+	if tlsa == nil {
+		return nil
+	}
+	if !strings.EqualFold(args.Type, "TLSA") {
+		return []p.CheckFailure{{
+			Property: "tlsa",
+			Reason:   fmt.Sprintf("tlsa can only be set for TLSA records, not %s", args.Type),
+		}}
+	}
+
+	var failures []p.CheckFailure
+	fail := func(field, reason string) {
+		failures = append(failures, p.CheckFailure{Property: "tlsa." + field, Reason: reason})
+	}
+
+	usage, selector, matchingType := tlsa.parameters()
+	failures = append(failures, certificateAssociationFailures("tlsa", usage, selector, matchingType)...)
+	if !isUint16(tlsa.Port) {
+		fail("port", fmt.Sprintf("Port must be between 0 and 65535, got %d", tlsa.Port))
+	}
+	if tlsa.Protocol != nil && !dnsLabelPattern.MatchString(*tlsa.Protocol) {
+		fail("protocol", fmt.Sprintf("Protocol %q must be a DNS label such as tcp or udp", *tlsa.Protocol))
+	}
+	if len(failures) > 0 {
+		return failures
+	}
+
+	value, err := tlsa.value()
+	if err != nil {
+		fail("certificate", err.Error())
+	} else if args.Value != value {
+		failures = append(failures, p.CheckFailure{
+			Property: "value",
+			Reason:   "Only one of value and tlsa can be set",
+		})
+	}
+	return failures
+}
+
+// certificateAssociationFailures validates the usage, selector and matching type of TLSA and
+// SMIMEA records
+func certificateAssociationFailures(property string, usage, selector, matchingType int) []p.CheckFailure {
+	var failures []p.CheckFailure
+	fail := func(field, reason string) {
+		failures = append(failures, p.CheckFailure{Property: property + "." + field, Reason: reason})
+	}
+
+	if usage < 0 || usage > tlsaMaxCertificateUsage {
+		fail("usage", fmt.Sprintf("Usage must be between 0 and %d, got %d", tlsaMaxCertificateUsage, usage))
+	}
+	if selector != TLSASelectorCertificate && selector != TLSASelectorSPKI {
+		fail("selector", fmt.Sprintf("Selector must be 0 or 1, got %d", selector))
+	}
+	if matchingType < TLSAMatchingTypeFull || matchingType > TLSAMatchingTypeSHA512 {
+		fail("matchingType", fmt.Sprintf("Matching type must be 0, 1 or 2, got %d", matchingType))
+	}
+	return failures
+}
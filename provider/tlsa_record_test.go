@@ -0,0 +1,129 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// newTestCertificate creates a self-signed certificate and returns it PEM encoded
+func newTestCertificate(t *testing.T) (string, *x509.Certificate) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "mail.example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), cert
+}
+
+func TestTLSARecordValue(t *testing.T) {
+	t.Parallel()
+	certPEM, cert := newTestCertificate(t)
+	intPtr := func(i int) *int { return &i }
+
+	value, err := TLSARecord{Certificate: certPEM, Port: 25}.value()
+	require.NoError(t, err)
+	spkiDigest := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	assert.Equal(t, "3 1 1 "+hex.EncodeToString(spkiDigest[:]), value)
+
+	value, err = TLSARecord{
+		Certificate: certPEM, Usage: intPtr(1), Selector: intPtr(0), MatchingType: intPtr(2), Port: 443,
+	}.value()
+	require.NoError(t, err)
+	certDigest := sha512.Sum512(cert.Raw)
+	assert.Equal(t, "1 0 2 "+hex.EncodeToString(certDigest[:]), value)
+
+	// A bare public key yields the same association data as its certificate
+	keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: cert.RawSubjectPublicKeyInfo}))
+	value, err = TLSARecord{Certificate: keyPEM, MatchingType: intPtr(0), Port: 25}.value()
+	require.NoError(t, err)
+	assert.Equal(t, "3 1 0 "+hex.EncodeToString(cert.RawSubjectPublicKeyInfo), value)
+
+	_, err = TLSARecord{Certificate: keyPEM, Selector: intPtr(0), Port: 25}.value()
+	assert.EqualError(t, err, "selector 0 requires a certificate, not a public key")
+
+	_, err = TLSARecord{Certificate: "not a certificate", Port: 25}.value()
+	assert.EqualError(t, err, "certificate must be PEM encoded")
+}
+
+func TestDnsRecordCheckTLSA(t *testing.T) {
+	t.Parallel()
+	check := func(tlsa property.Value) infer.CheckResponse[DNSRecordArgs] {
+		response, err := (&DNSRecord{}).Check(t.Context(), infer.CheckRequest{NewInputs: property.NewMap(
+			map[string]property.Value{
+				"domain": property.New("example.com"),
+				"name":   property.New("mail"),
+				"type":   property.New("TLSA"),
+				"tlsa":   tlsa,
+			},
+		)})
+		require.NoError(t, err)
+		return response
+	}
+	tlsa := func(certificate property.Value) property.Value {
+		return property.New(map[string]property.Value{
+			"certificate": certificate,
+			"port":        property.New(25.0),
+		})
+	}
+
+	certPEM, cert := newTestCertificate(t)
+	response := check(tlsa(property.New(certPEM)))
+	assert.Empty(t, response.Failures)
+	assert.Equal(t, "_25._tcp.mail", response.Inputs.Name)
+	digest := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	assert.Equal(t, "3 1 1 "+hex.EncodeToString(digest[:]), response.Inputs.Value)
+
+	// A renewed certificate with a new key changes the value in place
+	renewedPEM, _ := newTestCertificate(t)
+	renewed := check(tlsa(property.New(renewedPEM)))
+	assert.Empty(t, renewed.Failures)
+	assert.Equal(t, response.Inputs.Name, renewed.Inputs.Name)
+	assert.NotEqual(t, response.Inputs.Value, renewed.Inputs.Value)
+
+	response = check(tlsa(property.New("-----BEGIN CERTIFICATE-----\nAAAA\n-----END CERTIFICATE-----")))
+	require.Len(t, response.Failures, 1)
+	assert.Equal(t, "tlsa.certificate", response.Failures[0].Property)
+
+	// A certificate that is not known yet is validated once it is
+	response = check(tlsa(property.New(property.Computed)))
+	assert.Empty(t, response.Failures)
+	assert.Equal(t, "_25._tcp.mail", response.Inputs.Name)
+}
@@ -0,0 +1,415 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// Zone export formats
+const (
+	ZoneFormatBIND    = "bind"
+	ZoneFormatJSON    = "json"
+	ZoneFormatOctoDNS = "octodns"
+)
+
+// ExportZone renders the live zone of a Netcup domain as a document.
+type ExportZone struct{}
+
+// Annotate provides metadata about the exportZone function.
+func (f *ExportZone) Annotate(a infer.Annotator) {
+	a.SetToken("index", "exportZone")
+	a.Describe(&f, "Exports the records and zone settings of a domain as an RFC 1035 zone file, "+
+		"a JSON document or an octoDNS YAML zone, for backups, audits and migrations. "+
+		"Records are sorted, so exports of an unchanged zone are identical")
+}
+
+// ExportZoneArgs contains the input arguments for the exportZone function.
+type ExportZoneArgs struct {
+	Domain string  `pulumi:"domain"`
+	Format *string `pulumi:"format,optional"`
+}
+
+// Annotate provides metadata about the ExportZoneArgs.
+func (args *ExportZoneArgs) Annotate(a infer.Annotator) {
+	a.Describe(&args.Domain, "The domain name to export (e.g., 'example.com')")
+	a.Describe(&args.Format, "The format of the export: 'bind' for a zone file, 'json' or 'octodns'. Defaults to 'bind'")
+}
+
+// ExportZoneResult contains the result of the exportZone function.
+type ExportZoneResult struct {
+	Domain  string `pulumi:"domain"`
+	Format  string `pulumi:"format"`
+	Serial  string `pulumi:"serial"`
+	Content string `pulumi:"content"`
+}
+
+// Annotate provides metadata about the ExportZoneResult.
+func (result *ExportZoneResult) Annotate(a infer.Annotator) {
+	a.Describe(&result.Domain, "The exported domain name")
+	a.Describe(&result.Format, "The format of the export")
+	a.Describe(&result.Serial, "The serial of the zone at the time of the export")
+	a.Describe(&result.Content, "The exported zone")
+}
+
+// Invoke reads the zone and renders it.
+func (f *ExportZone) Invoke(
+	ctx context.Context,
+	req infer.FunctionRequest[ExportZoneArgs],
+) (infer.FunctionResponse[ExportZoneResult], error) {
+	domain := strings.ToLower(strings.TrimSpace(req.Input.Domain))
+	if !isValidDomain(domain) {
+		return infer.FunctionResponse[ExportZoneResult]{}, fmt.Errorf("invalid domain name: %q", req.Input.Domain)
+	}
+
+	format := ZoneFormatBIND
+	if req.Input.Format != nil {
+		format = strings.ToLower(strings.TrimSpace(*req.Input.Format))
+	}
+	render, ok := map[string]func(zoneExport) (string, error){
+		ZoneFormatBIND:    renderBINDZone,
+		ZoneFormatJSON:    renderJSONZone,
+		ZoneFormatOctoDNS: renderOctoDNSZone,
+	}[format]
+	if !ok {
+		return infer.FunctionResponse[ExportZoneResult]{}, fmt.Errorf(
+			"unsupported format %q. Valid formats are: %s, %s, %s",
+			format, ZoneFormatBIND, ZoneFormatJSON, ZoneFormatOctoDNS)
+	}
+
+	config := infer.GetConfig[Config](ctx)
+	client, err := config.netcupClient()
+	if err != nil {
+		return infer.FunctionResponse[ExportZoneResult]{}, err
+	}
+
+	zone, err := client.GetDNSZone(ctx, domain)
+	if err != nil {
+		return infer.FunctionResponse[ExportZoneResult]{}, fmt.Errorf("failed to read DNS zone %s: %w", domain, err)
+	}
+	records, err := client.getAllDNSRecords(ctx, domain)
+	if err != nil {
+		return infer.FunctionResponse[ExportZoneResult]{},
+			fmt.Errorf("failed to read DNS records of %s: %w", domain, err)
+	}
+
+	content, err := render(newZoneExport(domain, zone, records))
+	if err != nil {
+		return infer.FunctionResponse[ExportZoneResult]{}, fmt.Errorf("failed to render DNS zone %s: %w", domain, err)
+	}
+
+	return infer.FunctionResponse[ExportZoneResult]{Output: ExportZoneResult{
+		Domain:  domain,
+		Format:  format,
+		Serial:  zone.Serial,
+		Content: content,
+	}}, nil
+}
+
+// zoneExport is the content of an exported zone
+type zoneExport struct {
+	domain  string
+	zone    *DNSZoneInfo
+	records []*DNSRecordInfo
+}
+
+// newZoneExport sorts the records so that exports do not depend on the order Netcup returns
+// them in
+func newZoneExport(domain string, zone *DNSZoneInfo, records []*DNSRecordInfo) zoneExport {
+	return zoneExport{domain: domain, zone: zone, records: sortDNSRecords(records)}
+}
+
+// sortDNSRecords returns the records sorted by hostname, with the root domain first, type,
+// priority and value
+func sortDNSRecords(records []*DNSRecordInfo) []*DNSRecordInfo {
+	records = slices.Clone(records)
+	slices.SortStableFunc(records, func(a, b *DNSRecordInfo) int {
+		hostname := func(record *DNSRecordInfo) string {
+			if record.Hostname == "@" {
+				return ""
+			}
+			return strings.ToLower(record.Hostname)
+		}
+		priority := func(record *DNSRecordInfo) int {
+			value, _ := strconv.Atoi(record.Priority)
+			return value
+		}
+		return cmp.Or(
+			cmp.Compare(hostname(a), hostname(b)),
+			cmp.Compare(strings.ToUpper(a.Type), strings.ToUpper(b.Type)),
+			cmp.Compare(priority(a), priority(b)),
+			cmp.Compare(a.Destination, b.Destination),
+		)
+	})
+	return records
+}
+
+// renderBINDZone renders the zone as an RFC 1035 zone file. Netcup does not expose the
+// primary nameserver and mailbox of the SOA record, so Netcup's nameserver and the
+// hostmaster of the domain are used.
+func renderBINDZone(export zoneExport) (string, error) {
+	var b strings.Builder
+	nameserver, _, _ := strings.Cut(DefaultNameserver, ":")
+
+	fmt.Fprintf(&b, "$ORIGIN %s.\n", export.domain)
+	fmt.Fprintf(&b, "$TTL %s\n", export.zone.TTL)
+	fmt.Fprintf(&b, "@ IN SOA %s. hostmaster.%s. (\n", nameserver, export.domain)
+	fmt.Fprintf(&b, "\t%s ; serial\n", export.zone.Serial)
+	fmt.Fprintf(&b, "\t%s ; refresh\n", export.zone.Refresh)
+	fmt.Fprintf(&b, "\t%s ; retry\n", export.zone.Retry)
+	fmt.Fprintf(&b, "\t%s ; expire\n", export.zone.Expire)
+	fmt.Fprintf(&b, "\t%s ; minimum\n", export.zone.TTL)
+	b.WriteString(")\n")
+
+	for _, record := range export.records {
+		recordType := strings.ToUpper(record.Type)
+		var rdata string
+		switch recordType {
+		case "CNAME", "NS":
+			rdata = exportDomainName(record.Destination)
+		case "MX":
+			rdata = fmt.Sprintf("%s %s", exportPriority(record), exportDomainName(record.Destination))
+		case "SRV":
+			fields := strings.Fields(record.Destination)
+			if len(fields) == 3 {
+				fields[2] = exportDomainName(fields[2])
+			}
+			rdata = exportPriority(record) + " " + strings.Join(fields, " ")
+		case "TXT":
+			rdata = quoteZoneFileText(record.Destination)
+		default:
+			rdata = record.Destination
+		}
+		fmt.Fprintf(&b, "%s IN %s %s\n", record.Hostname, recordType, rdata)
+	}
+
+	return b.String(), nil
+}
+
+// renderJSONZone renders the zone as an indented JSON document
+func renderJSONZone(export zoneExport) (string, error) {
+	type jsonRecord struct {
+		Name     string `json:"name"`
+		Type     string `json:"type"`
+		Value    string `json:"value"`
+		Priority *int   `json:"priority,omitempty"`
+	}
+	type jsonZone struct {
+		Domain string `json:"domain"`
+		Zone   struct {
+			TTL     int    `json:"ttl"`
+			Serial  string `json:"serial"`
+			Refresh int    `json:"refresh"`
+			Retry   int    `json:"retry"`
+			Expire  int    `json:"expire"`
+			DNSSEC  bool   `json:"dnssec"`
+		} `json:"zone"`
+		Records []jsonRecord `json:"records"`
+	}
+
+	document := jsonZone{Domain: export.domain, Records: make([]jsonRecord, 0, len(export.records))}
+	document.Zone.TTL, _ = strconv.Atoi(export.zone.TTL)
+	document.Zone.Serial = export.zone.Serial
+	document.Zone.Refresh, _ = strconv.Atoi(export.zone.Refresh)
+	document.Zone.Retry, _ = strconv.Atoi(export.zone.Retry)
+	document.Zone.Expire, _ = strconv.Atoi(export.zone.Expire)
+	document.Zone.DNSSEC = export.zone.DNSSECStatus
+
+	for _, record := range export.records {
+		entry := jsonRecord{Name: record.Hostname, Type: strings.ToUpper(record.Type), Value: record.Destination}
+		if requiresPriority(entry.Type) {
+			priority, _ := strconv.Atoi(exportPriority(record))
+			entry.Priority = &priority
+		}
+		document.Records = append(document.Records, entry)
+	}
+
+	content, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(content) + "\n", nil
+}
+
+// renderOctoDNSZone renders the zone as an octoDNS YAML zone. Records of types octoDNS does
+// not support are kept as comments.
+func renderOctoDNSZone(export zoneExport) (string, error) {
+	var b strings.Builder
+	b.WriteString("---\n")
+
+	// Group the records by hostname and type, keeping their sorted order
+	type recordGroup struct {
+		name       string
+		recordType string
+		values     []string
+	}
+	var groups []*recordGroup
+	var unsupported []string
+	for _, record := range export.records {
+		name := strings.ToLower(record.Hostname)
+		if name == "@" {
+			name = ""
+		}
+		recordType := strings.ToUpper(record.Type)
+
+		value, ok := octoDNSValue(record, recordType, export.domain)
+		if !ok {
+			unsupported = append(unsupported, fmt.Sprintf("%s %s %s", record.Hostname, recordType, record.Destination))
+			continue
+		}
+
+		if len(groups) == 0 || groups[len(groups)-1].name != name || groups[len(groups)-1].recordType != recordType {
+			groups = append(groups, &recordGroup{name: name, recordType: recordType})
+		}
+		group := groups[len(groups)-1]
+		group.values = append(group.values, value)
+	}
+
+	for i, group := range groups {
+		if i == 0 || groups[i-1].name != group.name {
+			fmt.Fprintf(&b, "%s:\n", yamlQuote(group.name))
+		}
+		fmt.Fprintf(&b, "  - type: %s\n", group.recordType)
+		fmt.Fprintf(&b, "    ttl: %s\n", export.zone.TTL)
+		if group.recordType == "CNAME" {
+			fmt.Fprintf(&b, "    value: %s\n", group.values[0])
+			continue
+		}
+		b.WriteString("    values:\n")
+		for _, value := range group.values {
+			// Multi-line values are mappings that continue at the indentation of the list item
+			fmt.Fprintf(&b, "      - %s\n", strings.ReplaceAll(value, "\n", "\n        "))
+		}
+	}
+
+	if len(unsupported) > 0 {
+		b.WriteString("# Records of types octoDNS does not support:\n")
+		for _, record := range unsupported {
+			fmt.Fprintf(&b, "# %s\n", record)
+		}
+	}
+
+	return b.String(), nil
+}
+
+// octoDNSValue renders a record value in the octoDNS notation of its type, which is a scalar
+// or, for structured types, a mapping with one key per line
+func octoDNSValue(record *DNSRecordInfo, recordType, domain string) (string, bool) {
+	mapping := func(keys []string, values []string) (string, bool) {
+		if len(values) != len(keys) {
+			return "", false
+		}
+		lines := make([]string, 0, len(keys))
+		for i, key := range keys {
+			value := values[i]
+			if _, err := strconv.Atoi(value); err != nil {
+				value = yamlQuote(value)
+			}
+			lines = append(lines, key+": "+value)
+		}
+		return strings.Join(lines, "\n"), true
+	}
+	fields := strings.Fields(record.Destination)
+
+	switch recordType {
+	case "A", "AAAA":
+		return yamlQuote(record.Destination), true
+	case "CNAME", "NS":
+		return yamlQuote(absoluteDomainName(record.Destination, domain)), true
+	case "TXT":
+		// octoDNS requires semicolons to be escaped
+		return yamlQuote(strings.ReplaceAll(record.Destination, ";", `\;`)), true
+	case "MX":
+		return mapping([]string{"preference", "exchange"},
+			[]string{exportPriority(record), absoluteDomainName(record.Destination, domain)})
+	case "SRV":
+		if len(fields) != 3 {
+			return "", false
+		}
+		return mapping([]string{"priority", "weight", "port", "target"},
+			[]string{exportPriority(record), fields[0], fields[1], absoluteDomainName(fields[2], domain)})
+	case "CAA":
+		if len(fields) < 3 {
+			return "", false
+		}
+		value := strings.Trim(strings.Join(fields[2:], " "), `"`)
+		return mapping([]string{"flags", "tag", "value"}, []string{fields[0], fields[1], value})
+	case "TLSA":
+		return mapping([]string{"certificate_usage", "selector", "matching_type", "certificate_association_data"}, fields)
+	case "SSHFP":
+		return mapping([]string{"algorithm", "fingerprint_type", "fingerprint"}, fields)
+	case "DS":
+		return mapping([]string{"key_tag", "algorithm", "digest_type", "digest"}, fields)
+	default:
+		return "", false
+	}
+}
+
+// exportPriority returns the priority of a record, defaulting to 0 as Netcup does
+func exportPriority(record *DNSRecordInfo) string {
+	if record.Priority == "" {
+		return "0"
+	}
+	return record.Priority
+}
+
+// exportDomainName renders a domain name value of a record for a zone file. Netcup values
+// that contain a dot are absolute names, single labels are relative to the domain.
+func exportDomainName(name string) string {
+	if name == "@" || strings.HasSuffix(name, ".") || !strings.Contains(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+// absoluteDomainName renders a domain name value of a record as a fully qualified name, as
+// octoDNS requires
+func absoluteDomainName(name, domain string) string {
+	switch name = exportDomainName(name); {
+	case name == "@":
+		return domain + "."
+	case strings.HasSuffix(name, "."):
+		return name
+	default:
+		return name + "." + domain + "."
+	}
+}
+
+// quoteZoneFileText renders a text as quoted character strings of at most 255 bytes each
+func quoteZoneFileText(text string) string {
+	escape := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	var parts []string
+	for {
+		chunk := text[:min(len(text), 255)]
+		text = text[len(chunk):]
+		parts = append(parts, `"`+escape.Replace(chunk)+`"`)
+		if text == "" {
+			return strings.Join(parts, " ")
+		}
+	}
+}
+
+// yamlQuote renders a string as single-quoted YAML scalar
+func yamlQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
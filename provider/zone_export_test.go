@@ -0,0 +1,174 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+
+	"github.com/blackdark/pulumi-netcup/provider/netcuptest"
+)
+
+func testZoneExport() zoneExport {
+	return newZoneExport("example.com", &DNSZoneInfo{
+		Name: "example.com", TTL: "86400", Serial: "2025010101",
+		Refresh: "28800", Retry: "7200", Expire: "1209600",
+	}, []*DNSRecordInfo{
+		{Hostname: "www", Type: "A", Destination: "1.2.3.5", Priority: "0"},
+		{Hostname: "@", Type: "TXT", Destination: `v=spf1 "quoted" -all`, Priority: "0"},
+		{Hostname: "@", Type: "MX", Destination: "mail.example.com", Priority: "20"},
+		{Hostname: "@", Type: "MX", Destination: "mx1", Priority: "10"},
+		{Hostname: "www", Type: "A", Destination: "1.2.3.4", Priority: "0"},
+		{Hostname: "_sip._tcp", Type: "SRV", Destination: "60 5060 sip.example.com", Priority: "10"},
+		{Hostname: "key", Type: "OPENPGPKEY", Destination: "bWFpbA==", Priority: "0"},
+	})
+}
+
+func TestRenderBINDZone(t *testing.T) {
+	t.Parallel()
+	content, err := renderBINDZone(testZoneExport())
+	require.NoError(t, err)
+
+	assert.Equal(t, `$ORIGIN example.com.
+$TTL 86400
+@ IN SOA root-dns.netcup.net. hostmaster.example.com. (
+	2025010101 ; serial
+	28800 ; refresh
+	7200 ; retry
+	1209600 ; expire
+	86400 ; minimum
+)
+@ IN MX 10 mx1
+@ IN MX 20 mail.example.com.
+@ IN TXT "v=spf1 \"quoted\" -all"
+_sip._tcp IN SRV 10 60 5060 sip.example.com.
+key IN OPENPGPKEY bWFpbA==
+www IN A 1.2.3.4
+www IN A 1.2.3.5
+`, content)
+
+	// The export parses back into the same records
+	records, errs := parseZoneFile(content, "example.com")
+	require.Empty(t, errs)
+	require.Len(t, records, 7)
+	assert.Equal(t, DNSZoneRecord{Name: "@", Type: "TXT", Value: `v=spf1 "quoted" -all`}, records[2])
+	assert.Equal(t, "60 5060 sip.example.com.", records[3].Value)
+}
+
+func TestQuoteZoneFileText(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, `""`, quoteZoneFileText(""))
+
+	long := make([]byte, 300)
+	for i := range long {
+		long[i] = 'a'
+	}
+	quoted := quoteZoneFileText(string(long))
+	assert.Equal(t, `"`+string(long[:255])+`" "`+string(long[255:])+`"`, quoted)
+}
+
+func TestRenderJSONZone(t *testing.T) {
+	t.Parallel()
+	content, err := renderJSONZone(testZoneExport())
+	require.NoError(t, err)
+
+	var document struct {
+		Domain string `json:"domain"`
+		Zone   struct {
+			TTL    int    `json:"ttl"`
+			Serial string `json:"serial"`
+		} `json:"zone"`
+		Records []map[string]any `json:"records"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(content), &document))
+	assert.Equal(t, 86400, document.Zone.TTL)
+	assert.Equal(t, "2025010101", document.Zone.Serial)
+	require.Len(t, document.Records, 7)
+	assert.Equal(t, map[string]any{"name": "@", "type": "MX", "value": "mx1", "priority": float64(10)},
+		document.Records[0])
+	assert.NotContains(t, document.Records[6], "priority")
+}
+
+func TestRenderOctoDNSZone(t *testing.T) {
+	t.Parallel()
+	content, err := renderOctoDNSZone(testZoneExport())
+	require.NoError(t, err)
+
+	assert.Equal(t, `---
+'':
+  - type: MX
+    ttl: 86400
+    values:
+      - preference: 10
+        exchange: 'mx1.example.com.'
+      - preference: 20
+        exchange: 'mail.example.com.'
+  - type: TXT
+    ttl: 86400
+    values:
+      - 'v=spf1 "quoted" -all'
+'_sip._tcp':
+  - type: SRV
+    ttl: 86400
+    values:
+      - priority: 10
+        weight: 60
+        port: 5060
+        target: 'sip.example.com.'
+'www':
+  - type: A
+    ttl: 86400
+    values:
+      - '1.2.3.4'
+      - '1.2.3.5'
+# Records of types octoDNS does not support:
+# key OPENPGPKEY bWFpbA==
+`, content)
+}
+
+func TestExportZone(t *testing.T) {
+	t.Parallel()
+	server, netcup := newTestProviderServer(t)
+	netcup.AddRecord("example.com", netcuptest.Record{Hostname: "www", Type: "A", Destination: "1.2.3.4"})
+
+	zone, _ := netcup.Zone("example.com")
+
+	response, err := server.Invoke(p.InvokeRequest{
+		Token: "netcup:index:exportZone",
+		Args: property.NewMap(map[string]property.Value{
+			"domain": property.New("example.com"),
+			"format": property.New("JSON"),
+		}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "json", response.Return.Get("format").AsString())
+	assert.Equal(t, zone.Serial, response.Return.Get("serial").AsString())
+	assert.Contains(t, response.Return.Get("content").AsString(), `"value": "1.2.3.4"`)
+
+	_, err = server.Invoke(p.InvokeRequest{
+		Token: "netcup:index:exportZone",
+		Args: property.NewMap(map[string]property.Value{
+			"domain": property.New("example.com"),
+			"format": property.New("xml"),
+		}),
+	})
+	assert.ErrorContains(t, err, `unsupported format "xml"`)
+}
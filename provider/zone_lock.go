@@ -0,0 +1,71 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// maxZoneConflictRetries limits how often a mutation is recomputed after the zone changed underneath it
+const maxZoneConflictRetries = 3
+
+// errZoneChanged is returned when a zone kept changing between reading and writing its records
+var errZoneChanged = errors.New("DNS zone was modified concurrently")
+
+// zoneLocks serializes read-modify-write cycles per account and domain within the provider process
+var zoneLocks = struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}{
+	locks: make(map[string]*sync.Mutex),
+}
+
+// zoneKey identifies a domain of the account the client talks to
+func zoneKey(c *NetcupClient, domain string) string {
+	return c.endpoint + "\x00" + c.customerID + "\x00" + strings.ToLower(domain)
+}
+
+// lockZone acquires the mutation lock for a domain and returns the function releasing it
+func lockZone(key string) func() {
+	zoneLocks.mu.Lock()
+	lock, ok := zoneLocks.locks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		zoneLocks.locks[key] = lock
+	}
+	zoneLocks.mu.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
+
+// fingerprintDNSRecords returns a digest of a record set that does not depend on record order
+func fingerprintDNSRecords(records []*DNSRecordInfo) string {
+	lines := make([]string, 0, len(records))
+	for _, record := range records {
+		lines = append(lines, fmt.Sprintf("%s\x00%s\x00%s\x00%s\x00%s\x00%s",
+			record.ID, record.Hostname, record.Type, record.Priority, record.Destination, record.State))
+	}
+	slices.Sort(lines)
+
+	sum := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+	return hex.EncodeToString(sum[:])
+}
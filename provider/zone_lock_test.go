@@ -0,0 +1,178 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFingerprintDNSRecords(t *testing.T) {
+	t.Parallel()
+	a := &DNSRecordInfo{ID: "1", Hostname: "www", Type: "A", Destination: "1.2.3.4"}
+	b := &DNSRecordInfo{ID: "2", Hostname: "@", Type: "MX", Priority: "10", Destination: "mail.example.com"}
+
+	assert.Equal(t,
+		fingerprintDNSRecords([]*DNSRecordInfo{a, b}),
+		fingerprintDNSRecords([]*DNSRecordInfo{b, a}),
+		"fingerprint should not depend on record order")
+
+	changed := *b
+	changed.Priority = "20"
+	assert.NotEqual(t,
+		fingerprintDNSRecords([]*DNSRecordInfo{a, b}),
+		fingerprintDNSRecords([]*DNSRecordInfo{a, &changed}))
+}
+
+// zoneServer is a minimal stateful stand-in for the record endpoints of the Netcup API
+type zoneServer struct {
+	mu      sync.Mutex
+	records []DNSRecordInfo
+	nextID  int
+	updates int
+	// onInfo is called for every infoDnsRecords request while holding the lock
+	onInfo func(z *zoneServer)
+}
+
+func (z *zoneServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Action string `json:"action"`
+		Param  struct {
+			DNSRecordSet struct {
+				DNSRecords []DNSRecordInfo `json:"dnsrecords"`
+			} `json:"dnsrecordset"`
+		} `json:"param"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	z.mu.Lock()
+	defer z.mu.Unlock()
+
+	response := NetcupAPIResponse{Status: "success", StatusCode: 2000}
+	switch req.Action {
+	case "login":
+		response.ResponseData = responseData(map[string]interface{}{"apisessionid": "zone-session"})
+	case "infoDnsRecords":
+		if z.onInfo != nil {
+			z.onInfo(z)
+		}
+		response.ResponseData = responseData(map[string]interface{}{"dnsrecords": z.records})
+	case "updateDnsRecords":
+		z.updates++
+		var records []DNSRecordInfo
+		for _, record := range req.Param.DNSRecordSet.DNSRecords {
+			if record.DeleteRecord {
+				continue
+			}
+			if record.ID == "" {
+				z.nextID++
+				record.ID = strconv.Itoa(z.nextID)
+			}
+			records = append(records, record)
+		}
+		z.records = records
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func TestNetcupClient_ConcurrentCreatesKeepAllRecords(t *testing.T) {
+	t.Parallel()
+	zone := &zoneServer{
+		nextID:  100,
+		records: []DNSRecordInfo{{ID: "100", Hostname: "@", Type: "A", Destination: "1.2.3.4"}},
+	}
+	server := httptest.NewServer(zone)
+	defer server.Close()
+
+	var wg sync.WaitGroup
+	ids := make([]string, 10)
+	for i := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client := NewNetcupClient("test-key", "test-password", "test-customer", WithEndpoint(server.URL))
+			client.limiter = newRateLimiter(60000)
+
+			id, err := client.CreateDNSRecord(t.Context(), "example.com", fmt.Sprintf("host%d", i), "A", "1.2.3.4", "")
+			assert.NoError(t, err)
+			ids[i] = id
+		}()
+	}
+	wg.Wait()
+
+	zone.mu.Lock()
+	defer zone.mu.Unlock()
+	require.Len(t, zone.records, len(ids)+1, "no record may be lost to interleaved updates")
+
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		assert.False(t, seen[id], "record ID %s was returned twice", id)
+		seen[id] = true
+	}
+}
+
+func TestNetcupClient_DetectsConcurrentZoneChange(t *testing.T) {
+	t.Parallel()
+	externalWrites := 2
+	zone := &zoneServer{
+		nextID:  1,
+		records: []DNSRecordInfo{{ID: "1", Hostname: "www", Type: "A", Destination: "1.2.3.4"}},
+	}
+	// Simulate another party editing the zone right after each of our first reads
+	reads := 0
+	zone.onInfo = func(z *zoneServer) {
+		reads++
+		if reads%2 == 0 && externalWrites > 0 {
+			externalWrites--
+			z.nextID++
+			z.records = append(z.records, DNSRecordInfo{
+				ID: strconv.Itoa(z.nextID), Hostname: fmt.Sprintf("external%d", z.nextID), Type: "TXT", Destination: "x",
+			})
+		}
+	}
+	server := httptest.NewServer(zone)
+	defer server.Close()
+
+	client := NewNetcupClient("test-key", "test-password", "test-customer", WithEndpoint(server.URL))
+	client.limiter = newRateLimiter(60000)
+
+	require.NoError(t, client.UpdateDNSRecord(t.Context(), "1", "example.com", "www", "A", "5.6.7.8", ""))
+
+	zone.mu.Lock()
+	require.Len(t, zone.records, 3, "externally added records must survive the update")
+	assert.Equal(t, "5.6.7.8", zone.records[0].Destination)
+	zone.mu.Unlock()
+
+	// A zone that never settles eventually fails instead of being clobbered
+	zone.mu.Lock()
+	externalWrites = maxZoneConflictRetries + 1
+	zone.mu.Unlock()
+
+	err := client.UpdateDNSRecord(t.Context(), "1", "example.com", "www", "A", "9.9.9.9", "")
+	require.ErrorIs(t, err, errZoneChanged)
+}
@@ -0,0 +1,426 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// ParseZoneFile converts an RFC 1035 zone file into DNS records.
+type ParseZoneFile struct{}
+
+// Annotate provides metadata about the parseZoneFile function.
+func (f *ParseZoneFile) Annotate(a infer.Annotator) {
+	a.SetToken("index", "parseZoneFile")
+	a.Describe(&f, "Parses an RFC 1035 zone file (BIND format) into the records of a domain, "+
+		"ready to be used as records of a DNSZoneRecords resource. SOA and apex NS records are skipped, "+
+		"as Netcup manages them, and record TTLs are ignored")
+}
+
+// ParseZoneFileArgs contains the input arguments for the parseZoneFile function.
+type ParseZoneFileArgs struct {
+	Domain   string `pulumi:"domain"`
+	ZoneFile string `pulumi:"zoneFile"`
+}
+
+// Annotate provides metadata about the ParseZoneFileArgs.
+func (args *ParseZoneFileArgs) Annotate(a infer.Annotator) {
+	a.Describe(&args.Domain, "The domain name the zone file belongs to. It is the initial $ORIGIN")
+	a.Describe(&args.ZoneFile, "The content of the zone file")
+}
+
+// ParseZoneFileResult contains the result of the parseZoneFile function.
+type ParseZoneFileResult struct {
+	Records []DNSZoneRecord `pulumi:"records"`
+}
+
+// Annotate provides metadata about the ParseZoneFileResult.
+func (result *ParseZoneFileResult) Annotate(a infer.Annotator) {
+	a.Describe(&result.Records, "The records of the zone file, in the order they are defined")
+}
+
+// Invoke parses the zone file.
+func (f *ParseZoneFile) Invoke(
+	_ context.Context,
+	req infer.FunctionRequest[ParseZoneFileArgs],
+) (infer.FunctionResponse[ParseZoneFileResult], error) {
+	domain := strings.ToLower(strings.TrimSpace(req.Input.Domain))
+	if !isValidDomain(domain) {
+		return infer.FunctionResponse[ParseZoneFileResult]{}, fmt.Errorf("invalid domain name: %q", req.Input.Domain)
+	}
+
+	records, zoneErrors := parseZoneFile(req.Input.ZoneFile, domain)
+	if len(zoneErrors) > 0 {
+		return infer.FunctionResponse[ParseZoneFileResult]{},
+			fmt.Errorf("invalid zone file: %w", errors.Join(zoneErrors...))
+	}
+
+	return infer.FunctionResponse[ParseZoneFileResult]{Output: ParseZoneFileResult{Records: records}}, nil
+}
+
+// zoneFileError is a problem with an entry of a zone file
+type zoneFileError struct {
+	line   int
+	reason string
+}
+
+func (e *zoneFileError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.line, e.reason)
+}
+
+// zoneFileEntry is a directive or resource record, which spans several lines when it uses
+// parentheses
+type zoneFileEntry struct {
+	line int
+	// inheritsOwner is set when the entry starts with whitespace and so has no owner name
+	inheritsOwner bool
+	tokens        []string
+}
+
+// parseZoneFile parses the records of a zone file for the given domain. Names are returned
+// relative to the domain, as Netcup expects them. Every problem found is returned as error,
+// so that all of them can be reported at once.
+func parseZoneFile(content, domain string) ([]DNSZoneRecord, []error) {
+	entries, err := tokenizeZoneFile(content)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	var (
+		records []DNSZoneRecord
+		errs    []error
+		owner   string
+	)
+	origin := domain
+	fail := func(line int, format string, args ...any) {
+		errs = append(errs, &zoneFileError{line: line, reason: fmt.Sprintf(format, args...)})
+	}
+
+	for _, entry := range entries {
+		tokens := entry.tokens
+
+		if !entry.inheritsOwner && strings.HasPrefix(tokens[0], "$") {
+			switch directive := strings.ToUpper(tokens[0]); directive {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					fail(entry.line, "$ORIGIN expects a domain name")
+					continue
+				}
+				origin = qualifyZoneName(tokens[1], origin)
+			case "$TTL":
+				if len(tokens) != 2 || !isZoneFileTTL(tokens[1]) {
+					fail(entry.line, "$TTL expects a time to live")
+				}
+			default:
+				fail(entry.line, "unsupported directive %s", directive)
+			}
+			continue
+		}
+
+		if !entry.inheritsOwner {
+			owner = qualifyZoneName(tokens[0], origin)
+			tokens = tokens[1:]
+		} else if owner == "" {
+			fail(entry.line, "the first record has no owner name")
+			continue
+		}
+
+		// TTL and class are optional and may come in either order
+		for range 2 {
+			if len(tokens) == 0 {
+				break
+			}
+			if isZoneFileTTL(tokens[0]) {
+				tokens = tokens[1:]
+			} else if class := strings.ToUpper(tokens[0]); slices.Contains([]string{"IN", "CH", "HS", "CS"}, class) {
+				if class != "IN" {
+					fail(entry.line, "unsupported class %s", class)
+				}
+				tokens = tokens[1:]
+			}
+		}
+		if len(tokens) == 0 {
+			fail(entry.line, "missing record type")
+			continue
+		}
+
+		recordType := strings.ToUpper(tokens[0])
+		rdata := tokens[1:]
+
+		hostname, ok := zoneHostname(owner, domain)
+		if !ok {
+			fail(entry.line, "owner name %s is outside of domain %s", owner, domain)
+			continue
+		}
+		if recordType == "SOA" || (recordType == "NS" && hostname == "@") {
+			continue
+		}
+		if !getValidTypesMap()[recordType] {
+			fail(entry.line, "unsupported record type %s. Valid types are: %v", recordType, getValidTypesList())
+			continue
+		}
+
+		record, reason := zoneFileRecord(hostname, recordType, rdata, origin)
+		if reason != "" {
+			fail(entry.line, "%s record: %s", recordType, reason)
+			continue
+		}
+		records = append(records, record)
+	}
+
+	return records, errs
+}
+
+// zoneFileRecord converts the record data of an entry into a record the way Netcup stores it.
+// The priority of MX and SRV records is split out of the data.
+func zoneFileRecord(hostname, recordType string, rdata []string, origin string) (DNSZoneRecord, string) {
+	record := DNSZoneRecord{Name: hostname, Type: recordType}
+	expect := func(n int) string {
+		if len(rdata) < n {
+			return fmt.Sprintf("expected at least %d fields, got %d", n, len(rdata))
+		}
+		return ""
+	}
+	// join joins the fixed leading fields with spaces and appends the remaining ones without
+	// separator, as long base64 and hex fields may be split over several words
+	join := func(fixed int) string {
+		return strings.Join(append(slices.Clone(rdata[:fixed]), strings.Join(rdata[fixed:], "")), " ")
+	}
+
+	switch recordType {
+	case "A", "AAAA":
+		if len(rdata) != 1 {
+			return record, "expected a single address"
+		}
+		address, err := netip.ParseAddr(rdata[0])
+		if err != nil || address.Is4() != (recordType == "A") {
+			return record, fmt.Sprintf("invalid address %q", rdata[0])
+		}
+		record.Value = address.String()
+	case "CNAME", "NS":
+		if len(rdata) != 1 {
+			return record, "expected a single domain name"
+		}
+		record.Value = qualifyZoneName(rdata[0], origin) + "."
+	case "MX":
+		if len(rdata) != 2 {
+			return record, "expected a preference and an exchange"
+		}
+		if _, err := strconv.ParseUint(rdata[0], 10, 16); err != nil {
+			return record, fmt.Sprintf("invalid preference %q", rdata[0])
+		}
+		record.Priority = &rdata[0]
+		record.Value = qualifyZoneName(rdata[1], origin) + "."
+	case "SRV":
+		if len(rdata) != 4 {
+			return record, "expected priority, weight, port and target"
+		}
+		for _, field := range rdata[:3] {
+			if _, err := strconv.ParseUint(field, 10, 16); err != nil {
+				return record, fmt.Sprintf("invalid number %q", field)
+			}
+		}
+		record.Priority = &rdata[0]
+		record.Value = fmt.Sprintf("%s %s %s.", rdata[1], rdata[2], qualifyZoneName(rdata[3], origin))
+	case "TXT":
+		if reason := expect(1); reason != "" {
+			return record, reason
+		}
+		// Multiple character strings form a single text
+		record.Value = join(0)
+	case "CAA":
+		if len(rdata) != 3 {
+			return record, "expected flags, tag and value"
+		}
+		record.Value = fmt.Sprintf(`%s %s "%s"`, rdata[0], rdata[1], strings.ReplaceAll(rdata[2], `"`, `\"`))
+	case "TLSA", "SMIMEA", "DS":
+		if reason := expect(4); reason != "" {
+			return record, reason
+		}
+		record.Value = join(3)
+	case "SSHFP":
+		if reason := expect(3); reason != "" {
+			return record, reason
+		}
+		record.Value = join(2)
+	case "OPENPGPKEY":
+		if reason := expect(1); reason != "" {
+			return record, reason
+		}
+		record.Value = join(0)
+	}
+
+	return record, ""
+}
+
+// tokenizeZoneFile splits a zone file into entries. It removes comments, joins lines grouped
+// by parentheses and unquotes character strings.
+func tokenizeZoneFile(content string) ([]zoneFileEntry, error) {
+	var (
+		entries []zoneFileEntry
+		current zoneFileEntry
+		word    strings.Builder
+		inWord  bool
+		depth   int
+	)
+	line := 1
+	lineStart := true
+
+	// endWord finishes the current word. Quoted strings are kept even when empty.
+	endWord := func(quoted bool) {
+		if inWord || quoted {
+			current.tokens = append(current.tokens, word.String())
+		}
+		word.Reset()
+		inWord = false
+	}
+	endEntry := func() {
+		if len(current.tokens) > 0 {
+			entries = append(entries, current)
+		}
+		current = zoneFileEntry{}
+	}
+
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		if lineStart && depth == 0 {
+			current = zoneFileEntry{line: line, inheritsOwner: c == ' ' || c == '\t'}
+		}
+		lineStart = false
+
+		switch {
+		case c == '\n':
+			endWord(false)
+			line++
+			lineStart = true
+			if depth == 0 {
+				endEntry()
+			}
+		case c == ' ' || c == '\t' || c == '\r':
+			endWord(false)
+		case c == ';':
+			endWord(false)
+			for i+1 < len(content) && content[i+1] != '\n' {
+				i++
+			}
+		case c == '(':
+			endWord(false)
+			depth++
+		case c == ')':
+			endWord(false)
+			if depth == 0 {
+				return nil, &zoneFileError{line: line, reason: "unbalanced closing parenthesis"}
+			}
+			depth--
+		case c == '"':
+			endWord(false)
+			start := line
+			for i++; ; i++ {
+				if i >= len(content) {
+					return nil, &zoneFileError{line: start, reason: "unterminated quoted string"}
+				}
+				if content[i] == '"' {
+					break
+				}
+				if content[i] == '\n' {
+					line++
+				}
+				if content[i] == '\\' && i+1 < len(content) {
+					i = unescapeZoneFile(content, i, &word)
+					continue
+				}
+				word.WriteByte(content[i])
+			}
+			endWord(true)
+		case c == '\\' && i+1 < len(content):
+			inWord = true
+			i = unescapeZoneFile(content, i, &word)
+		default:
+			inWord = true
+			word.WriteByte(c)
+		}
+	}
+
+	if depth > 0 {
+		return nil, &zoneFileError{line: current.line, reason: "unbalanced opening parenthesis"}
+	}
+	endWord(false)
+	endEntry()
+	return entries, nil
+}
+
+// unescapeZoneFile writes the character escaped by the backslash at content[i], either \X or
+// \DDD, and returns the index of the last character of the escape sequence
+func unescapeZoneFile(content string, i int, word *strings.Builder) int {
+	if i+3 < len(content) {
+		if code, err := strconv.ParseUint(content[i+1:i+4], 10, 8); err == nil {
+			word.WriteByte(byte(code))
+			return i + 3
+		}
+	}
+	word.WriteByte(content[i+1])
+	return i + 1
+}
+
+// qualifyZoneName turns a name of a zone file into a fully qualified name without the
+// trailing dot. Names that do not end with a dot are relative to the origin.
+func qualifyZoneName(name, origin string) string {
+	name = strings.ToLower(name)
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return strings.TrimSuffix(name, ".")
+	default:
+		return name + "." + origin
+	}
+}
+
+// zoneHostname returns the hostname of a fully qualified name relative to the domain
+func zoneHostname(name, domain string) (string, bool) {
+	if name == domain {
+		return "@", true
+	}
+	hostname, ok := strings.CutSuffix(name, "."+domain)
+	return hostname, ok && hostname != ""
+}
+
+// isZoneFileTTL reports whether a word is a time to live, either in seconds or in the BIND
+// notation with units such as 1h30m
+func isZoneFileTTL(word string) bool {
+	if word == "" {
+		return false
+	}
+	digits := 0
+	for _, c := range strings.ToLower(word) {
+		switch {
+		case c >= '0' && c <= '9':
+			digits++
+		case strings.ContainsRune("smhdw", c) && digits > 0:
+			digits = 0
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,129 @@
+// Copyright 2025, BlackDark.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+const testZoneFile = `$ORIGIN example.com.
+$TTL 1h
+@   IN  SOA root-dns.netcup.net. admin.example.com. (
+            2025010101 ; serial
+            28800 7200 1209600 86400 )
+    IN  NS   root-dns.netcup.net.
+@   3600 IN A 1.2.3.4
+www      IN A 1.2.3.5
+         IN AAAA 2001:db8::1
+mail IN 300 MX 10 mx1
+@        MX 20 backup.example.net.
+_sip._tcp SRV 10 60 5060 sip
+@ TXT "v=spf1 include:_spf.example.net " "-all"
+@ CAA 0 issue "letsencrypt.org"
+$ORIGIN sub.example.com.
+alias CNAME @
+_25._tcp.mail TLSA 3 1 1 (
+    0123456789abcdef
+    0123456789abcdef )
+`
+
+func TestParseZoneFile(t *testing.T) {
+	t.Parallel()
+	records, errs := parseZoneFile(testZoneFile, "example.com")
+	require.Empty(t, errs)
+
+	assert.Equal(t, []DNSZoneRecord{
+		{Name: "@", Type: "A", Value: "1.2.3.4"},
+		{Name: "www", Type: "A", Value: "1.2.3.5"},
+		{Name: "www", Type: "AAAA", Value: "2001:db8::1"},
+		{Name: "mail", Type: "MX", Value: "mx1.example.com.", Priority: stringPtr("10")},
+		{Name: "@", Type: "MX", Value: "backup.example.net.", Priority: stringPtr("20")},
+		{Name: "_sip._tcp", Type: "SRV", Value: "60 5060 sip.example.com.", Priority: stringPtr("10")},
+		{Name: "@", Type: "TXT", Value: "v=spf1 include:_spf.example.net -all"},
+		{Name: "@", Type: "CAA", Value: `0 issue "letsencrypt.org"`},
+		{Name: "alias.sub", Type: "CNAME", Value: "sub.example.com."},
+		{Name: "_25._tcp.mail.sub", Type: "TLSA", Value: "3 1 1 0123456789abcdef0123456789abcdef"},
+	}, records)
+}
+
+func TestParseZoneFile_Errors(t *testing.T) {
+	t.Parallel()
+	_, errs := parseZoneFile(`www IN A 1.2.3.4
+www IN SPF "v=spf1 -all"
+other.example.net. IN A 1.2.3.4
+www IN A 2001:db8::1
+$INCLUDE other.zone
+`, "example.com")
+
+	var messages []string
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	require.Len(t, messages, 4)
+	assert.Contains(t, messages[0], "line 2: unsupported record type SPF")
+	assert.Equal(t, "line 3: owner name other.example.net is outside of domain example.com", messages[1])
+	assert.Equal(t, `line 4: A record: invalid address "2001:db8::1"`, messages[2])
+	assert.Equal(t, "line 5: unsupported directive $INCLUDE", messages[3])
+
+	_, errs = parseZoneFile("@ IN TXT \"unterminated\n", "example.com")
+	assert.EqualError(t, errs[0], "line 1: unterminated quoted string")
+
+	_, errs = parseZoneFile("@ IN MX ( 10 mail\n", "example.com")
+	assert.EqualError(t, errs[0], "line 1: unbalanced opening parenthesis")
+}
+
+func TestDnsZoneRecordsCheckZoneFile(t *testing.T) {
+	t.Parallel()
+	check := func(inputs map[string]property.Value) infer.CheckResponse[DNSZoneRecordsArgs] {
+		response, err := (&DNSZoneRecords{}).Check(t.Context(), infer.CheckRequest{NewInputs: property.NewMap(inputs)})
+		require.NoError(t, err)
+		return response
+	}
+
+	response := check(map[string]property.Value{
+		"domain":   property.New("Example.com"),
+		"zoneFile": property.New("www IN A 1.2.3.4\n@ IN MX 10 mail\n"),
+	})
+	assert.Empty(t, response.Failures)
+	assert.Equal(t, []DNSZoneRecord{
+		{Name: "www", Type: "A", Value: "1.2.3.4"},
+		{Name: "@", Type: "MX", Value: "mail.example.com.", Priority: stringPtr("10")},
+	}, response.Inputs.Records)
+
+	response = check(map[string]property.Value{
+		"domain":   property.New("example.com"),
+		"zoneFile": property.New("www IN SPF \"v=spf1 -all\"\n"),
+	})
+	require.Len(t, response.Failures, 1)
+	assert.Equal(t, "zoneFile", response.Failures[0].Property)
+
+	response = check(map[string]property.Value{
+		"domain":   property.New("example.com"),
+		"zoneFile": property.New("www IN A 1.2.3.4\n"),
+		"records": property.New([]property.Value{property.New(map[string]property.Value{
+			"name":  property.New("www"),
+			"type":  property.New("A"),
+			"value": property.New("1.2.3.4"),
+		})}),
+	})
+	require.Len(t, response.Failures, 1)
+	assert.Equal(t, "Only one of records and zoneFile can be set", response.Failures[0].Reason)
+}
@@ -32,9 +32,9 @@ namespace Blackdark.Netcup
 
         private static readonly global::Pulumi.Config __config = new global::Pulumi.Config("netcup");
 
-        private static readonly __Value<string?> _apiKey = new __Value<string?>(() => __config.Get("apiKey"));
+        private static readonly __Value<string?> _apiKey = new __Value<string?>(() => __config.Get("apiKey") ?? Utilities.GetEnv("NETCUP_API_KEY"));
         /// <summary>
-        /// The Netcup API key for authentication
+        /// The Netcup API key for authentication. Can also be set with NETCUP_API_KEY
         /// </summary>
         public static string? ApiKey
         {
@@ -42,9 +42,9 @@ namespace Blackdark.Netcup
             set => _apiKey.Set(value);
         }
 
-        private static readonly __Value<string?> _apiPassword = new __Value<string?>(() => __config.Get("apiPassword"));
+        private static readonly __Value<string?> _apiPassword = new __Value<string?>(() => __config.Get("apiPassword") ?? Utilities.GetEnv("NETCUP_API_PASSWORD"));
         /// <summary>
-        /// The Netcup API password for authentication
+        /// The Netcup API password for authentication. Can also be set with NETCUP_API_PASSWORD
         /// </summary>
         public static string? ApiPassword
         {
@@ -52,9 +52,19 @@ namespace Blackdark.Netcup
             set => _apiPassword.Set(value);
         }
 
-        private static readonly __Value<string?> _customerId = new __Value<string?>(() => __config.Get("customerId"));
+        private static readonly __Value<string?> _caBundle = new __Value<string?>(() => __config.Get("caBundle"));
         /// <summary>
-        /// The Netcup customer ID
+        /// Path to a PEM file with additional CA certificates trusted for API requests
+        /// </summary>
+        public static string? CaBundle
+        {
+            get => _caBundle.Get();
+            set => _caBundle.Set(value);
+        }
+
+        private static readonly __Value<string?> _customerId = new __Value<string?>(() => __config.Get("customerId") ?? Utilities.GetEnv("NETCUP_CUSTOMER_ID"));
+        /// <summary>
+        /// The Netcup customer ID. Can also be set with NETCUP_CUSTOMER_ID
         /// </summary>
         public static string? CustomerId
         {
@@ -62,5 +72,75 @@ namespace Blackdark.Netcup
             set => _customerId.Set(value);
         }
 
+        private static readonly __Value<string?> _endpoint = new __Value<string?>(() => __config.Get("endpoint"));
+        /// <summary>
+        /// The URL of the Netcup CCP JSON API. Defaults to https://ccp.netcup.net/run/webservice/servers/endpoint.php?JSON
+        /// </summary>
+        public static string? Endpoint
+        {
+            get => _endpoint.Get();
+            set => _endpoint.Set(value);
+        }
+
+        private static readonly __Value<int?> _maxRetries = new __Value<int?>(() => __config.GetInt32("maxRetries"));
+        /// <summary>
+        /// How often a rate-limited (status 2057) or transiently failed API request is retried. Defaults to 5
+        /// </summary>
+        public static int? MaxRetries
+        {
+            get => _maxRetries.Get();
+            set => _maxRetries.Set(value);
+        }
+
+        private static readonly __Value<string?> _nameserver = new __Value<string?>(() => __config.Get("nameserver"));
+        /// <summary>
+        /// The nameserver (host:port) DNSKEY records of DNSSEC signed zones are read from. Defaults to root-dns.netcup.net:53
+        /// </summary>
+        public static string? Nameserver
+        {
+            get => _nameserver.Get();
+            set => _nameserver.Set(value);
+        }
+
+        private static readonly __Value<string?> _proxyUrl = new __Value<string?>(() => __config.Get("proxyUrl"));
+        /// <summary>
+        /// The URL of an HTTP proxy for API requests. Defaults to the HTTPS_PROXY and NO_PROXY environment variables
+        /// </summary>
+        public static string? ProxyUrl
+        {
+            get => _proxyUrl.Get();
+            set => _proxyUrl.Set(value);
+        }
+
+        private static readonly __Value<int?> _requestTimeout = new __Value<int?>(() => __config.GetInt32("requestTimeout"));
+        /// <summary>
+        /// The timeout of a single API request in seconds. Defaults to 30
+        /// </summary>
+        public static int? RequestTimeout
+        {
+            get => _requestTimeout.Get();
+            set => _requestTimeout.Set(value);
+        }
+
+        private static readonly __Value<int?> _requestsPerMinute = new __Value<int?>(() => __config.GetInt32("requestsPerMinute"));
+        /// <summary>
+        /// The maximum number of Netcup API requests per minute made by the provider. The limit is shared by all provider instances in the process. Defaults to 180
+        /// </summary>
+        public static int? RequestsPerMinute
+        {
+            get => _requestsPerMinute.Get();
+            set => _requestsPerMinute.Set(value);
+        }
+
+        private static readonly __Value<string?> _userAgent = new __Value<string?>(() => __config.Get("userAgent"));
+        /// <summary>
+        /// The User-Agent header sent with API requests
+        /// </summary>
+        public static string? UserAgent
+        {
+            get => _userAgent.Get();
+            set => _userAgent.Set(value);
+        }
+
     }
 }
@@ -11,11 +11,17 @@ using Pulumi;
 namespace Blackdark.Netcup
 {
     /// <summary>
-    /// A DNS record managed by Netcup DNS service
+    /// A DNS record managed by Netcup DNS service. Existing records are imported with the ID 'domain:recordID' or by content with 'domain/name/type' or 'domain/name/type/value'
     /// </summary>
     [NetcupResourceType("netcup:index:DNSRecord")]
     public partial class DNSRecord : global::Pulumi.CustomResource
     {
+        /// <summary>
+        /// The structured inputs of a CAA record
+        /// </summary>
+        [Output("caa")]
+        public Output<Outputs.CAARecord?> Caa { get; private set; } = null!;
+
         /// <summary>
         /// The domain name for the DNS record
         /// </summary>
@@ -32,7 +38,13 @@ namespace Blackdark.Netcup
         /// The hostname for the DNS record
         /// </summary>
         [Output("name")]
-        public Output<string> Name { get; private set; } = null!;
+        public Output<string?> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// The inputs the OPENPGPKEY record is derived from
+        /// </summary>
+        [Output("openpgpkey")]
+        public Output<Outputs.OPENPGPKEYRecord?> Openpgpkey { get; private set; } = null!;
 
         /// <summary>
         /// The priority for the DNS record
@@ -46,6 +58,24 @@ namespace Blackdark.Netcup
         [Output("recordId")]
         public Output<string> RecordId { get; private set; } = null!;
 
+        /// <summary>
+        /// The inputs the SMIMEA record is derived from
+        /// </summary>
+        [Output("smimea")]
+        public Output<Outputs.SMIMEARecord?> Smimea { get; private set; } = null!;
+
+        /// <summary>
+        /// The structured inputs of an SRV record
+        /// </summary>
+        [Output("srv")]
+        public Output<Outputs.SRVRecord?> Srv { get; private set; } = null!;
+
+        /// <summary>
+        /// The inputs the TLSA record is derived from
+        /// </summary>
+        [Output("tlsa")]
+        public Output<Outputs.TLSARecord?> Tlsa { get; private set; } = null!;
+
         /// <summary>
         /// The DNS record type
         /// </summary>
@@ -56,7 +86,7 @@ namespace Blackdark.Netcup
         /// The value/destination for the DNS record
         /// </summary>
         [Output("value")]
-        public Output<string> Value { get; private set; } = null!;
+        public Output<string?> Value { get; private set; } = null!;
 
 
         /// <summary>
@@ -103,6 +133,12 @@ namespace Blackdark.Netcup
 
     public sealed class DNSRecordArgs : global::Pulumi.ResourceArgs
     {
+        /// <summary>
+        /// Structured inputs of a CAA record. The value is composed as 'flags tag "value"'
+        /// </summary>
+        [Input("caa")]
+        public Input<Inputs.CAARecordArgs>? Caa { get; set; }
+
         /// <summary>
         /// The domain name for the DNS record (e.g., 'example.com')
         /// </summary>
@@ -110,10 +146,16 @@ namespace Blackdark.Netcup
         public Input<string> Domain { get; set; } = null!;
 
         /// <summary>
-        /// The hostname for the DNS record. Use '@' for root domain, or specify subdomain (e.g., 'www', 'mail')
+        /// The hostname for the DNS record. Use '@' for root domain, or specify subdomain (e.g., 'www', 'mail'). Defaults to '@', for openpgpkey and smimea records to the name derived from the email address
+        /// </summary>
+        [Input("name")]
+        public Input<string>? Name { get; set; }
+
+        /// <summary>
+        /// Inputs of an OPENPGPKEY record publishing the key of an email address. The name is derived from the hashed local part below '_openpgpkey' and the value is the base64 encoded key
         /// </summary>
-        [Input("name", required: true)]
-        public Input<string> Name { get; set; } = null!;
+        [Input("openpgpkey")]
+        public Input<Inputs.OPENPGPKEYRecordArgs>? Openpgpkey { get; set; }
 
         /// <summary>
         /// The priority for MX and SRV records (required for these types, ignored for others)
@@ -121,6 +163,24 @@ namespace Blackdark.Netcup
         [Input("priority")]
         public Input<string>? Priority { get; set; }
 
+        /// <summary>
+        /// Inputs of an SMIMEA record publishing the S/MIME certificate of an email address. The name is derived from the hashed local part below '_smimecert'
+        /// </summary>
+        [Input("smimea")]
+        public Input<Inputs.SMIMEARecordArgs>? Smimea { get; set; }
+
+        /// <summary>
+        /// Structured inputs of an SRV record. The value is composed as 'weight port target' and the name is placed below '_service._proto', using '@' for the service of the domain itself
+        /// </summary>
+        [Input("srv")]
+        public Input<Inputs.SRVRecordArgs>? Srv { get; set; }
+
+        /// <summary>
+        /// Inputs of a TLSA record derived from a certificate or public key. The association data is computed from the certificate and the name is placed below '_port._proto'
+        /// </summary>
+        [Input("tlsa")]
+        public Input<Inputs.TLSARecordArgs>? Tlsa { get; set; }
+
         /// <summary>
         /// The DNS record type. Supported types: A, AAAA, CNAME, MX, TXT, SRV, CAA, TLSA, NS, DS, OPENPGPKEY, SMIMEA, SSHFP
         /// </summary>
@@ -128,10 +188,10 @@ namespace Blackdark.Netcup
         public Input<string> Type { get; set; } = null!;
 
         /// <summary>
-        /// The value/destination for the DNS record (e.g., IP address for A records, hostname for CNAME)
+        /// The value/destination for the DNS record (e.g., IP address for A records, hostname for CNAME). Required unless the value is composed from structured inputs such as srv
         /// </summary>
-        [Input("value", required: true)]
-        public Input<string> Value { get; set; } = null!;
+        [Input("value")]
+        public Input<string>? Value { get; set; }
 
         public DNSRecordArgs()
         {
@@ -0,0 +1,147 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup
+{
+    /// <summary>
+    /// All DNS records of one hostname and type, such as round-robin A records, several MX hosts or multiple TXT values. Records of the hostname and type that are not listed are deleted
+    /// </summary>
+    [NetcupResourceType("netcup:index:DNSRecordSet")]
+    public partial class DNSRecordSet : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The domain name for the DNS records
+        /// </summary>
+        [Output("domain")]
+        public Output<string> Domain { get; private set; } = null!;
+
+        /// <summary>
+        /// The fully qualified domain name
+        /// </summary>
+        [Output("fqdn")]
+        public Output<string> Fqdn { get; private set; } = null!;
+
+        /// <summary>
+        /// The hostname of the DNS records
+        /// </summary>
+        [Output("name")]
+        public Output<string> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// The Netcup IDs of the records, in the order of values
+        /// </summary>
+        [Output("recordIds")]
+        public Output<ImmutableArray<string>> RecordIds { get; private set; } = null!;
+
+        /// <summary>
+        /// The host keys the values of an SSHFP record set are derived from
+        /// </summary>
+        [Output("sshfp")]
+        public Output<Outputs.SSHFPRecordSet?> Sshfp { get; private set; } = null!;
+
+        /// <summary>
+        /// The DNS record type
+        /// </summary>
+        [Output("type")]
+        public Output<string> Type { get; private set; } = null!;
+
+        /// <summary>
+        /// The values of the records
+        /// </summary>
+        [Output("values")]
+        public Output<ImmutableArray<Outputs.DNSRecordSetValue>> Values { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a DNSRecordSet resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public DNSRecordSet(string name, DNSRecordSetArgs args, CustomResourceOptions? options = null)
+            : base("netcup:index:DNSRecordSet", name, args ?? new DNSRecordSetArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private DNSRecordSet(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("netcup:index:DNSRecordSet", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing DNSRecordSet resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static DNSRecordSet Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new DNSRecordSet(name, id, options);
+        }
+    }
+
+    public sealed class DNSRecordSetArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The domain name for the DNS records (e.g., 'example.com')
+        /// </summary>
+        [Input("domain", required: true)]
+        public Input<string> Domain { get; set; } = null!;
+
+        /// <summary>
+        /// The hostname of the DNS records. Use '@' for root domain
+        /// </summary>
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// Host keys the values of an SSHFP record set are derived from, a SHA-1 and a SHA-256 record per key
+        /// </summary>
+        [Input("sshfp")]
+        public Input<Inputs.SSHFPRecordSetArgs>? Sshfp { get; set; }
+
+        /// <summary>
+        /// The DNS record type. Supported types: A, AAAA, CNAME, MX, TXT, SRV, CAA, TLSA, NS, DS, OPENPGPKEY, SMIMEA, SSHFP
+        /// </summary>
+        [Input("type", required: true)]
+        public Input<string> Type { get; set; } = null!;
+
+        [Input("values")]
+        private InputList<Inputs.DNSRecordSetValueArgs>? _values;
+
+        /// <summary>
+        /// The values of the records, one record per value. Required unless sshfp is set
+        /// </summary>
+        public InputList<Inputs.DNSRecordSetValueArgs> Values
+        {
+            get => _values ?? (_values = new InputList<Inputs.DNSRecordSetValueArgs>());
+            set => _values = value;
+        }
+
+        public DNSRecordSetArgs()
+        {
+        }
+        public static new DNSRecordSetArgs Empty => new DNSRecordSetArgs();
+    }
+}
@@ -0,0 +1,159 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup
+{
+    /// <summary>
+    /// The settings of a DNS zone managed by Netcup DNS service. The zone of the domain must already exist; creating the resource adopts it and deleting the resource leaves the zone and its settings untouched
+    /// </summary>
+    [NetcupResourceType("netcup:index:DNSZone")]
+    public partial class DNSZone : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The DNSKEY records of the signed zone in presentation format. Empty while DNSSEC is disabled or Netcup has not finished signing the zone
+        /// </summary>
+        [Output("dnskeys")]
+        public Output<ImmutableArray<string>> Dnskeys { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether the zone is signed with DNSSEC
+        /// </summary>
+        [Output("dnssec")]
+        public Output<bool?> Dnssec { get; private set; } = null!;
+
+        /// <summary>
+        /// The domain name of the zone
+        /// </summary>
+        [Output("domain")]
+        public Output<string> Domain { get; private set; } = null!;
+
+        /// <summary>
+        /// The SHA-256 DS records of the key signing keys, to be published in the parent zone or at the registrar
+        /// </summary>
+        [Output("dsRecords")]
+        public Output<ImmutableArray<string>> DsRecords { get; private set; } = null!;
+
+        /// <summary>
+        /// The SOA expire time in seconds
+        /// </summary>
+        [Output("expire")]
+        public Output<int?> Expire { get; private set; } = null!;
+
+        /// <summary>
+        /// The SOA refresh interval in seconds
+        /// </summary>
+        [Output("refresh")]
+        public Output<int?> Refresh { get; private set; } = null!;
+
+        /// <summary>
+        /// The SOA retry interval in seconds
+        /// </summary>
+        [Output("retry")]
+        public Output<int?> Retry { get; private set; } = null!;
+
+        /// <summary>
+        /// The current SOA serial of the zone
+        /// </summary>
+        [Output("serial")]
+        public Output<string> Serial { get; private set; } = null!;
+
+        /// <summary>
+        /// The default TTL of the zone in seconds
+        /// </summary>
+        [Output("ttl")]
+        public Output<int?> Ttl { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a DNSZone resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public DNSZone(string name, DNSZoneArgs args, CustomResourceOptions? options = null)
+            : base("netcup:index:DNSZone", name, args ?? new DNSZoneArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private DNSZone(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("netcup:index:DNSZone", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing DNSZone resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static DNSZone Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new DNSZone(name, id, options);
+        }
+    }
+
+    public sealed class DNSZoneArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Whether the zone is signed with DNSSEC. Left unchanged when not set
+        /// </summary>
+        [Input("dnssec")]
+        public Input<bool>? Dnssec { get; set; }
+
+        /// <summary>
+        /// The domain name of the zone (e.g., 'example.com')
+        /// </summary>
+        [Input("domain", required: true)]
+        public Input<string> Domain { get; set; } = null!;
+
+        /// <summary>
+        /// The SOA expire time in seconds (RFC 1912 recommends at least 604800). Left unchanged when not set
+        /// </summary>
+        [Input("expire")]
+        public Input<int>? Expire { get; set; }
+
+        /// <summary>
+        /// The SOA refresh interval in seconds (RFC 1912 recommends at least 1200). Left unchanged when not set
+        /// </summary>
+        [Input("refresh")]
+        public Input<int>? Refresh { get; set; }
+
+        /// <summary>
+        /// The SOA retry interval in seconds (RFC 1912 recommends at least 180). Left unchanged when not set
+        /// </summary>
+        [Input("retry")]
+        public Input<int>? Retry { get; set; }
+
+        /// <summary>
+        /// The default TTL of the zone in seconds (RFC 1912 recommends at least 300). Left unchanged when not set
+        /// </summary>
+        [Input("ttl")]
+        public Input<int>? Ttl { get; set; }
+
+        public DNSZoneArgs()
+        {
+        }
+        public static new DNSZoneArgs Empty => new DNSZoneArgs();
+    }
+}
@@ -0,0 +1,135 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup
+{
+    /// <summary>
+    /// The complete record set of a domain managed by Netcup DNS service. Records of the domain that are not listed are deleted unless they match an ignore rule. Do not combine with DNSRecord resources for the same domain
+    /// </summary>
+    [NetcupResourceType("netcup:index:DNSZoneRecords")]
+    public partial class DNSZoneRecords : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The domain name whose records are managed
+        /// </summary>
+        [Output("domain")]
+        public Output<string> Domain { get; private set; } = null!;
+
+        /// <summary>
+        /// The rules selecting records that are left alone
+        /// </summary>
+        [Output("ignore")]
+        public Output<ImmutableArray<Outputs.DNSRecordFilter>> Ignore { get; private set; } = null!;
+
+        /// <summary>
+        /// The Netcup IDs of the records, in the order of records
+        /// </summary>
+        [Output("recordIds")]
+        public Output<ImmutableArray<string>> RecordIds { get; private set; } = null!;
+
+        /// <summary>
+        /// The records of the domain
+        /// </summary>
+        [Output("records")]
+        public Output<ImmutableArray<Outputs.DNSZoneRecord>> Records { get; private set; } = null!;
+
+        /// <summary>
+        /// The zone file the records were taken from
+        /// </summary>
+        [Output("zoneFile")]
+        public Output<string?> ZoneFile { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a DNSZoneRecords resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public DNSZoneRecords(string name, DNSZoneRecordsArgs args, CustomResourceOptions? options = null)
+            : base("netcup:index:DNSZoneRecords", name, args ?? new DNSZoneRecordsArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private DNSZoneRecords(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("netcup:index:DNSZoneRecords", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing DNSZoneRecords resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static DNSZoneRecords Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new DNSZoneRecords(name, id, options);
+        }
+    }
+
+    public sealed class DNSZoneRecordsArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The domain name whose records are managed (e.g., 'example.com')
+        /// </summary>
+        [Input("domain", required: true)]
+        public Input<string> Domain { get; set; } = null!;
+
+        [Input("ignore")]
+        private InputList<Inputs.DNSRecordFilterArgs>? _ignore;
+
+        /// <summary>
+        /// Existing records matching any of these rules are left alone instead of being deleted
+        /// </summary>
+        public InputList<Inputs.DNSRecordFilterArgs> Ignore
+        {
+            get => _ignore ?? (_ignore = new InputList<Inputs.DNSRecordFilterArgs>());
+            set => _ignore = value;
+        }
+
+        [Input("records")]
+        private InputList<Inputs.DNSZoneRecordArgs>? _records;
+
+        /// <summary>
+        /// The complete list of records the domain should have
+        /// </summary>
+        public InputList<Inputs.DNSZoneRecordArgs> Records
+        {
+            get => _records ?? (_records = new InputList<Inputs.DNSZoneRecordArgs>());
+            set => _records = value;
+        }
+
+        /// <summary>
+        /// An RFC 1035 zone file (BIND format) to take the records from instead of records. SOA and apex NS records are skipped and record TTLs are ignored
+        /// </summary>
+        [Input("zoneFile")]
+        public Input<string>? ZoneFile { get; set; }
+
+        public DNSZoneRecordsArgs()
+        {
+        }
+        public static new DNSZoneRecordsArgs Empty => new DNSZoneRecordsArgs();
+    }
+}
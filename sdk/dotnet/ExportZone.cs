@@ -0,0 +1,112 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup
+{
+    public static class ExportZone
+    {
+        /// <summary>
+        /// Exports the records and zone settings of a domain as an RFC 1035 zone file, a JSON document or an octoDNS YAML zone, for backups, audits and migrations. Records are sorted, so exports of an unchanged zone are identical
+        /// </summary>
+        public static Task<ExportZoneResult> InvokeAsync(ExportZoneArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<ExportZoneResult>("netcup:index:exportZone", args ?? new ExportZoneArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Exports the records and zone settings of a domain as an RFC 1035 zone file, a JSON document or an octoDNS YAML zone, for backups, audits and migrations. Records are sorted, so exports of an unchanged zone are identical
+        /// </summary>
+        public static Output<ExportZoneResult> Invoke(ExportZoneInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<ExportZoneResult>("netcup:index:exportZone", args ?? new ExportZoneInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Exports the records and zone settings of a domain as an RFC 1035 zone file, a JSON document or an octoDNS YAML zone, for backups, audits and migrations. Records are sorted, so exports of an unchanged zone are identical
+        /// </summary>
+        public static Output<ExportZoneResult> Invoke(ExportZoneInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<ExportZoneResult>("netcup:index:exportZone", args ?? new ExportZoneInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class ExportZoneArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The domain name to export (e.g., 'example.com')
+        /// </summary>
+        [Input("domain", required: true)]
+        public string Domain { get; set; } = null!;
+
+        /// <summary>
+        /// The format of the export: 'bind' for a zone file, 'json' or 'octodns'. Defaults to 'bind'
+        /// </summary>
+        [Input("format")]
+        public string? Format { get; set; }
+
+        public ExportZoneArgs()
+        {
+        }
+        public static new ExportZoneArgs Empty => new ExportZoneArgs();
+    }
+
+    public sealed class ExportZoneInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The domain name to export (e.g., 'example.com')
+        /// </summary>
+        [Input("domain", required: true)]
+        public Input<string> Domain { get; set; } = null!;
+
+        /// <summary>
+        /// The format of the export: 'bind' for a zone file, 'json' or 'octodns'. Defaults to 'bind'
+        /// </summary>
+        [Input("format")]
+        public Input<string>? Format { get; set; }
+
+        public ExportZoneInvokeArgs()
+        {
+        }
+        public static new ExportZoneInvokeArgs Empty => new ExportZoneInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class ExportZoneResult
+    {
+        /// <summary>
+        /// The exported zone
+        /// </summary>
+        public readonly string Content;
+        /// <summary>
+        /// The exported domain name
+        /// </summary>
+        public readonly string Domain;
+        /// <summary>
+        /// The format of the export
+        /// </summary>
+        public readonly string Format;
+        /// <summary>
+        /// The serial of the zone at the time of the export
+        /// </summary>
+        public readonly string Serial;
+
+        [OutputConstructor]
+        private ExportZoneResult(
+            string content,
+
+            string domain,
+
+            string format,
+
+            string serial)
+        {
+            Content = content;
+            Domain = domain;
+            Format = format;
+            Serial = serial;
+        }
+    }
+}
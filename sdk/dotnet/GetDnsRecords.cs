@@ -0,0 +1,122 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup
+{
+    public static class GetDnsRecords
+    {
+        /// <summary>
+        /// Returns the DNS records of a domain, optionally filtered by hostname, type or value. Records created outside of Pulumi, such as by the hosting panel, are included
+        /// </summary>
+        public static Task<GetDnsRecordsResult> InvokeAsync(GetDnsRecordsArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetDnsRecordsResult>("netcup:index:getDnsRecords", args ?? new GetDnsRecordsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Returns the DNS records of a domain, optionally filtered by hostname, type or value. Records created outside of Pulumi, such as by the hosting panel, are included
+        /// </summary>
+        public static Output<GetDnsRecordsResult> Invoke(GetDnsRecordsInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetDnsRecordsResult>("netcup:index:getDnsRecords", args ?? new GetDnsRecordsInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Returns the DNS records of a domain, optionally filtered by hostname, type or value. Records created outside of Pulumi, such as by the hosting panel, are included
+        /// </summary>
+        public static Output<GetDnsRecordsResult> Invoke(GetDnsRecordsInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetDnsRecordsResult>("netcup:index:getDnsRecords", args ?? new GetDnsRecordsInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetDnsRecordsArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The domain name to look up the DNS records of (e.g., 'example.com')
+        /// </summary>
+        [Input("domain", required: true)]
+        public string Domain { get; set; } = null!;
+
+        /// <summary>
+        /// Only return records of this hostname. Use '@' for root domain
+        /// </summary>
+        [Input("name")]
+        public string? Name { get; set; }
+
+        /// <summary>
+        /// Only return records of this DNS record type
+        /// </summary>
+        [Input("type")]
+        public string? Type { get; set; }
+
+        /// <summary>
+        /// Only return records whose value/destination matches this regular expression
+        /// </summary>
+        [Input("valueRegex")]
+        public string? ValueRegex { get; set; }
+
+        public GetDnsRecordsArgs()
+        {
+        }
+        public static new GetDnsRecordsArgs Empty => new GetDnsRecordsArgs();
+    }
+
+    public sealed class GetDnsRecordsInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The domain name to look up the DNS records of (e.g., 'example.com')
+        /// </summary>
+        [Input("domain", required: true)]
+        public Input<string> Domain { get; set; } = null!;
+
+        /// <summary>
+        /// Only return records of this hostname. Use '@' for root domain
+        /// </summary>
+        [Input("name")]
+        public Input<string>? Name { get; set; }
+
+        /// <summary>
+        /// Only return records of this DNS record type
+        /// </summary>
+        [Input("type")]
+        public Input<string>? Type { get; set; }
+
+        /// <summary>
+        /// Only return records whose value/destination matches this regular expression
+        /// </summary>
+        [Input("valueRegex")]
+        public Input<string>? ValueRegex { get; set; }
+
+        public GetDnsRecordsInvokeArgs()
+        {
+        }
+        public static new GetDnsRecordsInvokeArgs Empty => new GetDnsRecordsInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetDnsRecordsResult
+    {
+        /// <summary>
+        /// The domain name the DNS records belong to
+        /// </summary>
+        public readonly string Domain;
+        /// <summary>
+        /// The DNS records matching the filters, in the order Netcup returns them
+        /// </summary>
+        public readonly ImmutableArray<Outputs.DNSRecordEntry> Records;
+
+        [OutputConstructor]
+        private GetDnsRecordsResult(
+            string domain,
+
+            ImmutableArray<Outputs.DNSRecordEntry> records)
+        {
+            Domain = domain;
+            Records = records;
+        }
+    }
+}
@@ -0,0 +1,114 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup
+{
+    public static class GetDomainInfo
+    {
+        /// <summary>
+        /// Returns the registration details of a domain owned by the Netcup customer account
+        /// </summary>
+        public static Task<GetDomainInfoResult> InvokeAsync(GetDomainInfoArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetDomainInfoResult>("netcup:index:getDomainInfo", args ?? new GetDomainInfoArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Returns the registration details of a domain owned by the Netcup customer account
+        /// </summary>
+        public static Output<GetDomainInfoResult> Invoke(GetDomainInfoInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetDomainInfoResult>("netcup:index:getDomainInfo", args ?? new GetDomainInfoInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Returns the registration details of a domain owned by the Netcup customer account
+        /// </summary>
+        public static Output<GetDomainInfoResult> Invoke(GetDomainInfoInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetDomainInfoResult>("netcup:index:getDomainInfo", args ?? new GetDomainInfoInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetDomainInfoArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The domain name to look up (e.g., 'example.com')
+        /// </summary>
+        [Input("domain", required: true)]
+        public string Domain { get; set; } = null!;
+
+        public GetDomainInfoArgs()
+        {
+        }
+        public static new GetDomainInfoArgs Empty => new GetDomainInfoArgs();
+    }
+
+    public sealed class GetDomainInfoInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The domain name to look up (e.g., 'example.com')
+        /// </summary>
+        [Input("domain", required: true)]
+        public Input<string> Domain { get; set; } = null!;
+
+        public GetDomainInfoInvokeArgs()
+        {
+        }
+        public static new GetDomainInfoInvokeArgs Empty => new GetDomainInfoInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetDomainInfoResult
+    {
+        /// <summary>
+        /// The domain name
+        /// </summary>
+        public readonly string Domain;
+        /// <summary>
+        /// The contact handles assigned to the domain
+        /// </summary>
+        public readonly ImmutableArray<Outputs.DomainHandleDetails> Handles;
+        /// <summary>
+        /// The hostnames of the nameservers the domain is delegated to
+        /// </summary>
+        public readonly ImmutableArray<string> Nameservers;
+        /// <summary>
+        /// The end date of the current contract runtime
+        /// </summary>
+        public readonly string RuntimeEnd;
+        /// <summary>
+        /// The start date of the current contract runtime
+        /// </summary>
+        public readonly string RuntimeStart;
+        /// <summary>
+        /// The registration state of the domain as reported by Netcup
+        /// </summary>
+        public readonly string State;
+
+        [OutputConstructor]
+        private GetDomainInfoResult(
+            string domain,
+
+            ImmutableArray<Outputs.DomainHandleDetails> handles,
+
+            ImmutableArray<string> nameservers,
+
+            string runtimeEnd,
+
+            string runtimeStart,
+
+            string state)
+        {
+            Domain = domain;
+            Handles = handles;
+            Nameservers = nameservers;
+            RuntimeEnd = runtimeEnd;
+            RuntimeStart = runtimeStart;
+            State = state;
+        }
+    }
+}
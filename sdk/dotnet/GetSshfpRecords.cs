@@ -0,0 +1,90 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup
+{
+    public static class GetSshfpRecords
+    {
+        /// <summary>
+        /// Returns the SHA-1 and SHA-256 SSHFP records of SSH host keys, such as the contents of /etc/ssh/ssh_host_*_key.pub
+        /// </summary>
+        public static Task<GetSshfpRecordsResult> InvokeAsync(GetSshfpRecordsArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetSshfpRecordsResult>("netcup:index:getSshfpRecords", args ?? new GetSshfpRecordsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Returns the SHA-1 and SHA-256 SSHFP records of SSH host keys, such as the contents of /etc/ssh/ssh_host_*_key.pub
+        /// </summary>
+        public static Output<GetSshfpRecordsResult> Invoke(GetSshfpRecordsInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetSshfpRecordsResult>("netcup:index:getSshfpRecords", args ?? new GetSshfpRecordsInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Returns the SHA-1 and SHA-256 SSHFP records of SSH host keys, such as the contents of /etc/ssh/ssh_host_*_key.pub
+        /// </summary>
+        public static Output<GetSshfpRecordsResult> Invoke(GetSshfpRecordsInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetSshfpRecordsResult>("netcup:index:getSshfpRecords", args ?? new GetSshfpRecordsInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetSshfpRecordsArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("publicKeys", required: true)]
+        private List<string>? _publicKeys;
+
+        /// <summary>
+        /// The RSA, ECDSA or Ed25519 host keys in OpenSSH authorized_keys format
+        /// </summary>
+        public List<string> PublicKeys
+        {
+            get => _publicKeys ?? (_publicKeys = new List<string>());
+            set => _publicKeys = value;
+        }
+
+        public GetSshfpRecordsArgs()
+        {
+        }
+        public static new GetSshfpRecordsArgs Empty => new GetSshfpRecordsArgs();
+    }
+
+    public sealed class GetSshfpRecordsInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        [Input("publicKeys", required: true)]
+        private InputList<string>? _publicKeys;
+
+        /// <summary>
+        /// The RSA, ECDSA or Ed25519 host keys in OpenSSH authorized_keys format
+        /// </summary>
+        public InputList<string> PublicKeys
+        {
+            get => _publicKeys ?? (_publicKeys = new InputList<string>());
+            set => _publicKeys = value;
+        }
+
+        public GetSshfpRecordsInvokeArgs()
+        {
+        }
+        public static new GetSshfpRecordsInvokeArgs Empty => new GetSshfpRecordsInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetSshfpRecordsResult
+    {
+        /// <summary>
+        /// The SSHFP records, a SHA-1 and a SHA-256 record per key
+        /// </summary>
+        public readonly ImmutableArray<Outputs.SSHFPRecordEntry> Records;
+
+        [OutputConstructor]
+        private GetSshfpRecordsResult(ImmutableArray<Outputs.SSHFPRecordEntry> records)
+        {
+            Records = records;
+        }
+    }
+}
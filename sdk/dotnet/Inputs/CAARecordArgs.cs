@@ -0,0 +1,40 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup.Inputs
+{
+
+    public sealed class CAARecordArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The flags of the record: 0, or 128 to mark the property as critical for issuers
+        /// </summary>
+        [Input("flags")]
+        public Input<int>? Flags { get; set; }
+
+        /// <summary>
+        /// The property tag: issue, issuewild, iodef, contactemail or contactphone
+        /// </summary>
+        [Input("tag", required: true)]
+        public Input<string> Tag { get; set; } = null!;
+
+        /// <summary>
+        /// The unquoted property value, e.g. 'letsencrypt.org' for issue or 'mailto:security@example.com' for iodef
+        /// </summary>
+        [Input("value", required: true)]
+        public Input<string> Value { get; set; } = null!;
+
+        public CAARecordArgs()
+        {
+            Flags = 0;
+        }
+        public static new CAARecordArgs Empty => new CAARecordArgs();
+    }
+}
@@ -0,0 +1,33 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup.Inputs
+{
+
+    public sealed class DNSRecordFilterArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The hostname to match. Matches every hostname when not set
+        /// </summary>
+        [Input("name")]
+        public Input<string>? Name { get; set; }
+
+        /// <summary>
+        /// The record type to match. Matches every type when not set
+        /// </summary>
+        [Input("type")]
+        public Input<string>? Type { get; set; }
+
+        public DNSRecordFilterArgs()
+        {
+        }
+        public static new DNSRecordFilterArgs Empty => new DNSRecordFilterArgs();
+    }
+}
@@ -0,0 +1,33 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup.Inputs
+{
+
+    public sealed class DNSRecordSetValueArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The priority for MX and SRV records (required for these types, ignored for others)
+        /// </summary>
+        [Input("priority")]
+        public Input<string>? Priority { get; set; }
+
+        /// <summary>
+        /// The value/destination of the record
+        /// </summary>
+        [Input("value", required: true)]
+        public Input<string> Value { get; set; } = null!;
+
+        public DNSRecordSetValueArgs()
+        {
+        }
+        public static new DNSRecordSetValueArgs Empty => new DNSRecordSetValueArgs();
+    }
+}
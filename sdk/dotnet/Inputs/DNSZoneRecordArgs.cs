@@ -0,0 +1,45 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup.Inputs
+{
+
+    public sealed class DNSZoneRecordArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The hostname of the record. Use '@' for the root domain
+        /// </summary>
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// The priority for MX and SRV records
+        /// </summary>
+        [Input("priority")]
+        public Input<string>? Priority { get; set; }
+
+        /// <summary>
+        /// The DNS record type
+        /// </summary>
+        [Input("type", required: true)]
+        public Input<string> Type { get; set; } = null!;
+
+        /// <summary>
+        /// The value/destination of the record
+        /// </summary>
+        [Input("value", required: true)]
+        public Input<string> Value { get; set; } = null!;
+
+        public DNSZoneRecordArgs()
+        {
+        }
+        public static new DNSZoneRecordArgs Empty => new DNSZoneRecordArgs();
+    }
+}
@@ -0,0 +1,33 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup.Inputs
+{
+
+    public sealed class OPENPGPKEYRecordArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The email address the key belongs to, within the domain of the record
+        /// </summary>
+        [Input("email", required: true)]
+        public Input<string> Email { get; set; } = null!;
+
+        /// <summary>
+        /// The ASCII armored OpenPGP public key, with a user ID of the email address
+        /// </summary>
+        [Input("publicKey", required: true)]
+        public Input<string> PublicKey { get; set; } = null!;
+
+        public OPENPGPKEYRecordArgs()
+        {
+        }
+        public static new OPENPGPKEYRecordArgs Empty => new OPENPGPKEYRecordArgs();
+    }
+}
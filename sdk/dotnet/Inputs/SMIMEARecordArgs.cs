@@ -0,0 +1,54 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup.Inputs
+{
+
+    public sealed class SMIMEARecordArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The PEM encoded S/MIME certificate of the email address
+        /// </summary>
+        [Input("certificate", required: true)]
+        public Input<string> Certificate { get; set; } = null!;
+
+        /// <summary>
+        /// The email address the certificate is issued for, within the domain of the record
+        /// </summary>
+        [Input("email", required: true)]
+        public Input<string> Email { get; set; } = null!;
+
+        /// <summary>
+        /// The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512. Senders need the full certificate to encrypt, so it defaults to 0
+        /// </summary>
+        [Input("matchingType")]
+        public Input<int>? MatchingType { get; set; }
+
+        /// <summary>
+        /// The selector: 0 for the full certificate or 1 for the public key
+        /// </summary>
+        [Input("selector")]
+        public Input<int>? Selector { get; set; }
+
+        /// <summary>
+        /// The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+        /// </summary>
+        [Input("usage")]
+        public Input<int>? Usage { get; set; }
+
+        public SMIMEARecordArgs()
+        {
+            MatchingType = 0;
+            Selector = 0;
+            Usage = 3;
+        }
+        public static new SMIMEARecordArgs Empty => new SMIMEARecordArgs();
+    }
+}
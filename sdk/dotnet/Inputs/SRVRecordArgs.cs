@@ -0,0 +1,52 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup.Inputs
+{
+
+    public sealed class SRVRecordArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The port the service is offered on, between 0 and 65535
+        /// </summary>
+        [Input("port", required: true)]
+        public Input<int> Port { get; set; } = null!;
+
+        /// <summary>
+        /// The transport protocol without leading underscore (e.g., 'tcp', 'udp', 'tls')
+        /// </summary>
+        [Input("protocol", required: true)]
+        public Input<string> Protocol { get; set; } = null!;
+
+        /// <summary>
+        /// The symbolic name of the service without leading underscore (e.g., 'sip', 'xmpp-client')
+        /// </summary>
+        [Input("service", required: true)]
+        public Input<string> Service { get; set; } = null!;
+
+        /// <summary>
+        /// The hostname of the server offering the service, or '.' if the service is not offered
+        /// </summary>
+        [Input("target", required: true)]
+        public Input<string> Target { get; set; } = null!;
+
+        /// <summary>
+        /// The relative weight of records with the same priority, between 0 and 65535
+        /// </summary>
+        [Input("weight")]
+        public Input<int>? Weight { get; set; }
+
+        public SRVRecordArgs()
+        {
+            Weight = 0;
+        }
+        public static new SRVRecordArgs Empty => new SRVRecordArgs();
+    }
+}
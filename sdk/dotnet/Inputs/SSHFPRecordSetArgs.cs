@@ -0,0 +1,33 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup.Inputs
+{
+
+    public sealed class SSHFPRecordSetArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("publicKeys", required: true)]
+        private InputList<string>? _publicKeys;
+
+        /// <summary>
+        /// The RSA, ECDSA or Ed25519 host keys in OpenSSH authorized_keys format
+        /// </summary>
+        public InputList<string> PublicKeys
+        {
+            get => _publicKeys ?? (_publicKeys = new InputList<string>());
+            set => _publicKeys = value;
+        }
+
+        public SSHFPRecordSetArgs()
+        {
+        }
+        public static new SSHFPRecordSetArgs Empty => new SSHFPRecordSetArgs();
+    }
+}
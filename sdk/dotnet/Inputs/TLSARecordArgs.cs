@@ -0,0 +1,61 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup.Inputs
+{
+
+    public sealed class TLSARecordArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The PEM encoded certificate or public key (SubjectPublicKeyInfo) the record is derived from. Of a certificate chain, the first certificate is used
+        /// </summary>
+        [Input("certificate", required: true)]
+        public Input<string> Certificate { get; set; } = null!;
+
+        /// <summary>
+        /// The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512
+        /// </summary>
+        [Input("matchingType")]
+        public Input<int>? MatchingType { get; set; }
+
+        /// <summary>
+        /// The port the TLS service is offered on, e.g. 25 for SMTP
+        /// </summary>
+        [Input("port", required: true)]
+        public Input<int> Port { get; set; } = null!;
+
+        /// <summary>
+        /// The transport protocol of the service without leading underscore
+        /// </summary>
+        [Input("protocol")]
+        public Input<string>? Protocol { get; set; }
+
+        /// <summary>
+        /// The selector: 0 for the full certificate or 1 for the public key
+        /// </summary>
+        [Input("selector")]
+        public Input<int>? Selector { get; set; }
+
+        /// <summary>
+        /// The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+        /// </summary>
+        [Input("usage")]
+        public Input<int>? Usage { get; set; }
+
+        public TLSARecordArgs()
+        {
+            MatchingType = 1;
+            Protocol = "tcp";
+            Selector = 1;
+            Usage = 3;
+        }
+        public static new TLSARecordArgs Empty => new TLSARecordArgs();
+    }
+}
@@ -0,0 +1,58 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup
+{
+    public static class ListDomains
+    {
+        /// <summary>
+        /// Returns all domains owned by the Netcup customer account
+        /// </summary>
+        public static Task<ListDomainsResult> InvokeAsync(ListDomainsArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<ListDomainsResult>("netcup:index:listDomains", args ?? new ListDomainsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Returns all domains owned by the Netcup customer account
+        /// </summary>
+        public static Output<ListDomainsResult> Invoke(InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<ListDomainsResult>("netcup:index:listDomains", InvokeArgs.Empty, options.WithDefaults());
+
+        /// <summary>
+        /// Returns all domains owned by the Netcup customer account
+        /// </summary>
+        public static Output<ListDomainsResult> Invoke(InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<ListDomainsResult>("netcup:index:listDomains", InvokeArgs.Empty, options.WithDefaults());
+    }
+
+
+    public sealed class ListDomainsArgs : global::Pulumi.InvokeArgs
+    {
+        public ListDomainsArgs()
+        {
+        }
+        public static new ListDomainsArgs Empty => new ListDomainsArgs();
+    }
+
+
+    [OutputType]
+    public sealed class ListDomainsResult
+    {
+        /// <summary>
+        /// The domains of the account, sorted by name
+        /// </summary>
+        public readonly ImmutableArray<Outputs.DomainDetails> Domains;
+
+        [OutputConstructor]
+        private ListDomainsResult(ImmutableArray<Outputs.DomainDetails> domains)
+        {
+            Domains = domains;
+        }
+    }
+}
@@ -0,0 +1,43 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup.Outputs
+{
+
+    [OutputType]
+    public sealed class CAARecord
+    {
+        /// <summary>
+        /// The flags of the record: 0, or 128 to mark the property as critical for issuers
+        /// </summary>
+        public readonly int? Flags;
+        /// <summary>
+        /// The property tag: issue, issuewild, iodef, contactemail or contactphone
+        /// </summary>
+        public readonly string Tag;
+        /// <summary>
+        /// The unquoted property value, e.g. 'letsencrypt.org' for issue or 'mailto:security@example.com' for iodef
+        /// </summary>
+        public readonly string Value;
+
+        [OutputConstructor]
+        private CAARecord(
+            int? flags,
+
+            string tag,
+
+            string value)
+        {
+            Flags = flags;
+            Tag = tag;
+            Value = value;
+        }
+    }
+}
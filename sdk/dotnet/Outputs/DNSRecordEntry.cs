@@ -0,0 +1,64 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup.Outputs
+{
+
+    [OutputType]
+    public sealed class DNSRecordEntry
+    {
+        /// <summary>
+        /// The fully qualified domain name
+        /// </summary>
+        public readonly string Fqdn;
+        /// <summary>
+        /// The hostname of the DNS record
+        /// </summary>
+        public readonly string Name;
+        /// <summary>
+        /// The priority of MX and SRV records
+        /// </summary>
+        public readonly string? Priority;
+        /// <summary>
+        /// The Netcup record ID
+        /// </summary>
+        public readonly string RecordId;
+        /// <summary>
+        /// The DNS record type
+        /// </summary>
+        public readonly string Type;
+        /// <summary>
+        /// The value/destination of the DNS record
+        /// </summary>
+        public readonly string Value;
+
+        [OutputConstructor]
+        private DNSRecordEntry(
+            string fqdn,
+
+            string name,
+
+            string? priority,
+
+            string recordId,
+
+            string type,
+
+            string value)
+        {
+            Fqdn = fqdn;
+            Name = name;
+            Priority = priority;
+            RecordId = recordId;
+            Type = type;
+            Value = value;
+        }
+    }
+}
@@ -0,0 +1,36 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup.Outputs
+{
+
+    [OutputType]
+    public sealed class DNSRecordFilter
+    {
+        /// <summary>
+        /// The hostname to match. Matches every hostname when not set
+        /// </summary>
+        public readonly string? Name;
+        /// <summary>
+        /// The record type to match. Matches every type when not set
+        /// </summary>
+        public readonly string? Type;
+
+        [OutputConstructor]
+        private DNSRecordFilter(
+            string? name,
+
+            string? type)
+        {
+            Name = name;
+            Type = type;
+        }
+    }
+}
@@ -0,0 +1,36 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup.Outputs
+{
+
+    [OutputType]
+    public sealed class DNSRecordSetValue
+    {
+        /// <summary>
+        /// The priority for MX and SRV records (required for these types, ignored for others)
+        /// </summary>
+        public readonly string? Priority;
+        /// <summary>
+        /// The value/destination of the record
+        /// </summary>
+        public readonly string Value;
+
+        [OutputConstructor]
+        private DNSRecordSetValue(
+            string? priority,
+
+            string value)
+        {
+            Priority = priority;
+            Value = value;
+        }
+    }
+}
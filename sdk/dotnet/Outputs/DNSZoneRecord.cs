@@ -0,0 +1,50 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup.Outputs
+{
+
+    [OutputType]
+    public sealed class DNSZoneRecord
+    {
+        /// <summary>
+        /// The hostname of the record. Use '@' for the root domain
+        /// </summary>
+        public readonly string Name;
+        /// <summary>
+        /// The priority for MX and SRV records
+        /// </summary>
+        public readonly string? Priority;
+        /// <summary>
+        /// The DNS record type
+        /// </summary>
+        public readonly string Type;
+        /// <summary>
+        /// The value/destination of the record
+        /// </summary>
+        public readonly string Value;
+
+        [OutputConstructor]
+        private DNSZoneRecord(
+            string name,
+
+            string? priority,
+
+            string type,
+
+            string value)
+        {
+            Name = name;
+            Priority = priority;
+            Type = type;
+            Value = value;
+        }
+    }
+}
@@ -0,0 +1,64 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup.Outputs
+{
+
+    [OutputType]
+    public sealed class DomainDetails
+    {
+        /// <summary>
+        /// The domain name
+        /// </summary>
+        public readonly string Domain;
+        /// <summary>
+        /// The contact handles assigned to the domain
+        /// </summary>
+        public readonly ImmutableArray<Outputs.DomainHandleDetails> Handles;
+        /// <summary>
+        /// The hostnames of the nameservers the domain is delegated to
+        /// </summary>
+        public readonly ImmutableArray<string> Nameservers;
+        /// <summary>
+        /// The end date of the current contract runtime
+        /// </summary>
+        public readonly string RuntimeEnd;
+        /// <summary>
+        /// The start date of the current contract runtime
+        /// </summary>
+        public readonly string RuntimeStart;
+        /// <summary>
+        /// The registration state of the domain as reported by Netcup
+        /// </summary>
+        public readonly string State;
+
+        [OutputConstructor]
+        private DomainDetails(
+            string domain,
+
+            ImmutableArray<Outputs.DomainHandleDetails> handles,
+
+            ImmutableArray<string> nameservers,
+
+            string runtimeEnd,
+
+            string runtimeStart,
+
+            string state)
+        {
+            Domain = domain;
+            Handles = handles;
+            Nameservers = nameservers;
+            RuntimeEnd = runtimeEnd;
+            RuntimeStart = runtimeStart;
+            State = state;
+        }
+    }
+}
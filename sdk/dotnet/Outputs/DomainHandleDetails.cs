@@ -0,0 +1,36 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup.Outputs
+{
+
+    [OutputType]
+    public sealed class DomainHandleDetails
+    {
+        /// <summary>
+        /// The Netcup ID of the handle
+        /// </summary>
+        public readonly string HandleId;
+        /// <summary>
+        /// The role of the handle, such as 'ownerc', 'adminc', 'techc' or 'zonec'
+        /// </summary>
+        public readonly string Type;
+
+        [OutputConstructor]
+        private DomainHandleDetails(
+            string handleId,
+
+            string type)
+        {
+            HandleId = handleId;
+            Type = type;
+        }
+    }
+}
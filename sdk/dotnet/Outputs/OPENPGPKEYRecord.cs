@@ -0,0 +1,36 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup.Outputs
+{
+
+    [OutputType]
+    public sealed class OPENPGPKEYRecord
+    {
+        /// <summary>
+        /// The email address the key belongs to, within the domain of the record
+        /// </summary>
+        public readonly string Email;
+        /// <summary>
+        /// The ASCII armored OpenPGP public key, with a user ID of the email address
+        /// </summary>
+        public readonly string PublicKey;
+
+        [OutputConstructor]
+        private OPENPGPKEYRecord(
+            string email,
+
+            string publicKey)
+        {
+            Email = email;
+            PublicKey = publicKey;
+        }
+    }
+}
@@ -0,0 +1,57 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup.Outputs
+{
+
+    [OutputType]
+    public sealed class SMIMEARecord
+    {
+        /// <summary>
+        /// The PEM encoded S/MIME certificate of the email address
+        /// </summary>
+        public readonly string Certificate;
+        /// <summary>
+        /// The email address the certificate is issued for, within the domain of the record
+        /// </summary>
+        public readonly string Email;
+        /// <summary>
+        /// The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512. Senders need the full certificate to encrypt, so it defaults to 0
+        /// </summary>
+        public readonly int? MatchingType;
+        /// <summary>
+        /// The selector: 0 for the full certificate or 1 for the public key
+        /// </summary>
+        public readonly int? Selector;
+        /// <summary>
+        /// The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+        /// </summary>
+        public readonly int? Usage;
+
+        [OutputConstructor]
+        private SMIMEARecord(
+            string certificate,
+
+            string email,
+
+            int? matchingType,
+
+            int? selector,
+
+            int? usage)
+        {
+            Certificate = certificate;
+            Email = email;
+            MatchingType = matchingType;
+            Selector = selector;
+            Usage = usage;
+        }
+    }
+}
@@ -0,0 +1,57 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup.Outputs
+{
+
+    [OutputType]
+    public sealed class SRVRecord
+    {
+        /// <summary>
+        /// The port the service is offered on, between 0 and 65535
+        /// </summary>
+        public readonly int Port;
+        /// <summary>
+        /// The transport protocol without leading underscore (e.g., 'tcp', 'udp', 'tls')
+        /// </summary>
+        public readonly string Protocol;
+        /// <summary>
+        /// The symbolic name of the service without leading underscore (e.g., 'sip', 'xmpp-client')
+        /// </summary>
+        public readonly string Service;
+        /// <summary>
+        /// The hostname of the server offering the service, or '.' if the service is not offered
+        /// </summary>
+        public readonly string Target;
+        /// <summary>
+        /// The relative weight of records with the same priority, between 0 and 65535
+        /// </summary>
+        public readonly int? Weight;
+
+        [OutputConstructor]
+        private SRVRecord(
+            int port,
+
+            string protocol,
+
+            string service,
+
+            string target,
+
+            int? weight)
+        {
+            Port = port;
+            Protocol = protocol;
+            Service = service;
+            Target = target;
+            Weight = weight;
+        }
+    }
+}
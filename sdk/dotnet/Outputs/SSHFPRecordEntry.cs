@@ -0,0 +1,50 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup.Outputs
+{
+
+    [OutputType]
+    public sealed class SSHFPRecordEntry
+    {
+        /// <summary>
+        /// The SSHFP algorithm number: 1 (RSA), 3 (ECDSA) or 4 (Ed25519)
+        /// </summary>
+        public readonly int Algorithm;
+        /// <summary>
+        /// The hex encoded fingerprint of the key
+        /// </summary>
+        public readonly string Fingerprint;
+        /// <summary>
+        /// The fingerprint type: 1 (SHA-1) or 2 (SHA-256)
+        /// </summary>
+        public readonly int FingerprintType;
+        /// <summary>
+        /// The value of the SSHFP record
+        /// </summary>
+        public readonly string Value;
+
+        [OutputConstructor]
+        private SSHFPRecordEntry(
+            int algorithm,
+
+            string fingerprint,
+
+            int fingerprintType,
+
+            string value)
+        {
+            Algorithm = algorithm;
+            Fingerprint = fingerprint;
+            FingerprintType = fingerprintType;
+            Value = value;
+        }
+    }
+}
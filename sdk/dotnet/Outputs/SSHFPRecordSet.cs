@@ -0,0 +1,28 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup.Outputs
+{
+
+    [OutputType]
+    public sealed class SSHFPRecordSet
+    {
+        /// <summary>
+        /// The RSA, ECDSA or Ed25519 host keys in OpenSSH authorized_keys format
+        /// </summary>
+        public readonly ImmutableArray<string> PublicKeys;
+
+        [OutputConstructor]
+        private SSHFPRecordSet(ImmutableArray<string> publicKeys)
+        {
+            PublicKeys = publicKeys;
+        }
+    }
+}
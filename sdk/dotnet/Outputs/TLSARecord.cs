@@ -0,0 +1,64 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup.Outputs
+{
+
+    [OutputType]
+    public sealed class TLSARecord
+    {
+        /// <summary>
+        /// The PEM encoded certificate or public key (SubjectPublicKeyInfo) the record is derived from. Of a certificate chain, the first certificate is used
+        /// </summary>
+        public readonly string Certificate;
+        /// <summary>
+        /// The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512
+        /// </summary>
+        public readonly int? MatchingType;
+        /// <summary>
+        /// The port the TLS service is offered on, e.g. 25 for SMTP
+        /// </summary>
+        public readonly int Port;
+        /// <summary>
+        /// The transport protocol of the service without leading underscore
+        /// </summary>
+        public readonly string? Protocol;
+        /// <summary>
+        /// The selector: 0 for the full certificate or 1 for the public key
+        /// </summary>
+        public readonly int? Selector;
+        /// <summary>
+        /// The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+        /// </summary>
+        public readonly int? Usage;
+
+        [OutputConstructor]
+        private TLSARecord(
+            string certificate,
+
+            int? matchingType,
+
+            int port,
+
+            string? protocol,
+
+            int? selector,
+
+            int? usage)
+        {
+            Certificate = certificate;
+            MatchingType = matchingType;
+            Port = port;
+            Protocol = protocol;
+            Selector = selector;
+            Usage = usage;
+        }
+    }
+}
@@ -0,0 +1,90 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Blackdark.Netcup
+{
+    public static class ParseZoneFile
+    {
+        /// <summary>
+        /// Parses an RFC 1035 zone file (BIND format) into the records of a domain, ready to be used as records of a DNSZoneRecords resource. SOA and apex NS records are skipped, as Netcup manages them, and record TTLs are ignored
+        /// </summary>
+        public static Task<ParseZoneFileResult> InvokeAsync(ParseZoneFileArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<ParseZoneFileResult>("netcup:index:parseZoneFile", args ?? new ParseZoneFileArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Parses an RFC 1035 zone file (BIND format) into the records of a domain, ready to be used as records of a DNSZoneRecords resource. SOA and apex NS records are skipped, as Netcup manages them, and record TTLs are ignored
+        /// </summary>
+        public static Output<ParseZoneFileResult> Invoke(ParseZoneFileInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<ParseZoneFileResult>("netcup:index:parseZoneFile", args ?? new ParseZoneFileInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Parses an RFC 1035 zone file (BIND format) into the records of a domain, ready to be used as records of a DNSZoneRecords resource. SOA and apex NS records are skipped, as Netcup manages them, and record TTLs are ignored
+        /// </summary>
+        public static Output<ParseZoneFileResult> Invoke(ParseZoneFileInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<ParseZoneFileResult>("netcup:index:parseZoneFile", args ?? new ParseZoneFileInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class ParseZoneFileArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The domain name the zone file belongs to. It is the initial $ORIGIN
+        /// </summary>
+        [Input("domain", required: true)]
+        public string Domain { get; set; } = null!;
+
+        /// <summary>
+        /// The content of the zone file
+        /// </summary>
+        [Input("zoneFile", required: true)]
+        public string ZoneFile { get; set; } = null!;
+
+        public ParseZoneFileArgs()
+        {
+        }
+        public static new ParseZoneFileArgs Empty => new ParseZoneFileArgs();
+    }
+
+    public sealed class ParseZoneFileInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The domain name the zone file belongs to. It is the initial $ORIGIN
+        /// </summary>
+        [Input("domain", required: true)]
+        public Input<string> Domain { get; set; } = null!;
+
+        /// <summary>
+        /// The content of the zone file
+        /// </summary>
+        [Input("zoneFile", required: true)]
+        public Input<string> ZoneFile { get; set; } = null!;
+
+        public ParseZoneFileInvokeArgs()
+        {
+        }
+        public static new ParseZoneFileInvokeArgs Empty => new ParseZoneFileInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class ParseZoneFileResult
+    {
+        /// <summary>
+        /// The records of the zone file, in the order they are defined
+        /// </summary>
+        public readonly ImmutableArray<Outputs.DNSZoneRecord> Records;
+
+        [OutputConstructor]
+        private ParseZoneFileResult(ImmutableArray<Outputs.DNSZoneRecord> records)
+        {
+            Records = records;
+        }
+    }
+}
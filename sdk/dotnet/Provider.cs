@@ -14,22 +14,52 @@ namespace Blackdark.Netcup
     public partial class Provider : global::Pulumi.ProviderResource
     {
         /// <summary>
-        /// The Netcup API key for authentication
+        /// The Netcup API key for authentication. Can also be set with NETCUP_API_KEY
         /// </summary>
         [Output("apiKey")]
-        public Output<string> ApiKey { get; private set; } = null!;
+        public Output<string?> ApiKey { get; private set; } = null!;
 
         /// <summary>
-        /// The Netcup API password for authentication
+        /// The Netcup API password for authentication. Can also be set with NETCUP_API_PASSWORD
         /// </summary>
         [Output("apiPassword")]
-        public Output<string> ApiPassword { get; private set; } = null!;
+        public Output<string?> ApiPassword { get; private set; } = null!;
 
         /// <summary>
-        /// The Netcup customer ID
+        /// Path to a PEM file with additional CA certificates trusted for API requests
+        /// </summary>
+        [Output("caBundle")]
+        public Output<string?> CaBundle { get; private set; } = null!;
+
+        /// <summary>
+        /// The Netcup customer ID. Can also be set with NETCUP_CUSTOMER_ID
         /// </summary>
         [Output("customerId")]
-        public Output<string> CustomerId { get; private set; } = null!;
+        public Output<string?> CustomerId { get; private set; } = null!;
+
+        /// <summary>
+        /// The URL of the Netcup CCP JSON API. Defaults to https://ccp.netcup.net/run/webservice/servers/endpoint.php?JSON
+        /// </summary>
+        [Output("endpoint")]
+        public Output<string?> Endpoint { get; private set; } = null!;
+
+        /// <summary>
+        /// The nameserver (host:port) DNSKEY records of DNSSEC signed zones are read from. Defaults to root-dns.netcup.net:53
+        /// </summary>
+        [Output("nameserver")]
+        public Output<string?> Nameserver { get; private set; } = null!;
+
+        /// <summary>
+        /// The URL of an HTTP proxy for API requests. Defaults to the HTTPS_PROXY and NO_PROXY environment variables
+        /// </summary>
+        [Output("proxyUrl")]
+        public Output<string?> ProxyUrl { get; private set; } = null!;
+
+        /// <summary>
+        /// The User-Agent header sent with API requests
+        /// </summary>
+        [Output("userAgent")]
+        public Output<string?> UserAgent { get; private set; } = null!;
 
 
         /// <summary>
@@ -39,7 +69,7 @@ namespace Blackdark.Netcup
         /// <param name="name">The unique name of the resource</param>
         /// <param name="args">The arguments used to populate this resource's properties</param>
         /// <param name="options">A bag of options that control this resource's behavior</param>
-        public Provider(string name, ProviderArgs args, CustomResourceOptions? options = null)
+        public Provider(string name, ProviderArgs? args = null, CustomResourceOptions? options = null)
             : base("netcup", name, args ?? new ProviderArgs(), MakeResourceOptions(options, ""))
         {
         }
@@ -64,11 +94,11 @@ namespace Blackdark.Netcup
 
     public sealed class ProviderArgs : global::Pulumi.ResourceArgs
     {
-        [Input("apiKey", required: true)]
+        [Input("apiKey")]
         private Input<string>? _apiKey;
 
         /// <summary>
-        /// The Netcup API key for authentication
+        /// The Netcup API key for authentication. Can also be set with NETCUP_API_KEY
         /// </summary>
         public Input<string>? ApiKey
         {
@@ -80,11 +110,11 @@ namespace Blackdark.Netcup
             }
         }
 
-        [Input("apiPassword", required: true)]
+        [Input("apiPassword")]
         private Input<string>? _apiPassword;
 
         /// <summary>
-        /// The Netcup API password for authentication
+        /// The Netcup API password for authentication. Can also be set with NETCUP_API_PASSWORD
         /// </summary>
         public Input<string>? ApiPassword
         {
@@ -97,13 +127,64 @@ namespace Blackdark.Netcup
         }
 
         /// <summary>
-        /// The Netcup customer ID
+        /// Path to a PEM file with additional CA certificates trusted for API requests
+        /// </summary>
+        [Input("caBundle")]
+        public Input<string>? CaBundle { get; set; }
+
+        /// <summary>
+        /// The Netcup customer ID. Can also be set with NETCUP_CUSTOMER_ID
+        /// </summary>
+        [Input("customerId")]
+        public Input<string>? CustomerId { get; set; }
+
+        /// <summary>
+        /// The URL of the Netcup CCP JSON API. Defaults to https://ccp.netcup.net/run/webservice/servers/endpoint.php?JSON
+        /// </summary>
+        [Input("endpoint")]
+        public Input<string>? Endpoint { get; set; }
+
+        /// <summary>
+        /// How often a rate-limited (status 2057) or transiently failed API request is retried. Defaults to 5
+        /// </summary>
+        [Input("maxRetries", json: true)]
+        public Input<int>? MaxRetries { get; set; }
+
+        /// <summary>
+        /// The nameserver (host:port) DNSKEY records of DNSSEC signed zones are read from. Defaults to root-dns.netcup.net:53
+        /// </summary>
+        [Input("nameserver")]
+        public Input<string>? Nameserver { get; set; }
+
+        /// <summary>
+        /// The URL of an HTTP proxy for API requests. Defaults to the HTTPS_PROXY and NO_PROXY environment variables
+        /// </summary>
+        [Input("proxyUrl")]
+        public Input<string>? ProxyUrl { get; set; }
+
+        /// <summary>
+        /// The timeout of a single API request in seconds. Defaults to 30
+        /// </summary>
+        [Input("requestTimeout", json: true)]
+        public Input<int>? RequestTimeout { get; set; }
+
+        /// <summary>
+        /// The maximum number of Netcup API requests per minute made by the provider. The limit is shared by all provider instances in the process. Defaults to 180
+        /// </summary>
+        [Input("requestsPerMinute", json: true)]
+        public Input<int>? RequestsPerMinute { get; set; }
+
+        /// <summary>
+        /// The User-Agent header sent with API requests
         /// </summary>
-        [Input("customerId", required: true)]
-        public Input<string> CustomerId { get; set; } = null!;
+        [Input("userAgent")]
+        public Input<string>? UserAgent { get; set; }
 
         public ProviderArgs()
         {
+            ApiKey = Utilities.GetEnv("NETCUP_API_KEY");
+            ApiPassword = Utilities.GetEnv("NETCUP_API_PASSWORD");
+            CustomerId = Utilities.GetEnv("NETCUP_CUSTOMER_ID");
         }
         public static new ProviderArgs Empty => new ProviderArgs();
     }
@@ -11,17 +11,81 @@ import (
 
 var _ = internal.GetEnvOrDefault
 
-// The Netcup API key for authentication
+// The Netcup API key for authentication. Can also be set with NETCUP_API_KEY
 func GetApiKey(ctx *pulumi.Context) string {
-	return config.Get(ctx, "netcup:apiKey")
+	v, err := config.Try(ctx, "netcup:apiKey")
+	if err == nil {
+		return v
+	}
+	var value string
+	if d := internal.GetEnvOrDefault(nil, nil, "NETCUP_API_KEY"); d != nil {
+		value = d.(string)
+	}
+	return value
 }
 
-// The Netcup API password for authentication
+// The Netcup API password for authentication. Can also be set with NETCUP_API_PASSWORD
 func GetApiPassword(ctx *pulumi.Context) string {
-	return config.Get(ctx, "netcup:apiPassword")
+	v, err := config.Try(ctx, "netcup:apiPassword")
+	if err == nil {
+		return v
+	}
+	var value string
+	if d := internal.GetEnvOrDefault(nil, nil, "NETCUP_API_PASSWORD"); d != nil {
+		value = d.(string)
+	}
+	return value
 }
 
-// The Netcup customer ID
+// Path to a PEM file with additional CA certificates trusted for API requests
+func GetCaBundle(ctx *pulumi.Context) string {
+	return config.Get(ctx, "netcup:caBundle")
+}
+
+// The Netcup customer ID. Can also be set with NETCUP_CUSTOMER_ID
 func GetCustomerId(ctx *pulumi.Context) string {
-	return config.Get(ctx, "netcup:customerId")
+	v, err := config.Try(ctx, "netcup:customerId")
+	if err == nil {
+		return v
+	}
+	var value string
+	if d := internal.GetEnvOrDefault(nil, nil, "NETCUP_CUSTOMER_ID"); d != nil {
+		value = d.(string)
+	}
+	return value
+}
+
+// The URL of the Netcup CCP JSON API. Defaults to https://ccp.netcup.net/run/webservice/servers/endpoint.php?JSON
+func GetEndpoint(ctx *pulumi.Context) string {
+	return config.Get(ctx, "netcup:endpoint")
+}
+
+// How often a rate-limited (status 2057) or transiently failed API request is retried. Defaults to 5
+func GetMaxRetries(ctx *pulumi.Context) int {
+	return config.GetInt(ctx, "netcup:maxRetries")
+}
+
+// The nameserver (host:port) DNSKEY records of DNSSEC signed zones are read from. Defaults to root-dns.netcup.net:53
+func GetNameserver(ctx *pulumi.Context) string {
+	return config.Get(ctx, "netcup:nameserver")
+}
+
+// The URL of an HTTP proxy for API requests. Defaults to the HTTPS_PROXY and NO_PROXY environment variables
+func GetProxyUrl(ctx *pulumi.Context) string {
+	return config.Get(ctx, "netcup:proxyUrl")
+}
+
+// The timeout of a single API request in seconds. Defaults to 30
+func GetRequestTimeout(ctx *pulumi.Context) int {
+	return config.GetInt(ctx, "netcup:requestTimeout")
+}
+
+// The maximum number of Netcup API requests per minute made by the provider. The limit is shared by all provider instances in the process. Defaults to 180
+func GetRequestsPerMinute(ctx *pulumi.Context) int {
+	return config.GetInt(ctx, "netcup:requestsPerMinute")
+}
+
+// The User-Agent header sent with API requests
+func GetUserAgent(ctx *pulumi.Context) string {
+	return config.Get(ctx, "netcup:userAgent")
 }
@@ -12,24 +12,34 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
-// A DNS record managed by Netcup DNS service
+// A DNS record managed by Netcup DNS service. Existing records are imported with the ID 'domain:recordID' or by content with 'domain/name/type' or 'domain/name/type/value'
 type DNSRecord struct {
 	pulumi.CustomResourceState
 
+	// The structured inputs of a CAA record
+	Caa CAARecordPtrOutput `pulumi:"caa"`
 	// The domain name for the DNS record
 	Domain pulumi.StringOutput `pulumi:"domain"`
 	// The fully qualified domain name
 	Fqdn pulumi.StringOutput `pulumi:"fqdn"`
 	// The hostname for the DNS record
-	Name pulumi.StringOutput `pulumi:"name"`
+	Name pulumi.StringPtrOutput `pulumi:"name"`
+	// The inputs the OPENPGPKEY record is derived from
+	Openpgpkey OPENPGPKEYRecordPtrOutput `pulumi:"openpgpkey"`
 	// The priority for the DNS record
 	Priority pulumi.StringPtrOutput `pulumi:"priority"`
 	// The unique identifier for the DNS record
 	RecordId pulumi.StringOutput `pulumi:"recordId"`
+	// The inputs the SMIMEA record is derived from
+	Smimea SMIMEARecordPtrOutput `pulumi:"smimea"`
+	// The structured inputs of an SRV record
+	Srv SRVRecordPtrOutput `pulumi:"srv"`
+	// The inputs the TLSA record is derived from
+	Tlsa TLSARecordPtrOutput `pulumi:"tlsa"`
 	// The DNS record type
 	Type pulumi.StringOutput `pulumi:"type"`
 	// The value/destination for the DNS record
-	Value pulumi.StringOutput `pulumi:"value"`
+	Value pulumi.StringPtrOutput `pulumi:"value"`
 }
 
 // NewDNSRecord registers a new resource with the given unique name, arguments, and options.
@@ -42,14 +52,20 @@ func NewDNSRecord(ctx *pulumi.Context,
 	if args.Domain == nil {
 		return nil, errors.New("invalid value for required argument 'Domain'")
 	}
-	if args.Name == nil {
-		return nil, errors.New("invalid value for required argument 'Name'")
-	}
 	if args.Type == nil {
 		return nil, errors.New("invalid value for required argument 'Type'")
 	}
-	if args.Value == nil {
-		return nil, errors.New("invalid value for required argument 'Value'")
+	if args.Caa != nil {
+		args.Caa = args.Caa.ToCAARecordPtrOutput().ApplyT(func(v *CAARecord) *CAARecord { return v.Defaults() }).(CAARecordPtrOutput)
+	}
+	if args.Smimea != nil {
+		args.Smimea = args.Smimea.ToSMIMEARecordPtrOutput().ApplyT(func(v *SMIMEARecord) *SMIMEARecord { return v.Defaults() }).(SMIMEARecordPtrOutput)
+	}
+	if args.Srv != nil {
+		args.Srv = args.Srv.ToSRVRecordPtrOutput().ApplyT(func(v *SRVRecord) *SRVRecord { return v.Defaults() }).(SRVRecordPtrOutput)
+	}
+	if args.Tlsa != nil {
+		args.Tlsa = args.Tlsa.ToTLSARecordPtrOutput().ApplyT(func(v *TLSARecord) *TLSARecord { return v.Defaults() }).(TLSARecordPtrOutput)
 	}
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource DNSRecord
@@ -84,30 +100,50 @@ func (DNSRecordState) ElementType() reflect.Type {
 }
 
 type dnsrecordArgs struct {
+	// Structured inputs of a CAA record. The value is composed as 'flags tag "value"'
+	Caa *CAARecord `pulumi:"caa"`
 	// The domain name for the DNS record (e.g., 'example.com')
 	Domain string `pulumi:"domain"`
-	// The hostname for the DNS record. Use '@' for root domain, or specify subdomain (e.g., 'www', 'mail')
-	Name string `pulumi:"name"`
+	// The hostname for the DNS record. Use '@' for root domain, or specify subdomain (e.g., 'www', 'mail'). Defaults to '@', for openpgpkey and smimea records to the name derived from the email address
+	Name *string `pulumi:"name"`
+	// Inputs of an OPENPGPKEY record publishing the key of an email address. The name is derived from the hashed local part below '_openpgpkey' and the value is the base64 encoded key
+	Openpgpkey *OPENPGPKEYRecord `pulumi:"openpgpkey"`
 	// The priority for MX and SRV records (required for these types, ignored for others)
 	Priority *string `pulumi:"priority"`
+	// Inputs of an SMIMEA record publishing the S/MIME certificate of an email address. The name is derived from the hashed local part below '_smimecert'
+	Smimea *SMIMEARecord `pulumi:"smimea"`
+	// Structured inputs of an SRV record. The value is composed as 'weight port target' and the name is placed below '_service._proto', using '@' for the service of the domain itself
+	Srv *SRVRecord `pulumi:"srv"`
+	// Inputs of a TLSA record derived from a certificate or public key. The association data is computed from the certificate and the name is placed below '_port._proto'
+	Tlsa *TLSARecord `pulumi:"tlsa"`
 	// The DNS record type. Supported types: A, AAAA, CNAME, MX, TXT, SRV, CAA, TLSA, NS, DS, OPENPGPKEY, SMIMEA, SSHFP
 	Type string `pulumi:"type"`
-	// The value/destination for the DNS record (e.g., IP address for A records, hostname for CNAME)
-	Value string `pulumi:"value"`
+	// The value/destination for the DNS record (e.g., IP address for A records, hostname for CNAME). Required unless the value is composed from structured inputs such as srv
+	Value *string `pulumi:"value"`
 }
 
 // The set of arguments for constructing a DNSRecord resource.
 type DNSRecordArgs struct {
+	// Structured inputs of a CAA record. The value is composed as 'flags tag "value"'
+	Caa CAARecordPtrInput
 	// The domain name for the DNS record (e.g., 'example.com')
 	Domain pulumi.StringInput
-	// The hostname for the DNS record. Use '@' for root domain, or specify subdomain (e.g., 'www', 'mail')
-	Name pulumi.StringInput
+	// The hostname for the DNS record. Use '@' for root domain, or specify subdomain (e.g., 'www', 'mail'). Defaults to '@', for openpgpkey and smimea records to the name derived from the email address
+	Name pulumi.StringPtrInput
+	// Inputs of an OPENPGPKEY record publishing the key of an email address. The name is derived from the hashed local part below '_openpgpkey' and the value is the base64 encoded key
+	Openpgpkey OPENPGPKEYRecordPtrInput
 	// The priority for MX and SRV records (required for these types, ignored for others)
 	Priority pulumi.StringPtrInput
+	// Inputs of an SMIMEA record publishing the S/MIME certificate of an email address. The name is derived from the hashed local part below '_smimecert'
+	Smimea SMIMEARecordPtrInput
+	// Structured inputs of an SRV record. The value is composed as 'weight port target' and the name is placed below '_service._proto', using '@' for the service of the domain itself
+	Srv SRVRecordPtrInput
+	// Inputs of a TLSA record derived from a certificate or public key. The association data is computed from the certificate and the name is placed below '_port._proto'
+	Tlsa TLSARecordPtrInput
 	// The DNS record type. Supported types: A, AAAA, CNAME, MX, TXT, SRV, CAA, TLSA, NS, DS, OPENPGPKEY, SMIMEA, SSHFP
 	Type pulumi.StringInput
-	// The value/destination for the DNS record (e.g., IP address for A records, hostname for CNAME)
-	Value pulumi.StringInput
+	// The value/destination for the DNS record (e.g., IP address for A records, hostname for CNAME). Required unless the value is composed from structured inputs such as srv
+	Value pulumi.StringPtrInput
 }
 
 func (DNSRecordArgs) ElementType() reflect.Type {
@@ -197,6 +233,11 @@ func (o DNSRecordOutput) ToDNSRecordOutputWithContext(ctx context.Context) DNSRe
 	return o
 }
 
+// The structured inputs of a CAA record
+func (o DNSRecordOutput) Caa() CAARecordPtrOutput {
+	return o.ApplyT(func(v *DNSRecord) CAARecordPtrOutput { return v.Caa }).(CAARecordPtrOutput)
+}
+
 // The domain name for the DNS record
 func (o DNSRecordOutput) Domain() pulumi.StringOutput {
 	return o.ApplyT(func(v *DNSRecord) pulumi.StringOutput { return v.Domain }).(pulumi.StringOutput)
@@ -208,8 +249,13 @@ func (o DNSRecordOutput) Fqdn() pulumi.StringOutput {
 }
 
 // The hostname for the DNS record
-func (o DNSRecordOutput) Name() pulumi.StringOutput {
-	return o.ApplyT(func(v *DNSRecord) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
+func (o DNSRecordOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *DNSRecord) pulumi.StringPtrOutput { return v.Name }).(pulumi.StringPtrOutput)
+}
+
+// The inputs the OPENPGPKEY record is derived from
+func (o DNSRecordOutput) Openpgpkey() OPENPGPKEYRecordPtrOutput {
+	return o.ApplyT(func(v *DNSRecord) OPENPGPKEYRecordPtrOutput { return v.Openpgpkey }).(OPENPGPKEYRecordPtrOutput)
 }
 
 // The priority for the DNS record
@@ -222,14 +268,29 @@ func (o DNSRecordOutput) RecordId() pulumi.StringOutput {
 	return o.ApplyT(func(v *DNSRecord) pulumi.StringOutput { return v.RecordId }).(pulumi.StringOutput)
 }
 
+// The inputs the SMIMEA record is derived from
+func (o DNSRecordOutput) Smimea() SMIMEARecordPtrOutput {
+	return o.ApplyT(func(v *DNSRecord) SMIMEARecordPtrOutput { return v.Smimea }).(SMIMEARecordPtrOutput)
+}
+
+// The structured inputs of an SRV record
+func (o DNSRecordOutput) Srv() SRVRecordPtrOutput {
+	return o.ApplyT(func(v *DNSRecord) SRVRecordPtrOutput { return v.Srv }).(SRVRecordPtrOutput)
+}
+
+// The inputs the TLSA record is derived from
+func (o DNSRecordOutput) Tlsa() TLSARecordPtrOutput {
+	return o.ApplyT(func(v *DNSRecord) TLSARecordPtrOutput { return v.Tlsa }).(TLSARecordPtrOutput)
+}
+
 // The DNS record type
 func (o DNSRecordOutput) Type() pulumi.StringOutput {
 	return o.ApplyT(func(v *DNSRecord) pulumi.StringOutput { return v.Type }).(pulumi.StringOutput)
 }
 
 // The value/destination for the DNS record
-func (o DNSRecordOutput) Value() pulumi.StringOutput {
-	return o.ApplyT(func(v *DNSRecord) pulumi.StringOutput { return v.Value }).(pulumi.StringOutput)
+func (o DNSRecordOutput) Value() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *DNSRecord) pulumi.StringPtrOutput { return v.Value }).(pulumi.StringPtrOutput)
 }
 
 type DNSRecordArrayOutput struct{ *pulumi.OutputState }
@@ -0,0 +1,279 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package puluminetcup
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/blackdark/pulumi-netcup/sdk/go/pulumi-netcup/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// All DNS records of one hostname and type, such as round-robin A records, several MX hosts or multiple TXT values. Records of the hostname and type that are not listed are deleted
+type DNSRecordSet struct {
+	pulumi.CustomResourceState
+
+	// The domain name for the DNS records
+	Domain pulumi.StringOutput `pulumi:"domain"`
+	// The fully qualified domain name
+	Fqdn pulumi.StringOutput `pulumi:"fqdn"`
+	// The hostname of the DNS records
+	Name pulumi.StringOutput `pulumi:"name"`
+	// The Netcup IDs of the records, in the order of values
+	RecordIds pulumi.StringArrayOutput `pulumi:"recordIds"`
+	// The host keys the values of an SSHFP record set are derived from
+	Sshfp SSHFPRecordSetPtrOutput `pulumi:"sshfp"`
+	// The DNS record type
+	Type pulumi.StringOutput `pulumi:"type"`
+	// The values of the records
+	Values DNSRecordSetValueArrayOutput `pulumi:"values"`
+}
+
+// NewDNSRecordSet registers a new resource with the given unique name, arguments, and options.
+func NewDNSRecordSet(ctx *pulumi.Context,
+	name string, args *DNSRecordSetArgs, opts ...pulumi.ResourceOption) (*DNSRecordSet, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Domain == nil {
+		return nil, errors.New("invalid value for required argument 'Domain'")
+	}
+	if args.Name == nil {
+		return nil, errors.New("invalid value for required argument 'Name'")
+	}
+	if args.Type == nil {
+		return nil, errors.New("invalid value for required argument 'Type'")
+	}
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource DNSRecordSet
+	err := ctx.RegisterResource("netcup:index:DNSRecordSet", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetDNSRecordSet gets an existing DNSRecordSet resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetDNSRecordSet(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *DNSRecordSetState, opts ...pulumi.ResourceOption) (*DNSRecordSet, error) {
+	var resource DNSRecordSet
+	err := ctx.ReadResource("netcup:index:DNSRecordSet", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering DNSRecordSet resources.
+type dnsrecordSetState struct {
+}
+
+type DNSRecordSetState struct {
+}
+
+func (DNSRecordSetState) ElementType() reflect.Type {
+	return reflect.TypeOf((*dnsrecordSetState)(nil)).Elem()
+}
+
+type dnsrecordSetArgs struct {
+	// The domain name for the DNS records (e.g., 'example.com')
+	Domain string `pulumi:"domain"`
+	// The hostname of the DNS records. Use '@' for root domain
+	Name string `pulumi:"name"`
+	// Host keys the values of an SSHFP record set are derived from, a SHA-1 and a SHA-256 record per key
+	Sshfp *SSHFPRecordSet `pulumi:"sshfp"`
+	// The DNS record type. Supported types: A, AAAA, CNAME, MX, TXT, SRV, CAA, TLSA, NS, DS, OPENPGPKEY, SMIMEA, SSHFP
+	Type string `pulumi:"type"`
+	// The values of the records, one record per value. Required unless sshfp is set
+	Values []DNSRecordSetValue `pulumi:"values"`
+}
+
+// The set of arguments for constructing a DNSRecordSet resource.
+type DNSRecordSetArgs struct {
+	// The domain name for the DNS records (e.g., 'example.com')
+	Domain pulumi.StringInput
+	// The hostname of the DNS records. Use '@' for root domain
+	Name pulumi.StringInput
+	// Host keys the values of an SSHFP record set are derived from, a SHA-1 and a SHA-256 record per key
+	Sshfp SSHFPRecordSetPtrInput
+	// The DNS record type. Supported types: A, AAAA, CNAME, MX, TXT, SRV, CAA, TLSA, NS, DS, OPENPGPKEY, SMIMEA, SSHFP
+	Type pulumi.StringInput
+	// The values of the records, one record per value. Required unless sshfp is set
+	Values DNSRecordSetValueArrayInput
+}
+
+func (DNSRecordSetArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*dnsrecordSetArgs)(nil)).Elem()
+}
+
+type DNSRecordSetInput interface {
+	pulumi.Input
+
+	ToDNSRecordSetOutput() DNSRecordSetOutput
+	ToDNSRecordSetOutputWithContext(ctx context.Context) DNSRecordSetOutput
+}
+
+func (*DNSRecordSet) ElementType() reflect.Type {
+	return reflect.TypeOf((**DNSRecordSet)(nil)).Elem()
+}
+
+func (i *DNSRecordSet) ToDNSRecordSetOutput() DNSRecordSetOutput {
+	return i.ToDNSRecordSetOutputWithContext(context.Background())
+}
+
+func (i *DNSRecordSet) ToDNSRecordSetOutputWithContext(ctx context.Context) DNSRecordSetOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DNSRecordSetOutput)
+}
+
+// DNSRecordSetArrayInput is an input type that accepts DNSRecordSetArray and DNSRecordSetArrayOutput values.
+// You can construct a concrete instance of `DNSRecordSetArrayInput` via:
+//
+//	DNSRecordSetArray{ DNSRecordSetArgs{...} }
+type DNSRecordSetArrayInput interface {
+	pulumi.Input
+
+	ToDNSRecordSetArrayOutput() DNSRecordSetArrayOutput
+	ToDNSRecordSetArrayOutputWithContext(context.Context) DNSRecordSetArrayOutput
+}
+
+type DNSRecordSetArray []DNSRecordSetInput
+
+func (DNSRecordSetArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*DNSRecordSet)(nil)).Elem()
+}
+
+func (i DNSRecordSetArray) ToDNSRecordSetArrayOutput() DNSRecordSetArrayOutput {
+	return i.ToDNSRecordSetArrayOutputWithContext(context.Background())
+}
+
+func (i DNSRecordSetArray) ToDNSRecordSetArrayOutputWithContext(ctx context.Context) DNSRecordSetArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DNSRecordSetArrayOutput)
+}
+
+// DNSRecordSetMapInput is an input type that accepts DNSRecordSetMap and DNSRecordSetMapOutput values.
+// You can construct a concrete instance of `DNSRecordSetMapInput` via:
+//
+//	DNSRecordSetMap{ "key": DNSRecordSetArgs{...} }
+type DNSRecordSetMapInput interface {
+	pulumi.Input
+
+	ToDNSRecordSetMapOutput() DNSRecordSetMapOutput
+	ToDNSRecordSetMapOutputWithContext(context.Context) DNSRecordSetMapOutput
+}
+
+type DNSRecordSetMap map[string]DNSRecordSetInput
+
+func (DNSRecordSetMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*DNSRecordSet)(nil)).Elem()
+}
+
+func (i DNSRecordSetMap) ToDNSRecordSetMapOutput() DNSRecordSetMapOutput {
+	return i.ToDNSRecordSetMapOutputWithContext(context.Background())
+}
+
+func (i DNSRecordSetMap) ToDNSRecordSetMapOutputWithContext(ctx context.Context) DNSRecordSetMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DNSRecordSetMapOutput)
+}
+
+type DNSRecordSetOutput struct{ *pulumi.OutputState }
+
+func (DNSRecordSetOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**DNSRecordSet)(nil)).Elem()
+}
+
+func (o DNSRecordSetOutput) ToDNSRecordSetOutput() DNSRecordSetOutput {
+	return o
+}
+
+func (o DNSRecordSetOutput) ToDNSRecordSetOutputWithContext(ctx context.Context) DNSRecordSetOutput {
+	return o
+}
+
+// The domain name for the DNS records
+func (o DNSRecordSetOutput) Domain() pulumi.StringOutput {
+	return o.ApplyT(func(v *DNSRecordSet) pulumi.StringOutput { return v.Domain }).(pulumi.StringOutput)
+}
+
+// The fully qualified domain name
+func (o DNSRecordSetOutput) Fqdn() pulumi.StringOutput {
+	return o.ApplyT(func(v *DNSRecordSet) pulumi.StringOutput { return v.Fqdn }).(pulumi.StringOutput)
+}
+
+// The hostname of the DNS records
+func (o DNSRecordSetOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v *DNSRecordSet) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
+}
+
+// The Netcup IDs of the records, in the order of values
+func (o DNSRecordSetOutput) RecordIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *DNSRecordSet) pulumi.StringArrayOutput { return v.RecordIds }).(pulumi.StringArrayOutput)
+}
+
+// The host keys the values of an SSHFP record set are derived from
+func (o DNSRecordSetOutput) Sshfp() SSHFPRecordSetPtrOutput {
+	return o.ApplyT(func(v *DNSRecordSet) SSHFPRecordSetPtrOutput { return v.Sshfp }).(SSHFPRecordSetPtrOutput)
+}
+
+// The DNS record type
+func (o DNSRecordSetOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v *DNSRecordSet) pulumi.StringOutput { return v.Type }).(pulumi.StringOutput)
+}
+
+// The values of the records
+func (o DNSRecordSetOutput) Values() DNSRecordSetValueArrayOutput {
+	return o.ApplyT(func(v *DNSRecordSet) DNSRecordSetValueArrayOutput { return v.Values }).(DNSRecordSetValueArrayOutput)
+}
+
+type DNSRecordSetArrayOutput struct{ *pulumi.OutputState }
+
+func (DNSRecordSetArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*DNSRecordSet)(nil)).Elem()
+}
+
+func (o DNSRecordSetArrayOutput) ToDNSRecordSetArrayOutput() DNSRecordSetArrayOutput {
+	return o
+}
+
+func (o DNSRecordSetArrayOutput) ToDNSRecordSetArrayOutputWithContext(ctx context.Context) DNSRecordSetArrayOutput {
+	return o
+}
+
+func (o DNSRecordSetArrayOutput) Index(i pulumi.IntInput) DNSRecordSetOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *DNSRecordSet {
+		return vs[0].([]*DNSRecordSet)[vs[1].(int)]
+	}).(DNSRecordSetOutput)
+}
+
+type DNSRecordSetMapOutput struct{ *pulumi.OutputState }
+
+func (DNSRecordSetMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*DNSRecordSet)(nil)).Elem()
+}
+
+func (o DNSRecordSetMapOutput) ToDNSRecordSetMapOutput() DNSRecordSetMapOutput {
+	return o
+}
+
+func (o DNSRecordSetMapOutput) ToDNSRecordSetMapOutputWithContext(ctx context.Context) DNSRecordSetMapOutput {
+	return o
+}
+
+func (o DNSRecordSetMapOutput) MapIndex(k pulumi.StringInput) DNSRecordSetOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *DNSRecordSet {
+		return vs[0].(map[string]*DNSRecordSet)[vs[1].(string)]
+	}).(DNSRecordSetOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*DNSRecordSetInput)(nil)).Elem(), &DNSRecordSet{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DNSRecordSetArrayInput)(nil)).Elem(), DNSRecordSetArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DNSRecordSetMapInput)(nil)).Elem(), DNSRecordSetMap{})
+	pulumi.RegisterOutputType(DNSRecordSetOutput{})
+	pulumi.RegisterOutputType(DNSRecordSetArrayOutput{})
+	pulumi.RegisterOutputType(DNSRecordSetMapOutput{})
+}
@@ -0,0 +1,291 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package puluminetcup
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/blackdark/pulumi-netcup/sdk/go/pulumi-netcup/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// The settings of a DNS zone managed by Netcup DNS service. The zone of the domain must already exist; creating the resource adopts it and deleting the resource leaves the zone and its settings untouched
+type DNSZone struct {
+	pulumi.CustomResourceState
+
+	// The DNSKEY records of the signed zone in presentation format. Empty while DNSSEC is disabled or Netcup has not finished signing the zone
+	Dnskeys pulumi.StringArrayOutput `pulumi:"dnskeys"`
+	// Whether the zone is signed with DNSSEC
+	Dnssec pulumi.BoolPtrOutput `pulumi:"dnssec"`
+	// The domain name of the zone
+	Domain pulumi.StringOutput `pulumi:"domain"`
+	// The SHA-256 DS records of the key signing keys, to be published in the parent zone or at the registrar
+	DsRecords pulumi.StringArrayOutput `pulumi:"dsRecords"`
+	// The SOA expire time in seconds
+	Expire pulumi.IntPtrOutput `pulumi:"expire"`
+	// The SOA refresh interval in seconds
+	Refresh pulumi.IntPtrOutput `pulumi:"refresh"`
+	// The SOA retry interval in seconds
+	Retry pulumi.IntPtrOutput `pulumi:"retry"`
+	// The current SOA serial of the zone
+	Serial pulumi.StringOutput `pulumi:"serial"`
+	// The default TTL of the zone in seconds
+	Ttl pulumi.IntPtrOutput `pulumi:"ttl"`
+}
+
+// NewDNSZone registers a new resource with the given unique name, arguments, and options.
+func NewDNSZone(ctx *pulumi.Context,
+	name string, args *DNSZoneArgs, opts ...pulumi.ResourceOption) (*DNSZone, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Domain == nil {
+		return nil, errors.New("invalid value for required argument 'Domain'")
+	}
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource DNSZone
+	err := ctx.RegisterResource("netcup:index:DNSZone", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetDNSZone gets an existing DNSZone resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetDNSZone(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *DNSZoneState, opts ...pulumi.ResourceOption) (*DNSZone, error) {
+	var resource DNSZone
+	err := ctx.ReadResource("netcup:index:DNSZone", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering DNSZone resources.
+type dnszoneState struct {
+}
+
+type DNSZoneState struct {
+}
+
+func (DNSZoneState) ElementType() reflect.Type {
+	return reflect.TypeOf((*dnszoneState)(nil)).Elem()
+}
+
+type dnszoneArgs struct {
+	// Whether the zone is signed with DNSSEC. Left unchanged when not set
+	Dnssec *bool `pulumi:"dnssec"`
+	// The domain name of the zone (e.g., 'example.com')
+	Domain string `pulumi:"domain"`
+	// The SOA expire time in seconds (RFC 1912 recommends at least 604800). Left unchanged when not set
+	Expire *int `pulumi:"expire"`
+	// The SOA refresh interval in seconds (RFC 1912 recommends at least 1200). Left unchanged when not set
+	Refresh *int `pulumi:"refresh"`
+	// The SOA retry interval in seconds (RFC 1912 recommends at least 180). Left unchanged when not set
+	Retry *int `pulumi:"retry"`
+	// The default TTL of the zone in seconds (RFC 1912 recommends at least 300). Left unchanged when not set
+	Ttl *int `pulumi:"ttl"`
+}
+
+// The set of arguments for constructing a DNSZone resource.
+type DNSZoneArgs struct {
+	// Whether the zone is signed with DNSSEC. Left unchanged when not set
+	Dnssec pulumi.BoolPtrInput
+	// The domain name of the zone (e.g., 'example.com')
+	Domain pulumi.StringInput
+	// The SOA expire time in seconds (RFC 1912 recommends at least 604800). Left unchanged when not set
+	Expire pulumi.IntPtrInput
+	// The SOA refresh interval in seconds (RFC 1912 recommends at least 1200). Left unchanged when not set
+	Refresh pulumi.IntPtrInput
+	// The SOA retry interval in seconds (RFC 1912 recommends at least 180). Left unchanged when not set
+	Retry pulumi.IntPtrInput
+	// The default TTL of the zone in seconds (RFC 1912 recommends at least 300). Left unchanged when not set
+	Ttl pulumi.IntPtrInput
+}
+
+func (DNSZoneArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*dnszoneArgs)(nil)).Elem()
+}
+
+type DNSZoneInput interface {
+	pulumi.Input
+
+	ToDNSZoneOutput() DNSZoneOutput
+	ToDNSZoneOutputWithContext(ctx context.Context) DNSZoneOutput
+}
+
+func (*DNSZone) ElementType() reflect.Type {
+	return reflect.TypeOf((**DNSZone)(nil)).Elem()
+}
+
+func (i *DNSZone) ToDNSZoneOutput() DNSZoneOutput {
+	return i.ToDNSZoneOutputWithContext(context.Background())
+}
+
+func (i *DNSZone) ToDNSZoneOutputWithContext(ctx context.Context) DNSZoneOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DNSZoneOutput)
+}
+
+// DNSZoneArrayInput is an input type that accepts DNSZoneArray and DNSZoneArrayOutput values.
+// You can construct a concrete instance of `DNSZoneArrayInput` via:
+//
+//	DNSZoneArray{ DNSZoneArgs{...} }
+type DNSZoneArrayInput interface {
+	pulumi.Input
+
+	ToDNSZoneArrayOutput() DNSZoneArrayOutput
+	ToDNSZoneArrayOutputWithContext(context.Context) DNSZoneArrayOutput
+}
+
+type DNSZoneArray []DNSZoneInput
+
+func (DNSZoneArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*DNSZone)(nil)).Elem()
+}
+
+func (i DNSZoneArray) ToDNSZoneArrayOutput() DNSZoneArrayOutput {
+	return i.ToDNSZoneArrayOutputWithContext(context.Background())
+}
+
+func (i DNSZoneArray) ToDNSZoneArrayOutputWithContext(ctx context.Context) DNSZoneArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DNSZoneArrayOutput)
+}
+
+// DNSZoneMapInput is an input type that accepts DNSZoneMap and DNSZoneMapOutput values.
+// You can construct a concrete instance of `DNSZoneMapInput` via:
+//
+//	DNSZoneMap{ "key": DNSZoneArgs{...} }
+type DNSZoneMapInput interface {
+	pulumi.Input
+
+	ToDNSZoneMapOutput() DNSZoneMapOutput
+	ToDNSZoneMapOutputWithContext(context.Context) DNSZoneMapOutput
+}
+
+type DNSZoneMap map[string]DNSZoneInput
+
+func (DNSZoneMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*DNSZone)(nil)).Elem()
+}
+
+func (i DNSZoneMap) ToDNSZoneMapOutput() DNSZoneMapOutput {
+	return i.ToDNSZoneMapOutputWithContext(context.Background())
+}
+
+func (i DNSZoneMap) ToDNSZoneMapOutputWithContext(ctx context.Context) DNSZoneMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DNSZoneMapOutput)
+}
+
+type DNSZoneOutput struct{ *pulumi.OutputState }
+
+func (DNSZoneOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**DNSZone)(nil)).Elem()
+}
+
+func (o DNSZoneOutput) ToDNSZoneOutput() DNSZoneOutput {
+	return o
+}
+
+func (o DNSZoneOutput) ToDNSZoneOutputWithContext(ctx context.Context) DNSZoneOutput {
+	return o
+}
+
+// The DNSKEY records of the signed zone in presentation format. Empty while DNSSEC is disabled or Netcup has not finished signing the zone
+func (o DNSZoneOutput) Dnskeys() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *DNSZone) pulumi.StringArrayOutput { return v.Dnskeys }).(pulumi.StringArrayOutput)
+}
+
+// Whether the zone is signed with DNSSEC
+func (o DNSZoneOutput) Dnssec() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *DNSZone) pulumi.BoolPtrOutput { return v.Dnssec }).(pulumi.BoolPtrOutput)
+}
+
+// The domain name of the zone
+func (o DNSZoneOutput) Domain() pulumi.StringOutput {
+	return o.ApplyT(func(v *DNSZone) pulumi.StringOutput { return v.Domain }).(pulumi.StringOutput)
+}
+
+// The SHA-256 DS records of the key signing keys, to be published in the parent zone or at the registrar
+func (o DNSZoneOutput) DsRecords() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *DNSZone) pulumi.StringArrayOutput { return v.DsRecords }).(pulumi.StringArrayOutput)
+}
+
+// The SOA expire time in seconds
+func (o DNSZoneOutput) Expire() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *DNSZone) pulumi.IntPtrOutput { return v.Expire }).(pulumi.IntPtrOutput)
+}
+
+// The SOA refresh interval in seconds
+func (o DNSZoneOutput) Refresh() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *DNSZone) pulumi.IntPtrOutput { return v.Refresh }).(pulumi.IntPtrOutput)
+}
+
+// The SOA retry interval in seconds
+func (o DNSZoneOutput) Retry() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *DNSZone) pulumi.IntPtrOutput { return v.Retry }).(pulumi.IntPtrOutput)
+}
+
+// The current SOA serial of the zone
+func (o DNSZoneOutput) Serial() pulumi.StringOutput {
+	return o.ApplyT(func(v *DNSZone) pulumi.StringOutput { return v.Serial }).(pulumi.StringOutput)
+}
+
+// The default TTL of the zone in seconds
+func (o DNSZoneOutput) Ttl() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *DNSZone) pulumi.IntPtrOutput { return v.Ttl }).(pulumi.IntPtrOutput)
+}
+
+type DNSZoneArrayOutput struct{ *pulumi.OutputState }
+
+func (DNSZoneArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*DNSZone)(nil)).Elem()
+}
+
+func (o DNSZoneArrayOutput) ToDNSZoneArrayOutput() DNSZoneArrayOutput {
+	return o
+}
+
+func (o DNSZoneArrayOutput) ToDNSZoneArrayOutputWithContext(ctx context.Context) DNSZoneArrayOutput {
+	return o
+}
+
+func (o DNSZoneArrayOutput) Index(i pulumi.IntInput) DNSZoneOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *DNSZone {
+		return vs[0].([]*DNSZone)[vs[1].(int)]
+	}).(DNSZoneOutput)
+}
+
+type DNSZoneMapOutput struct{ *pulumi.OutputState }
+
+func (DNSZoneMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*DNSZone)(nil)).Elem()
+}
+
+func (o DNSZoneMapOutput) ToDNSZoneMapOutput() DNSZoneMapOutput {
+	return o
+}
+
+func (o DNSZoneMapOutput) ToDNSZoneMapOutputWithContext(ctx context.Context) DNSZoneMapOutput {
+	return o
+}
+
+func (o DNSZoneMapOutput) MapIndex(k pulumi.StringInput) DNSZoneOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *DNSZone {
+		return vs[0].(map[string]*DNSZone)[vs[1].(string)]
+	}).(DNSZoneOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*DNSZoneInput)(nil)).Elem(), &DNSZone{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DNSZoneArrayInput)(nil)).Elem(), DNSZoneArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DNSZoneMapInput)(nil)).Elem(), DNSZoneMap{})
+	pulumi.RegisterOutputType(DNSZoneOutput{})
+	pulumi.RegisterOutputType(DNSZoneArrayOutput{})
+	pulumi.RegisterOutputType(DNSZoneMapOutput{})
+}
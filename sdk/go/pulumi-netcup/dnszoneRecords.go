@@ -0,0 +1,255 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package puluminetcup
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/blackdark/pulumi-netcup/sdk/go/pulumi-netcup/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// The complete record set of a domain managed by Netcup DNS service. Records of the domain that are not listed are deleted unless they match an ignore rule. Do not combine with DNSRecord resources for the same domain
+type DNSZoneRecords struct {
+	pulumi.CustomResourceState
+
+	// The domain name whose records are managed
+	Domain pulumi.StringOutput `pulumi:"domain"`
+	// The rules selecting records that are left alone
+	Ignore DNSRecordFilterArrayOutput `pulumi:"ignore"`
+	// The Netcup IDs of the records, in the order of records
+	RecordIds pulumi.StringArrayOutput `pulumi:"recordIds"`
+	// The records of the domain
+	Records DNSZoneRecordArrayOutput `pulumi:"records"`
+	// The zone file the records were taken from
+	ZoneFile pulumi.StringPtrOutput `pulumi:"zoneFile"`
+}
+
+// NewDNSZoneRecords registers a new resource with the given unique name, arguments, and options.
+func NewDNSZoneRecords(ctx *pulumi.Context,
+	name string, args *DNSZoneRecordsArgs, opts ...pulumi.ResourceOption) (*DNSZoneRecords, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Domain == nil {
+		return nil, errors.New("invalid value for required argument 'Domain'")
+	}
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource DNSZoneRecords
+	err := ctx.RegisterResource("netcup:index:DNSZoneRecords", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetDNSZoneRecords gets an existing DNSZoneRecords resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetDNSZoneRecords(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *DNSZoneRecordsState, opts ...pulumi.ResourceOption) (*DNSZoneRecords, error) {
+	var resource DNSZoneRecords
+	err := ctx.ReadResource("netcup:index:DNSZoneRecords", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering DNSZoneRecords resources.
+type dnszoneRecordsState struct {
+}
+
+type DNSZoneRecordsState struct {
+}
+
+func (DNSZoneRecordsState) ElementType() reflect.Type {
+	return reflect.TypeOf((*dnszoneRecordsState)(nil)).Elem()
+}
+
+type dnszoneRecordsArgs struct {
+	// The domain name whose records are managed (e.g., 'example.com')
+	Domain string `pulumi:"domain"`
+	// Existing records matching any of these rules are left alone instead of being deleted
+	Ignore []DNSRecordFilter `pulumi:"ignore"`
+	// The complete list of records the domain should have
+	Records []DNSZoneRecord `pulumi:"records"`
+	// An RFC 1035 zone file (BIND format) to take the records from instead of records. SOA and apex NS records are skipped and record TTLs are ignored
+	ZoneFile *string `pulumi:"zoneFile"`
+}
+
+// The set of arguments for constructing a DNSZoneRecords resource.
+type DNSZoneRecordsArgs struct {
+	// The domain name whose records are managed (e.g., 'example.com')
+	Domain pulumi.StringInput
+	// Existing records matching any of these rules are left alone instead of being deleted
+	Ignore DNSRecordFilterArrayInput
+	// The complete list of records the domain should have
+	Records DNSZoneRecordArrayInput
+	// An RFC 1035 zone file (BIND format) to take the records from instead of records. SOA and apex NS records are skipped and record TTLs are ignored
+	ZoneFile pulumi.StringPtrInput
+}
+
+func (DNSZoneRecordsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*dnszoneRecordsArgs)(nil)).Elem()
+}
+
+type DNSZoneRecordsInput interface {
+	pulumi.Input
+
+	ToDNSZoneRecordsOutput() DNSZoneRecordsOutput
+	ToDNSZoneRecordsOutputWithContext(ctx context.Context) DNSZoneRecordsOutput
+}
+
+func (*DNSZoneRecords) ElementType() reflect.Type {
+	return reflect.TypeOf((**DNSZoneRecords)(nil)).Elem()
+}
+
+func (i *DNSZoneRecords) ToDNSZoneRecordsOutput() DNSZoneRecordsOutput {
+	return i.ToDNSZoneRecordsOutputWithContext(context.Background())
+}
+
+func (i *DNSZoneRecords) ToDNSZoneRecordsOutputWithContext(ctx context.Context) DNSZoneRecordsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DNSZoneRecordsOutput)
+}
+
+// DNSZoneRecordsArrayInput is an input type that accepts DNSZoneRecordsArray and DNSZoneRecordsArrayOutput values.
+// You can construct a concrete instance of `DNSZoneRecordsArrayInput` via:
+//
+//	DNSZoneRecordsArray{ DNSZoneRecordsArgs{...} }
+type DNSZoneRecordsArrayInput interface {
+	pulumi.Input
+
+	ToDNSZoneRecordsArrayOutput() DNSZoneRecordsArrayOutput
+	ToDNSZoneRecordsArrayOutputWithContext(context.Context) DNSZoneRecordsArrayOutput
+}
+
+type DNSZoneRecordsArray []DNSZoneRecordsInput
+
+func (DNSZoneRecordsArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*DNSZoneRecords)(nil)).Elem()
+}
+
+func (i DNSZoneRecordsArray) ToDNSZoneRecordsArrayOutput() DNSZoneRecordsArrayOutput {
+	return i.ToDNSZoneRecordsArrayOutputWithContext(context.Background())
+}
+
+func (i DNSZoneRecordsArray) ToDNSZoneRecordsArrayOutputWithContext(ctx context.Context) DNSZoneRecordsArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DNSZoneRecordsArrayOutput)
+}
+
+// DNSZoneRecordsMapInput is an input type that accepts DNSZoneRecordsMap and DNSZoneRecordsMapOutput values.
+// You can construct a concrete instance of `DNSZoneRecordsMapInput` via:
+//
+//	DNSZoneRecordsMap{ "key": DNSZoneRecordsArgs{...} }
+type DNSZoneRecordsMapInput interface {
+	pulumi.Input
+
+	ToDNSZoneRecordsMapOutput() DNSZoneRecordsMapOutput
+	ToDNSZoneRecordsMapOutputWithContext(context.Context) DNSZoneRecordsMapOutput
+}
+
+type DNSZoneRecordsMap map[string]DNSZoneRecordsInput
+
+func (DNSZoneRecordsMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*DNSZoneRecords)(nil)).Elem()
+}
+
+func (i DNSZoneRecordsMap) ToDNSZoneRecordsMapOutput() DNSZoneRecordsMapOutput {
+	return i.ToDNSZoneRecordsMapOutputWithContext(context.Background())
+}
+
+func (i DNSZoneRecordsMap) ToDNSZoneRecordsMapOutputWithContext(ctx context.Context) DNSZoneRecordsMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DNSZoneRecordsMapOutput)
+}
+
+type DNSZoneRecordsOutput struct{ *pulumi.OutputState }
+
+func (DNSZoneRecordsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**DNSZoneRecords)(nil)).Elem()
+}
+
+func (o DNSZoneRecordsOutput) ToDNSZoneRecordsOutput() DNSZoneRecordsOutput {
+	return o
+}
+
+func (o DNSZoneRecordsOutput) ToDNSZoneRecordsOutputWithContext(ctx context.Context) DNSZoneRecordsOutput {
+	return o
+}
+
+// The domain name whose records are managed
+func (o DNSZoneRecordsOutput) Domain() pulumi.StringOutput {
+	return o.ApplyT(func(v *DNSZoneRecords) pulumi.StringOutput { return v.Domain }).(pulumi.StringOutput)
+}
+
+// The rules selecting records that are left alone
+func (o DNSZoneRecordsOutput) Ignore() DNSRecordFilterArrayOutput {
+	return o.ApplyT(func(v *DNSZoneRecords) DNSRecordFilterArrayOutput { return v.Ignore }).(DNSRecordFilterArrayOutput)
+}
+
+// The Netcup IDs of the records, in the order of records
+func (o DNSZoneRecordsOutput) RecordIds() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *DNSZoneRecords) pulumi.StringArrayOutput { return v.RecordIds }).(pulumi.StringArrayOutput)
+}
+
+// The records of the domain
+func (o DNSZoneRecordsOutput) Records() DNSZoneRecordArrayOutput {
+	return o.ApplyT(func(v *DNSZoneRecords) DNSZoneRecordArrayOutput { return v.Records }).(DNSZoneRecordArrayOutput)
+}
+
+// The zone file the records were taken from
+func (o DNSZoneRecordsOutput) ZoneFile() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *DNSZoneRecords) pulumi.StringPtrOutput { return v.ZoneFile }).(pulumi.StringPtrOutput)
+}
+
+type DNSZoneRecordsArrayOutput struct{ *pulumi.OutputState }
+
+func (DNSZoneRecordsArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*DNSZoneRecords)(nil)).Elem()
+}
+
+func (o DNSZoneRecordsArrayOutput) ToDNSZoneRecordsArrayOutput() DNSZoneRecordsArrayOutput {
+	return o
+}
+
+func (o DNSZoneRecordsArrayOutput) ToDNSZoneRecordsArrayOutputWithContext(ctx context.Context) DNSZoneRecordsArrayOutput {
+	return o
+}
+
+func (o DNSZoneRecordsArrayOutput) Index(i pulumi.IntInput) DNSZoneRecordsOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *DNSZoneRecords {
+		return vs[0].([]*DNSZoneRecords)[vs[1].(int)]
+	}).(DNSZoneRecordsOutput)
+}
+
+type DNSZoneRecordsMapOutput struct{ *pulumi.OutputState }
+
+func (DNSZoneRecordsMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*DNSZoneRecords)(nil)).Elem()
+}
+
+func (o DNSZoneRecordsMapOutput) ToDNSZoneRecordsMapOutput() DNSZoneRecordsMapOutput {
+	return o
+}
+
+func (o DNSZoneRecordsMapOutput) ToDNSZoneRecordsMapOutputWithContext(ctx context.Context) DNSZoneRecordsMapOutput {
+	return o
+}
+
+func (o DNSZoneRecordsMapOutput) MapIndex(k pulumi.StringInput) DNSZoneRecordsOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *DNSZoneRecords {
+		return vs[0].(map[string]*DNSZoneRecords)[vs[1].(string)]
+	}).(DNSZoneRecordsOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*DNSZoneRecordsInput)(nil)).Elem(), &DNSZoneRecords{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DNSZoneRecordsArrayInput)(nil)).Elem(), DNSZoneRecordsArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DNSZoneRecordsMapInput)(nil)).Elem(), DNSZoneRecordsMap{})
+	pulumi.RegisterOutputType(DNSZoneRecordsOutput{})
+	pulumi.RegisterOutputType(DNSZoneRecordsArrayOutput{})
+	pulumi.RegisterOutputType(DNSZoneRecordsMapOutput{})
+}
@@ -0,0 +1,93 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package puluminetcup
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/blackdark/pulumi-netcup/sdk/go/pulumi-netcup/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Returns the DNS records of a domain, optionally filtered by hostname, type or value. Records created outside of Pulumi, such as by the hosting panel, are included
+func GetDnsRecords(ctx *pulumi.Context, args *GetDnsRecordsArgs, opts ...pulumi.InvokeOption) (*GetDnsRecordsResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv GetDnsRecordsResult
+	err := ctx.Invoke("netcup:index:getDnsRecords", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetDnsRecordsArgs struct {
+	// The domain name to look up the DNS records of (e.g., 'example.com')
+	Domain string `pulumi:"domain"`
+	// Only return records of this hostname. Use '@' for root domain
+	Name *string `pulumi:"name"`
+	// Only return records of this DNS record type
+	Type *string `pulumi:"type"`
+	// Only return records whose value/destination matches this regular expression
+	ValueRegex *string `pulumi:"valueRegex"`
+}
+
+type GetDnsRecordsResult struct {
+	// The domain name the DNS records belong to
+	Domain string `pulumi:"domain"`
+	// The DNS records matching the filters, in the order Netcup returns them
+	Records []DNSRecordEntry `pulumi:"records"`
+}
+
+func GetDnsRecordsOutput(ctx *pulumi.Context, args GetDnsRecordsOutputArgs, opts ...pulumi.InvokeOption) GetDnsRecordsResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (GetDnsRecordsResultOutput, error) {
+			args := v.(GetDnsRecordsArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("netcup:index:getDnsRecords", args, GetDnsRecordsResultOutput{}, options).(GetDnsRecordsResultOutput), nil
+		}).(GetDnsRecordsResultOutput)
+}
+
+type GetDnsRecordsOutputArgs struct {
+	// The domain name to look up the DNS records of (e.g., 'example.com')
+	Domain pulumi.StringInput `pulumi:"domain"`
+	// Only return records of this hostname. Use '@' for root domain
+	Name pulumi.StringPtrInput `pulumi:"name"`
+	// Only return records of this DNS record type
+	Type pulumi.StringPtrInput `pulumi:"type"`
+	// Only return records whose value/destination matches this regular expression
+	ValueRegex pulumi.StringPtrInput `pulumi:"valueRegex"`
+}
+
+func (GetDnsRecordsOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetDnsRecordsArgs)(nil)).Elem()
+}
+
+type GetDnsRecordsResultOutput struct{ *pulumi.OutputState }
+
+func (GetDnsRecordsResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetDnsRecordsResult)(nil)).Elem()
+}
+
+func (o GetDnsRecordsResultOutput) ToGetDnsRecordsResultOutput() GetDnsRecordsResultOutput {
+	return o
+}
+
+func (o GetDnsRecordsResultOutput) ToGetDnsRecordsResultOutputWithContext(ctx context.Context) GetDnsRecordsResultOutput {
+	return o
+}
+
+// The domain name the DNS records belong to
+func (o GetDnsRecordsResultOutput) Domain() pulumi.StringOutput {
+	return o.ApplyT(func(v GetDnsRecordsResult) string { return v.Domain }).(pulumi.StringOutput)
+}
+
+// The DNS records matching the filters, in the order Netcup returns them
+func (o GetDnsRecordsResultOutput) Records() DNSRecordEntryArrayOutput {
+	return o.ApplyT(func(v GetDnsRecordsResult) []DNSRecordEntry { return v.Records }).(DNSRecordEntryArrayOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(GetDnsRecordsResultOutput{})
+}
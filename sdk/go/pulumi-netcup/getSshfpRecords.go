@@ -0,0 +1,74 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package puluminetcup
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/blackdark/pulumi-netcup/sdk/go/pulumi-netcup/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Returns the SHA-1 and SHA-256 SSHFP records of SSH host keys, such as the contents of /etc/ssh/ssh_host_*_key.pub
+func GetSshfpRecords(ctx *pulumi.Context, args *GetSshfpRecordsArgs, opts ...pulumi.InvokeOption) (*GetSshfpRecordsResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv GetSshfpRecordsResult
+	err := ctx.Invoke("netcup:index:getSshfpRecords", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetSshfpRecordsArgs struct {
+	// The RSA, ECDSA or Ed25519 host keys in OpenSSH authorized_keys format
+	PublicKeys []string `pulumi:"publicKeys"`
+}
+
+type GetSshfpRecordsResult struct {
+	// The SSHFP records, a SHA-1 and a SHA-256 record per key
+	Records []SSHFPRecordEntry `pulumi:"records"`
+}
+
+func GetSshfpRecordsOutput(ctx *pulumi.Context, args GetSshfpRecordsOutputArgs, opts ...pulumi.InvokeOption) GetSshfpRecordsResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (GetSshfpRecordsResultOutput, error) {
+			args := v.(GetSshfpRecordsArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("netcup:index:getSshfpRecords", args, GetSshfpRecordsResultOutput{}, options).(GetSshfpRecordsResultOutput), nil
+		}).(GetSshfpRecordsResultOutput)
+}
+
+type GetSshfpRecordsOutputArgs struct {
+	// The RSA, ECDSA or Ed25519 host keys in OpenSSH authorized_keys format
+	PublicKeys pulumi.StringArrayInput `pulumi:"publicKeys"`
+}
+
+func (GetSshfpRecordsOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetSshfpRecordsArgs)(nil)).Elem()
+}
+
+type GetSshfpRecordsResultOutput struct{ *pulumi.OutputState }
+
+func (GetSshfpRecordsResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetSshfpRecordsResult)(nil)).Elem()
+}
+
+func (o GetSshfpRecordsResultOutput) ToGetSshfpRecordsResultOutput() GetSshfpRecordsResultOutput {
+	return o
+}
+
+func (o GetSshfpRecordsResultOutput) ToGetSshfpRecordsResultOutputWithContext(ctx context.Context) GetSshfpRecordsResultOutput {
+	return o
+}
+
+// The SSHFP records, a SHA-1 and a SHA-256 record per key
+func (o GetSshfpRecordsResultOutput) Records() SSHFPRecordEntryArrayOutput {
+	return o.ApplyT(func(v GetSshfpRecordsResult) []SSHFPRecordEntry { return v.Records }).(SSHFPRecordEntryArrayOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(GetSshfpRecordsResultOutput{})
+}
@@ -23,6 +23,12 @@ func (m *module) Construct(ctx *pulumi.Context, name, typ, urn string) (r pulumi
 	switch typ {
 	case "netcup:index:DNSRecord":
 		r = &DNSRecord{}
+	case "netcup:index:DNSRecordSet":
+		r = &DNSRecordSet{}
+	case "netcup:index:DNSZone":
+		r = &DNSZone{}
+	case "netcup:index:DNSZoneRecords":
+		r = &DNSZoneRecords{}
 	default:
 		return nil, fmt.Errorf("unknown resource type: %s", typ)
 	}
@@ -0,0 +1,70 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package puluminetcup
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/blackdark/pulumi-netcup/sdk/go/pulumi-netcup/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Returns all domains owned by the Netcup customer account
+func ListDomains(ctx *pulumi.Context, args *ListDomainsArgs, opts ...pulumi.InvokeOption) (*ListDomainsResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv ListDomainsResult
+	err := ctx.Invoke("netcup:index:listDomains", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type ListDomainsArgs struct {
+}
+
+type ListDomainsResult struct {
+	// The domains of the account, sorted by name
+	Domains []DomainDetails `pulumi:"domains"`
+}
+
+func ListDomainsOutput(ctx *pulumi.Context, args ListDomainsOutputArgs, opts ...pulumi.InvokeOption) ListDomainsResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (ListDomainsResultOutput, error) {
+			args := v.(ListDomainsArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("netcup:index:listDomains", args, ListDomainsResultOutput{}, options).(ListDomainsResultOutput), nil
+		}).(ListDomainsResultOutput)
+}
+
+type ListDomainsOutputArgs struct {
+}
+
+func (ListDomainsOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ListDomainsArgs)(nil)).Elem()
+}
+
+type ListDomainsResultOutput struct{ *pulumi.OutputState }
+
+func (ListDomainsResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ListDomainsResult)(nil)).Elem()
+}
+
+func (o ListDomainsResultOutput) ToListDomainsResultOutput() ListDomainsResultOutput {
+	return o
+}
+
+func (o ListDomainsResultOutput) ToListDomainsResultOutputWithContext(ctx context.Context) ListDomainsResultOutput {
+	return o
+}
+
+// The domains of the account, sorted by name
+func (o ListDomainsResultOutput) Domains() DomainDetailsArrayOutput {
+	return o.ApplyT(func(v ListDomainsResult) []DomainDetails { return v.Domains }).(DomainDetailsArrayOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(ListDomainsResultOutput{})
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"reflect"
 
-	"errors"
 	"github.com/blackdark/pulumi-netcup/sdk/go/pulumi-netcup/internal"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
@@ -15,35 +14,51 @@ import (
 type Provider struct {
 	pulumi.ProviderResourceState
 
-	// The Netcup API key for authentication
-	ApiKey pulumi.StringOutput `pulumi:"apiKey"`
-	// The Netcup API password for authentication
-	ApiPassword pulumi.StringOutput `pulumi:"apiPassword"`
-	// The Netcup customer ID
-	CustomerId pulumi.StringOutput `pulumi:"customerId"`
+	// The Netcup API key for authentication. Can also be set with NETCUP_API_KEY
+	ApiKey pulumi.StringPtrOutput `pulumi:"apiKey"`
+	// The Netcup API password for authentication. Can also be set with NETCUP_API_PASSWORD
+	ApiPassword pulumi.StringPtrOutput `pulumi:"apiPassword"`
+	// Path to a PEM file with additional CA certificates trusted for API requests
+	CaBundle pulumi.StringPtrOutput `pulumi:"caBundle"`
+	// The Netcup customer ID. Can also be set with NETCUP_CUSTOMER_ID
+	CustomerId pulumi.StringPtrOutput `pulumi:"customerId"`
+	// The URL of the Netcup CCP JSON API. Defaults to https://ccp.netcup.net/run/webservice/servers/endpoint.php?JSON
+	Endpoint pulumi.StringPtrOutput `pulumi:"endpoint"`
+	// The nameserver (host:port) DNSKEY records of DNSSEC signed zones are read from. Defaults to root-dns.netcup.net:53
+	Nameserver pulumi.StringPtrOutput `pulumi:"nameserver"`
+	// The URL of an HTTP proxy for API requests. Defaults to the HTTPS_PROXY and NO_PROXY environment variables
+	ProxyUrl pulumi.StringPtrOutput `pulumi:"proxyUrl"`
+	// The User-Agent header sent with API requests
+	UserAgent pulumi.StringPtrOutput `pulumi:"userAgent"`
 }
 
 // NewProvider registers a new resource with the given unique name, arguments, and options.
 func NewProvider(ctx *pulumi.Context,
 	name string, args *ProviderArgs, opts ...pulumi.ResourceOption) (*Provider, error) {
 	if args == nil {
-		return nil, errors.New("missing one or more required arguments")
+		args = &ProviderArgs{}
 	}
 
 	if args.ApiKey == nil {
-		return nil, errors.New("invalid value for required argument 'ApiKey'")
+		if d := internal.GetEnvOrDefault(nil, nil, "NETCUP_API_KEY"); d != nil {
+			args.ApiKey = pulumi.StringPtr(d.(string))
+		}
 	}
 	if args.ApiPassword == nil {
-		return nil, errors.New("invalid value for required argument 'ApiPassword'")
+		if d := internal.GetEnvOrDefault(nil, nil, "NETCUP_API_PASSWORD"); d != nil {
+			args.ApiPassword = pulumi.StringPtr(d.(string))
+		}
 	}
 	if args.CustomerId == nil {
-		return nil, errors.New("invalid value for required argument 'CustomerId'")
+		if d := internal.GetEnvOrDefault(nil, nil, "NETCUP_CUSTOMER_ID"); d != nil {
+			args.CustomerId = pulumi.StringPtr(d.(string))
+		}
 	}
 	if args.ApiKey != nil {
-		args.ApiKey = pulumi.ToSecret(args.ApiKey).(pulumi.StringInput)
+		args.ApiKey = pulumi.ToSecret(args.ApiKey).(pulumi.StringPtrInput)
 	}
 	if args.ApiPassword != nil {
-		args.ApiPassword = pulumi.ToSecret(args.ApiPassword).(pulumi.StringInput)
+		args.ApiPassword = pulumi.ToSecret(args.ApiPassword).(pulumi.StringPtrInput)
 	}
 	secrets := pulumi.AdditionalSecretOutputs([]string{
 		"apiKey",
@@ -60,22 +75,54 @@ func NewProvider(ctx *pulumi.Context,
 }
 
 type providerArgs struct {
-	// The Netcup API key for authentication
-	ApiKey string `pulumi:"apiKey"`
-	// The Netcup API password for authentication
-	ApiPassword string `pulumi:"apiPassword"`
-	// The Netcup customer ID
-	CustomerId string `pulumi:"customerId"`
+	// The Netcup API key for authentication. Can also be set with NETCUP_API_KEY
+	ApiKey *string `pulumi:"apiKey"`
+	// The Netcup API password for authentication. Can also be set with NETCUP_API_PASSWORD
+	ApiPassword *string `pulumi:"apiPassword"`
+	// Path to a PEM file with additional CA certificates trusted for API requests
+	CaBundle *string `pulumi:"caBundle"`
+	// The Netcup customer ID. Can also be set with NETCUP_CUSTOMER_ID
+	CustomerId *string `pulumi:"customerId"`
+	// The URL of the Netcup CCP JSON API. Defaults to https://ccp.netcup.net/run/webservice/servers/endpoint.php?JSON
+	Endpoint *string `pulumi:"endpoint"`
+	// How often a rate-limited (status 2057) or transiently failed API request is retried. Defaults to 5
+	MaxRetries *int `pulumi:"maxRetries"`
+	// The nameserver (host:port) DNSKEY records of DNSSEC signed zones are read from. Defaults to root-dns.netcup.net:53
+	Nameserver *string `pulumi:"nameserver"`
+	// The URL of an HTTP proxy for API requests. Defaults to the HTTPS_PROXY and NO_PROXY environment variables
+	ProxyUrl *string `pulumi:"proxyUrl"`
+	// The timeout of a single API request in seconds. Defaults to 30
+	RequestTimeout *int `pulumi:"requestTimeout"`
+	// The maximum number of Netcup API requests per minute made by the provider. The limit is shared by all provider instances in the process. Defaults to 180
+	RequestsPerMinute *int `pulumi:"requestsPerMinute"`
+	// The User-Agent header sent with API requests
+	UserAgent *string `pulumi:"userAgent"`
 }
 
 // The set of arguments for constructing a Provider resource.
 type ProviderArgs struct {
-	// The Netcup API key for authentication
-	ApiKey pulumi.StringInput
-	// The Netcup API password for authentication
-	ApiPassword pulumi.StringInput
-	// The Netcup customer ID
-	CustomerId pulumi.StringInput
+	// The Netcup API key for authentication. Can also be set with NETCUP_API_KEY
+	ApiKey pulumi.StringPtrInput
+	// The Netcup API password for authentication. Can also be set with NETCUP_API_PASSWORD
+	ApiPassword pulumi.StringPtrInput
+	// Path to a PEM file with additional CA certificates trusted for API requests
+	CaBundle pulumi.StringPtrInput
+	// The Netcup customer ID. Can also be set with NETCUP_CUSTOMER_ID
+	CustomerId pulumi.StringPtrInput
+	// The URL of the Netcup CCP JSON API. Defaults to https://ccp.netcup.net/run/webservice/servers/endpoint.php?JSON
+	Endpoint pulumi.StringPtrInput
+	// How often a rate-limited (status 2057) or transiently failed API request is retried. Defaults to 5
+	MaxRetries pulumi.IntPtrInput
+	// The nameserver (host:port) DNSKEY records of DNSSEC signed zones are read from. Defaults to root-dns.netcup.net:53
+	Nameserver pulumi.StringPtrInput
+	// The URL of an HTTP proxy for API requests. Defaults to the HTTPS_PROXY and NO_PROXY environment variables
+	ProxyUrl pulumi.StringPtrInput
+	// The timeout of a single API request in seconds. Defaults to 30
+	RequestTimeout pulumi.IntPtrInput
+	// The maximum number of Netcup API requests per minute made by the provider. The limit is shared by all provider instances in the process. Defaults to 180
+	RequestsPerMinute pulumi.IntPtrInput
+	// The User-Agent header sent with API requests
+	UserAgent pulumi.StringPtrInput
 }
 
 func (ProviderArgs) ElementType() reflect.Type {
@@ -115,19 +162,44 @@ func (o ProviderOutput) ToProviderOutputWithContext(ctx context.Context) Provide
 	return o
 }
 
-// The Netcup API key for authentication
-func (o ProviderOutput) ApiKey() pulumi.StringOutput {
-	return o.ApplyT(func(v *Provider) pulumi.StringOutput { return v.ApiKey }).(pulumi.StringOutput)
+// The Netcup API key for authentication. Can also be set with NETCUP_API_KEY
+func (o ProviderOutput) ApiKey() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.ApiKey }).(pulumi.StringPtrOutput)
 }
 
-// The Netcup API password for authentication
-func (o ProviderOutput) ApiPassword() pulumi.StringOutput {
-	return o.ApplyT(func(v *Provider) pulumi.StringOutput { return v.ApiPassword }).(pulumi.StringOutput)
+// The Netcup API password for authentication. Can also be set with NETCUP_API_PASSWORD
+func (o ProviderOutput) ApiPassword() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.ApiPassword }).(pulumi.StringPtrOutput)
 }
 
-// The Netcup customer ID
-func (o ProviderOutput) CustomerId() pulumi.StringOutput {
-	return o.ApplyT(func(v *Provider) pulumi.StringOutput { return v.CustomerId }).(pulumi.StringOutput)
+// Path to a PEM file with additional CA certificates trusted for API requests
+func (o ProviderOutput) CaBundle() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.CaBundle }).(pulumi.StringPtrOutput)
+}
+
+// The Netcup customer ID. Can also be set with NETCUP_CUSTOMER_ID
+func (o ProviderOutput) CustomerId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.CustomerId }).(pulumi.StringPtrOutput)
+}
+
+// The URL of the Netcup CCP JSON API. Defaults to https://ccp.netcup.net/run/webservice/servers/endpoint.php?JSON
+func (o ProviderOutput) Endpoint() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.Endpoint }).(pulumi.StringPtrOutput)
+}
+
+// The nameserver (host:port) DNSKEY records of DNSSEC signed zones are read from. Defaults to root-dns.netcup.net:53
+func (o ProviderOutput) Nameserver() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.Nameserver }).(pulumi.StringPtrOutput)
+}
+
+// The URL of an HTTP proxy for API requests. Defaults to the HTTPS_PROXY and NO_PROXY environment variables
+func (o ProviderOutput) ProxyUrl() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.ProxyUrl }).(pulumi.StringPtrOutput)
+}
+
+// The User-Agent header sent with API requests
+func (o ProviderOutput) UserAgent() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.UserAgent }).(pulumi.StringPtrOutput)
 }
 
 func init() {
@@ -0,0 +1,1928 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package puluminetcup
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/blackdark/pulumi-netcup/sdk/go/pulumi-netcup/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+var _ = internal.GetEnvOrDefault
+
+type CAARecord struct {
+	// The flags of the record: 0, or 128 to mark the property as critical for issuers
+	Flags *int `pulumi:"flags"`
+	// The property tag: issue, issuewild, iodef, contactemail or contactphone
+	Tag string `pulumi:"tag"`
+	// The unquoted property value, e.g. 'letsencrypt.org' for issue or 'mailto:security@example.com' for iodef
+	Value string `pulumi:"value"`
+}
+
+// Defaults sets the appropriate defaults for CAARecord
+func (val *CAARecord) Defaults() *CAARecord {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.Flags == nil {
+		flags_ := 0
+		tmp.Flags = &flags_
+	}
+	return &tmp
+}
+
+// CAARecordInput is an input type that accepts CAARecordArgs and CAARecordOutput values.
+// You can construct a concrete instance of `CAARecordInput` via:
+//
+//	CAARecordArgs{...}
+type CAARecordInput interface {
+	pulumi.Input
+
+	ToCAARecordOutput() CAARecordOutput
+	ToCAARecordOutputWithContext(context.Context) CAARecordOutput
+}
+
+type CAARecordArgs struct {
+	// The flags of the record: 0, or 128 to mark the property as critical for issuers
+	Flags pulumi.IntPtrInput `pulumi:"flags"`
+	// The property tag: issue, issuewild, iodef, contactemail or contactphone
+	Tag pulumi.StringInput `pulumi:"tag"`
+	// The unquoted property value, e.g. 'letsencrypt.org' for issue or 'mailto:security@example.com' for iodef
+	Value pulumi.StringInput `pulumi:"value"`
+}
+
+// Defaults sets the appropriate defaults for CAARecordArgs
+func (val *CAARecordArgs) Defaults() *CAARecordArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.Flags == nil {
+		tmp.Flags = pulumi.IntPtr(0)
+	}
+	return &tmp
+}
+func (CAARecordArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*CAARecord)(nil)).Elem()
+}
+
+func (i CAARecordArgs) ToCAARecordOutput() CAARecordOutput {
+	return i.ToCAARecordOutputWithContext(context.Background())
+}
+
+func (i CAARecordArgs) ToCAARecordOutputWithContext(ctx context.Context) CAARecordOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CAARecordOutput)
+}
+
+func (i CAARecordArgs) ToCAARecordPtrOutput() CAARecordPtrOutput {
+	return i.ToCAARecordPtrOutputWithContext(context.Background())
+}
+
+func (i CAARecordArgs) ToCAARecordPtrOutputWithContext(ctx context.Context) CAARecordPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CAARecordOutput).ToCAARecordPtrOutputWithContext(ctx)
+}
+
+// CAARecordPtrInput is an input type that accepts CAARecordArgs, CAARecordPtr and CAARecordPtrOutput values.
+// You can construct a concrete instance of `CAARecordPtrInput` via:
+//
+//	        CAARecordArgs{...}
+//
+//	or:
+//
+//	        nil
+type CAARecordPtrInput interface {
+	pulumi.Input
+
+	ToCAARecordPtrOutput() CAARecordPtrOutput
+	ToCAARecordPtrOutputWithContext(context.Context) CAARecordPtrOutput
+}
+
+type caarecordPtrType CAARecordArgs
+
+func CAARecordPtr(v *CAARecordArgs) CAARecordPtrInput {
+	return (*caarecordPtrType)(v)
+}
+
+func (*caarecordPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**CAARecord)(nil)).Elem()
+}
+
+func (i *caarecordPtrType) ToCAARecordPtrOutput() CAARecordPtrOutput {
+	return i.ToCAARecordPtrOutputWithContext(context.Background())
+}
+
+func (i *caarecordPtrType) ToCAARecordPtrOutputWithContext(ctx context.Context) CAARecordPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CAARecordPtrOutput)
+}
+
+type CAARecordOutput struct{ *pulumi.OutputState }
+
+func (CAARecordOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*CAARecord)(nil)).Elem()
+}
+
+func (o CAARecordOutput) ToCAARecordOutput() CAARecordOutput {
+	return o
+}
+
+func (o CAARecordOutput) ToCAARecordOutputWithContext(ctx context.Context) CAARecordOutput {
+	return o
+}
+
+func (o CAARecordOutput) ToCAARecordPtrOutput() CAARecordPtrOutput {
+	return o.ToCAARecordPtrOutputWithContext(context.Background())
+}
+
+func (o CAARecordOutput) ToCAARecordPtrOutputWithContext(ctx context.Context) CAARecordPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v CAARecord) *CAARecord {
+		return &v
+	}).(CAARecordPtrOutput)
+}
+
+// The flags of the record: 0, or 128 to mark the property as critical for issuers
+func (o CAARecordOutput) Flags() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v CAARecord) *int { return v.Flags }).(pulumi.IntPtrOutput)
+}
+
+// The property tag: issue, issuewild, iodef, contactemail or contactphone
+func (o CAARecordOutput) Tag() pulumi.StringOutput {
+	return o.ApplyT(func(v CAARecord) string { return v.Tag }).(pulumi.StringOutput)
+}
+
+// The unquoted property value, e.g. 'letsencrypt.org' for issue or 'mailto:security@example.com' for iodef
+func (o CAARecordOutput) Value() pulumi.StringOutput {
+	return o.ApplyT(func(v CAARecord) string { return v.Value }).(pulumi.StringOutput)
+}
+
+type CAARecordPtrOutput struct{ *pulumi.OutputState }
+
+func (CAARecordPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**CAARecord)(nil)).Elem()
+}
+
+func (o CAARecordPtrOutput) ToCAARecordPtrOutput() CAARecordPtrOutput {
+	return o
+}
+
+func (o CAARecordPtrOutput) ToCAARecordPtrOutputWithContext(ctx context.Context) CAARecordPtrOutput {
+	return o
+}
+
+func (o CAARecordPtrOutput) Elem() CAARecordOutput {
+	return o.ApplyT(func(v *CAARecord) CAARecord {
+		if v != nil {
+			return *v
+		}
+		var ret CAARecord
+		return ret
+	}).(CAARecordOutput)
+}
+
+// The flags of the record: 0, or 128 to mark the property as critical for issuers
+func (o CAARecordPtrOutput) Flags() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *CAARecord) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Flags
+	}).(pulumi.IntPtrOutput)
+}
+
+// The property tag: issue, issuewild, iodef, contactemail or contactphone
+func (o CAARecordPtrOutput) Tag() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CAARecord) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Tag
+	}).(pulumi.StringPtrOutput)
+}
+
+// The unquoted property value, e.g. 'letsencrypt.org' for issue or 'mailto:security@example.com' for iodef
+func (o CAARecordPtrOutput) Value() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CAARecord) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Value
+	}).(pulumi.StringPtrOutput)
+}
+
+type DNSRecordEntry struct {
+	// The fully qualified domain name
+	Fqdn string `pulumi:"fqdn"`
+	// The hostname of the DNS record
+	Name string `pulumi:"name"`
+	// The priority of MX and SRV records
+	Priority *string `pulumi:"priority"`
+	// The Netcup record ID
+	RecordId string `pulumi:"recordId"`
+	// The DNS record type
+	Type string `pulumi:"type"`
+	// The value/destination of the DNS record
+	Value string `pulumi:"value"`
+}
+
+type DNSRecordEntryOutput struct{ *pulumi.OutputState }
+
+func (DNSRecordEntryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DNSRecordEntry)(nil)).Elem()
+}
+
+func (o DNSRecordEntryOutput) ToDNSRecordEntryOutput() DNSRecordEntryOutput {
+	return o
+}
+
+func (o DNSRecordEntryOutput) ToDNSRecordEntryOutputWithContext(ctx context.Context) DNSRecordEntryOutput {
+	return o
+}
+
+// The fully qualified domain name
+func (o DNSRecordEntryOutput) Fqdn() pulumi.StringOutput {
+	return o.ApplyT(func(v DNSRecordEntry) string { return v.Fqdn }).(pulumi.StringOutput)
+}
+
+// The hostname of the DNS record
+func (o DNSRecordEntryOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v DNSRecordEntry) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// The priority of MX and SRV records
+func (o DNSRecordEntryOutput) Priority() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v DNSRecordEntry) *string { return v.Priority }).(pulumi.StringPtrOutput)
+}
+
+// The Netcup record ID
+func (o DNSRecordEntryOutput) RecordId() pulumi.StringOutput {
+	return o.ApplyT(func(v DNSRecordEntry) string { return v.RecordId }).(pulumi.StringOutput)
+}
+
+// The DNS record type
+func (o DNSRecordEntryOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v DNSRecordEntry) string { return v.Type }).(pulumi.StringOutput)
+}
+
+// The value/destination of the DNS record
+func (o DNSRecordEntryOutput) Value() pulumi.StringOutput {
+	return o.ApplyT(func(v DNSRecordEntry) string { return v.Value }).(pulumi.StringOutput)
+}
+
+type DNSRecordEntryArrayOutput struct{ *pulumi.OutputState }
+
+func (DNSRecordEntryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]DNSRecordEntry)(nil)).Elem()
+}
+
+func (o DNSRecordEntryArrayOutput) ToDNSRecordEntryArrayOutput() DNSRecordEntryArrayOutput {
+	return o
+}
+
+func (o DNSRecordEntryArrayOutput) ToDNSRecordEntryArrayOutputWithContext(ctx context.Context) DNSRecordEntryArrayOutput {
+	return o
+}
+
+func (o DNSRecordEntryArrayOutput) Index(i pulumi.IntInput) DNSRecordEntryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) DNSRecordEntry {
+		return vs[0].([]DNSRecordEntry)[vs[1].(int)]
+	}).(DNSRecordEntryOutput)
+}
+
+type DNSRecordFilter struct {
+	// The hostname to match. Matches every hostname when not set
+	Name *string `pulumi:"name"`
+	// The record type to match. Matches every type when not set
+	Type *string `pulumi:"type"`
+}
+
+// DNSRecordFilterInput is an input type that accepts DNSRecordFilterArgs and DNSRecordFilterOutput values.
+// You can construct a concrete instance of `DNSRecordFilterInput` via:
+//
+//	DNSRecordFilterArgs{...}
+type DNSRecordFilterInput interface {
+	pulumi.Input
+
+	ToDNSRecordFilterOutput() DNSRecordFilterOutput
+	ToDNSRecordFilterOutputWithContext(context.Context) DNSRecordFilterOutput
+}
+
+type DNSRecordFilterArgs struct {
+	// The hostname to match. Matches every hostname when not set
+	Name pulumi.StringPtrInput `pulumi:"name"`
+	// The record type to match. Matches every type when not set
+	Type pulumi.StringPtrInput `pulumi:"type"`
+}
+
+func (DNSRecordFilterArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*DNSRecordFilter)(nil)).Elem()
+}
+
+func (i DNSRecordFilterArgs) ToDNSRecordFilterOutput() DNSRecordFilterOutput {
+	return i.ToDNSRecordFilterOutputWithContext(context.Background())
+}
+
+func (i DNSRecordFilterArgs) ToDNSRecordFilterOutputWithContext(ctx context.Context) DNSRecordFilterOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DNSRecordFilterOutput)
+}
+
+// DNSRecordFilterArrayInput is an input type that accepts DNSRecordFilterArray and DNSRecordFilterArrayOutput values.
+// You can construct a concrete instance of `DNSRecordFilterArrayInput` via:
+//
+//	DNSRecordFilterArray{ DNSRecordFilterArgs{...} }
+type DNSRecordFilterArrayInput interface {
+	pulumi.Input
+
+	ToDNSRecordFilterArrayOutput() DNSRecordFilterArrayOutput
+	ToDNSRecordFilterArrayOutputWithContext(context.Context) DNSRecordFilterArrayOutput
+}
+
+type DNSRecordFilterArray []DNSRecordFilterInput
+
+func (DNSRecordFilterArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]DNSRecordFilter)(nil)).Elem()
+}
+
+func (i DNSRecordFilterArray) ToDNSRecordFilterArrayOutput() DNSRecordFilterArrayOutput {
+	return i.ToDNSRecordFilterArrayOutputWithContext(context.Background())
+}
+
+func (i DNSRecordFilterArray) ToDNSRecordFilterArrayOutputWithContext(ctx context.Context) DNSRecordFilterArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DNSRecordFilterArrayOutput)
+}
+
+type DNSRecordFilterOutput struct{ *pulumi.OutputState }
+
+func (DNSRecordFilterOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DNSRecordFilter)(nil)).Elem()
+}
+
+func (o DNSRecordFilterOutput) ToDNSRecordFilterOutput() DNSRecordFilterOutput {
+	return o
+}
+
+func (o DNSRecordFilterOutput) ToDNSRecordFilterOutputWithContext(ctx context.Context) DNSRecordFilterOutput {
+	return o
+}
+
+// The hostname to match. Matches every hostname when not set
+func (o DNSRecordFilterOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v DNSRecordFilter) *string { return v.Name }).(pulumi.StringPtrOutput)
+}
+
+// The record type to match. Matches every type when not set
+func (o DNSRecordFilterOutput) Type() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v DNSRecordFilter) *string { return v.Type }).(pulumi.StringPtrOutput)
+}
+
+type DNSRecordFilterArrayOutput struct{ *pulumi.OutputState }
+
+func (DNSRecordFilterArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]DNSRecordFilter)(nil)).Elem()
+}
+
+func (o DNSRecordFilterArrayOutput) ToDNSRecordFilterArrayOutput() DNSRecordFilterArrayOutput {
+	return o
+}
+
+func (o DNSRecordFilterArrayOutput) ToDNSRecordFilterArrayOutputWithContext(ctx context.Context) DNSRecordFilterArrayOutput {
+	return o
+}
+
+func (o DNSRecordFilterArrayOutput) Index(i pulumi.IntInput) DNSRecordFilterOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) DNSRecordFilter {
+		return vs[0].([]DNSRecordFilter)[vs[1].(int)]
+	}).(DNSRecordFilterOutput)
+}
+
+type DNSRecordSetValue struct {
+	// The priority for MX and SRV records (required for these types, ignored for others)
+	Priority *string `pulumi:"priority"`
+	// The value/destination of the record
+	Value string `pulumi:"value"`
+}
+
+// DNSRecordSetValueInput is an input type that accepts DNSRecordSetValueArgs and DNSRecordSetValueOutput values.
+// You can construct a concrete instance of `DNSRecordSetValueInput` via:
+//
+//	DNSRecordSetValueArgs{...}
+type DNSRecordSetValueInput interface {
+	pulumi.Input
+
+	ToDNSRecordSetValueOutput() DNSRecordSetValueOutput
+	ToDNSRecordSetValueOutputWithContext(context.Context) DNSRecordSetValueOutput
+}
+
+type DNSRecordSetValueArgs struct {
+	// The priority for MX and SRV records (required for these types, ignored for others)
+	Priority pulumi.StringPtrInput `pulumi:"priority"`
+	// The value/destination of the record
+	Value pulumi.StringInput `pulumi:"value"`
+}
+
+func (DNSRecordSetValueArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*DNSRecordSetValue)(nil)).Elem()
+}
+
+func (i DNSRecordSetValueArgs) ToDNSRecordSetValueOutput() DNSRecordSetValueOutput {
+	return i.ToDNSRecordSetValueOutputWithContext(context.Background())
+}
+
+func (i DNSRecordSetValueArgs) ToDNSRecordSetValueOutputWithContext(ctx context.Context) DNSRecordSetValueOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DNSRecordSetValueOutput)
+}
+
+// DNSRecordSetValueArrayInput is an input type that accepts DNSRecordSetValueArray and DNSRecordSetValueArrayOutput values.
+// You can construct a concrete instance of `DNSRecordSetValueArrayInput` via:
+//
+//	DNSRecordSetValueArray{ DNSRecordSetValueArgs{...} }
+type DNSRecordSetValueArrayInput interface {
+	pulumi.Input
+
+	ToDNSRecordSetValueArrayOutput() DNSRecordSetValueArrayOutput
+	ToDNSRecordSetValueArrayOutputWithContext(context.Context) DNSRecordSetValueArrayOutput
+}
+
+type DNSRecordSetValueArray []DNSRecordSetValueInput
+
+func (DNSRecordSetValueArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]DNSRecordSetValue)(nil)).Elem()
+}
+
+func (i DNSRecordSetValueArray) ToDNSRecordSetValueArrayOutput() DNSRecordSetValueArrayOutput {
+	return i.ToDNSRecordSetValueArrayOutputWithContext(context.Background())
+}
+
+func (i DNSRecordSetValueArray) ToDNSRecordSetValueArrayOutputWithContext(ctx context.Context) DNSRecordSetValueArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DNSRecordSetValueArrayOutput)
+}
+
+type DNSRecordSetValueOutput struct{ *pulumi.OutputState }
+
+func (DNSRecordSetValueOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DNSRecordSetValue)(nil)).Elem()
+}
+
+func (o DNSRecordSetValueOutput) ToDNSRecordSetValueOutput() DNSRecordSetValueOutput {
+	return o
+}
+
+func (o DNSRecordSetValueOutput) ToDNSRecordSetValueOutputWithContext(ctx context.Context) DNSRecordSetValueOutput {
+	return o
+}
+
+// The priority for MX and SRV records (required for these types, ignored for others)
+func (o DNSRecordSetValueOutput) Priority() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v DNSRecordSetValue) *string { return v.Priority }).(pulumi.StringPtrOutput)
+}
+
+// The value/destination of the record
+func (o DNSRecordSetValueOutput) Value() pulumi.StringOutput {
+	return o.ApplyT(func(v DNSRecordSetValue) string { return v.Value }).(pulumi.StringOutput)
+}
+
+type DNSRecordSetValueArrayOutput struct{ *pulumi.OutputState }
+
+func (DNSRecordSetValueArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]DNSRecordSetValue)(nil)).Elem()
+}
+
+func (o DNSRecordSetValueArrayOutput) ToDNSRecordSetValueArrayOutput() DNSRecordSetValueArrayOutput {
+	return o
+}
+
+func (o DNSRecordSetValueArrayOutput) ToDNSRecordSetValueArrayOutputWithContext(ctx context.Context) DNSRecordSetValueArrayOutput {
+	return o
+}
+
+func (o DNSRecordSetValueArrayOutput) Index(i pulumi.IntInput) DNSRecordSetValueOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) DNSRecordSetValue {
+		return vs[0].([]DNSRecordSetValue)[vs[1].(int)]
+	}).(DNSRecordSetValueOutput)
+}
+
+type DNSZoneRecord struct {
+	// The hostname of the record. Use '@' for the root domain
+	Name string `pulumi:"name"`
+	// The priority for MX and SRV records
+	Priority *string `pulumi:"priority"`
+	// The DNS record type
+	Type string `pulumi:"type"`
+	// The value/destination of the record
+	Value string `pulumi:"value"`
+}
+
+// DNSZoneRecordInput is an input type that accepts DNSZoneRecordArgs and DNSZoneRecordOutput values.
+// You can construct a concrete instance of `DNSZoneRecordInput` via:
+//
+//	DNSZoneRecordArgs{...}
+type DNSZoneRecordInput interface {
+	pulumi.Input
+
+	ToDNSZoneRecordOutput() DNSZoneRecordOutput
+	ToDNSZoneRecordOutputWithContext(context.Context) DNSZoneRecordOutput
+}
+
+type DNSZoneRecordArgs struct {
+	// The hostname of the record. Use '@' for the root domain
+	Name pulumi.StringInput `pulumi:"name"`
+	// The priority for MX and SRV records
+	Priority pulumi.StringPtrInput `pulumi:"priority"`
+	// The DNS record type
+	Type pulumi.StringInput `pulumi:"type"`
+	// The value/destination of the record
+	Value pulumi.StringInput `pulumi:"value"`
+}
+
+func (DNSZoneRecordArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*DNSZoneRecord)(nil)).Elem()
+}
+
+func (i DNSZoneRecordArgs) ToDNSZoneRecordOutput() DNSZoneRecordOutput {
+	return i.ToDNSZoneRecordOutputWithContext(context.Background())
+}
+
+func (i DNSZoneRecordArgs) ToDNSZoneRecordOutputWithContext(ctx context.Context) DNSZoneRecordOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DNSZoneRecordOutput)
+}
+
+// DNSZoneRecordArrayInput is an input type that accepts DNSZoneRecordArray and DNSZoneRecordArrayOutput values.
+// You can construct a concrete instance of `DNSZoneRecordArrayInput` via:
+//
+//	DNSZoneRecordArray{ DNSZoneRecordArgs{...} }
+type DNSZoneRecordArrayInput interface {
+	pulumi.Input
+
+	ToDNSZoneRecordArrayOutput() DNSZoneRecordArrayOutput
+	ToDNSZoneRecordArrayOutputWithContext(context.Context) DNSZoneRecordArrayOutput
+}
+
+type DNSZoneRecordArray []DNSZoneRecordInput
+
+func (DNSZoneRecordArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]DNSZoneRecord)(nil)).Elem()
+}
+
+func (i DNSZoneRecordArray) ToDNSZoneRecordArrayOutput() DNSZoneRecordArrayOutput {
+	return i.ToDNSZoneRecordArrayOutputWithContext(context.Background())
+}
+
+func (i DNSZoneRecordArray) ToDNSZoneRecordArrayOutputWithContext(ctx context.Context) DNSZoneRecordArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DNSZoneRecordArrayOutput)
+}
+
+type DNSZoneRecordOutput struct{ *pulumi.OutputState }
+
+func (DNSZoneRecordOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DNSZoneRecord)(nil)).Elem()
+}
+
+func (o DNSZoneRecordOutput) ToDNSZoneRecordOutput() DNSZoneRecordOutput {
+	return o
+}
+
+func (o DNSZoneRecordOutput) ToDNSZoneRecordOutputWithContext(ctx context.Context) DNSZoneRecordOutput {
+	return o
+}
+
+// The hostname of the record. Use '@' for the root domain
+func (o DNSZoneRecordOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v DNSZoneRecord) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// The priority for MX and SRV records
+func (o DNSZoneRecordOutput) Priority() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v DNSZoneRecord) *string { return v.Priority }).(pulumi.StringPtrOutput)
+}
+
+// The DNS record type
+func (o DNSZoneRecordOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v DNSZoneRecord) string { return v.Type }).(pulumi.StringOutput)
+}
+
+// The value/destination of the record
+func (o DNSZoneRecordOutput) Value() pulumi.StringOutput {
+	return o.ApplyT(func(v DNSZoneRecord) string { return v.Value }).(pulumi.StringOutput)
+}
+
+type DNSZoneRecordArrayOutput struct{ *pulumi.OutputState }
+
+func (DNSZoneRecordArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]DNSZoneRecord)(nil)).Elem()
+}
+
+func (o DNSZoneRecordArrayOutput) ToDNSZoneRecordArrayOutput() DNSZoneRecordArrayOutput {
+	return o
+}
+
+func (o DNSZoneRecordArrayOutput) ToDNSZoneRecordArrayOutputWithContext(ctx context.Context) DNSZoneRecordArrayOutput {
+	return o
+}
+
+func (o DNSZoneRecordArrayOutput) Index(i pulumi.IntInput) DNSZoneRecordOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) DNSZoneRecord {
+		return vs[0].([]DNSZoneRecord)[vs[1].(int)]
+	}).(DNSZoneRecordOutput)
+}
+
+type DomainDetails struct {
+	// The domain name
+	Domain string `pulumi:"domain"`
+	// The contact handles assigned to the domain
+	Handles []DomainHandleDetails `pulumi:"handles"`
+	// The hostnames of the nameservers the domain is delegated to
+	Nameservers []string `pulumi:"nameservers"`
+	// The end date of the current contract runtime
+	RuntimeEnd string `pulumi:"runtimeEnd"`
+	// The start date of the current contract runtime
+	RuntimeStart string `pulumi:"runtimeStart"`
+	// The registration state of the domain as reported by Netcup
+	State string `pulumi:"state"`
+}
+
+type DomainDetailsOutput struct{ *pulumi.OutputState }
+
+func (DomainDetailsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DomainDetails)(nil)).Elem()
+}
+
+func (o DomainDetailsOutput) ToDomainDetailsOutput() DomainDetailsOutput {
+	return o
+}
+
+func (o DomainDetailsOutput) ToDomainDetailsOutputWithContext(ctx context.Context) DomainDetailsOutput {
+	return o
+}
+
+// The domain name
+func (o DomainDetailsOutput) Domain() pulumi.StringOutput {
+	return o.ApplyT(func(v DomainDetails) string { return v.Domain }).(pulumi.StringOutput)
+}
+
+// The contact handles assigned to the domain
+func (o DomainDetailsOutput) Handles() DomainHandleDetailsArrayOutput {
+	return o.ApplyT(func(v DomainDetails) []DomainHandleDetails { return v.Handles }).(DomainHandleDetailsArrayOutput)
+}
+
+// The hostnames of the nameservers the domain is delegated to
+func (o DomainDetailsOutput) Nameservers() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v DomainDetails) []string { return v.Nameservers }).(pulumi.StringArrayOutput)
+}
+
+// The end date of the current contract runtime
+func (o DomainDetailsOutput) RuntimeEnd() pulumi.StringOutput {
+	return o.ApplyT(func(v DomainDetails) string { return v.RuntimeEnd }).(pulumi.StringOutput)
+}
+
+// The start date of the current contract runtime
+func (o DomainDetailsOutput) RuntimeStart() pulumi.StringOutput {
+	return o.ApplyT(func(v DomainDetails) string { return v.RuntimeStart }).(pulumi.StringOutput)
+}
+
+// The registration state of the domain as reported by Netcup
+func (o DomainDetailsOutput) State() pulumi.StringOutput {
+	return o.ApplyT(func(v DomainDetails) string { return v.State }).(pulumi.StringOutput)
+}
+
+type DomainDetailsArrayOutput struct{ *pulumi.OutputState }
+
+func (DomainDetailsArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]DomainDetails)(nil)).Elem()
+}
+
+func (o DomainDetailsArrayOutput) ToDomainDetailsArrayOutput() DomainDetailsArrayOutput {
+	return o
+}
+
+func (o DomainDetailsArrayOutput) ToDomainDetailsArrayOutputWithContext(ctx context.Context) DomainDetailsArrayOutput {
+	return o
+}
+
+func (o DomainDetailsArrayOutput) Index(i pulumi.IntInput) DomainDetailsOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) DomainDetails {
+		return vs[0].([]DomainDetails)[vs[1].(int)]
+	}).(DomainDetailsOutput)
+}
+
+type DomainHandleDetails struct {
+	// The Netcup ID of the handle
+	HandleId string `pulumi:"handleId"`
+	// The role of the handle, such as 'ownerc', 'adminc', 'techc' or 'zonec'
+	Type string `pulumi:"type"`
+}
+
+type DomainHandleDetailsOutput struct{ *pulumi.OutputState }
+
+func (DomainHandleDetailsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DomainHandleDetails)(nil)).Elem()
+}
+
+func (o DomainHandleDetailsOutput) ToDomainHandleDetailsOutput() DomainHandleDetailsOutput {
+	return o
+}
+
+func (o DomainHandleDetailsOutput) ToDomainHandleDetailsOutputWithContext(ctx context.Context) DomainHandleDetailsOutput {
+	return o
+}
+
+// The Netcup ID of the handle
+func (o DomainHandleDetailsOutput) HandleId() pulumi.StringOutput {
+	return o.ApplyT(func(v DomainHandleDetails) string { return v.HandleId }).(pulumi.StringOutput)
+}
+
+// The role of the handle, such as 'ownerc', 'adminc', 'techc' or 'zonec'
+func (o DomainHandleDetailsOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v DomainHandleDetails) string { return v.Type }).(pulumi.StringOutput)
+}
+
+type DomainHandleDetailsArrayOutput struct{ *pulumi.OutputState }
+
+func (DomainHandleDetailsArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]DomainHandleDetails)(nil)).Elem()
+}
+
+func (o DomainHandleDetailsArrayOutput) ToDomainHandleDetailsArrayOutput() DomainHandleDetailsArrayOutput {
+	return o
+}
+
+func (o DomainHandleDetailsArrayOutput) ToDomainHandleDetailsArrayOutputWithContext(ctx context.Context) DomainHandleDetailsArrayOutput {
+	return o
+}
+
+func (o DomainHandleDetailsArrayOutput) Index(i pulumi.IntInput) DomainHandleDetailsOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) DomainHandleDetails {
+		return vs[0].([]DomainHandleDetails)[vs[1].(int)]
+	}).(DomainHandleDetailsOutput)
+}
+
+type OPENPGPKEYRecord struct {
+	// The email address the key belongs to, within the domain of the record
+	Email string `pulumi:"email"`
+	// The ASCII armored OpenPGP public key, with a user ID of the email address
+	PublicKey string `pulumi:"publicKey"`
+}
+
+// OPENPGPKEYRecordInput is an input type that accepts OPENPGPKEYRecordArgs and OPENPGPKEYRecordOutput values.
+// You can construct a concrete instance of `OPENPGPKEYRecordInput` via:
+//
+//	OPENPGPKEYRecordArgs{...}
+type OPENPGPKEYRecordInput interface {
+	pulumi.Input
+
+	ToOPENPGPKEYRecordOutput() OPENPGPKEYRecordOutput
+	ToOPENPGPKEYRecordOutputWithContext(context.Context) OPENPGPKEYRecordOutput
+}
+
+type OPENPGPKEYRecordArgs struct {
+	// The email address the key belongs to, within the domain of the record
+	Email pulumi.StringInput `pulumi:"email"`
+	// The ASCII armored OpenPGP public key, with a user ID of the email address
+	PublicKey pulumi.StringInput `pulumi:"publicKey"`
+}
+
+func (OPENPGPKEYRecordArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*OPENPGPKEYRecord)(nil)).Elem()
+}
+
+func (i OPENPGPKEYRecordArgs) ToOPENPGPKEYRecordOutput() OPENPGPKEYRecordOutput {
+	return i.ToOPENPGPKEYRecordOutputWithContext(context.Background())
+}
+
+func (i OPENPGPKEYRecordArgs) ToOPENPGPKEYRecordOutputWithContext(ctx context.Context) OPENPGPKEYRecordOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OPENPGPKEYRecordOutput)
+}
+
+func (i OPENPGPKEYRecordArgs) ToOPENPGPKEYRecordPtrOutput() OPENPGPKEYRecordPtrOutput {
+	return i.ToOPENPGPKEYRecordPtrOutputWithContext(context.Background())
+}
+
+func (i OPENPGPKEYRecordArgs) ToOPENPGPKEYRecordPtrOutputWithContext(ctx context.Context) OPENPGPKEYRecordPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OPENPGPKEYRecordOutput).ToOPENPGPKEYRecordPtrOutputWithContext(ctx)
+}
+
+// OPENPGPKEYRecordPtrInput is an input type that accepts OPENPGPKEYRecordArgs, OPENPGPKEYRecordPtr and OPENPGPKEYRecordPtrOutput values.
+// You can construct a concrete instance of `OPENPGPKEYRecordPtrInput` via:
+//
+//	        OPENPGPKEYRecordArgs{...}
+//
+//	or:
+//
+//	        nil
+type OPENPGPKEYRecordPtrInput interface {
+	pulumi.Input
+
+	ToOPENPGPKEYRecordPtrOutput() OPENPGPKEYRecordPtrOutput
+	ToOPENPGPKEYRecordPtrOutputWithContext(context.Context) OPENPGPKEYRecordPtrOutput
+}
+
+type openpgpkeyrecordPtrType OPENPGPKEYRecordArgs
+
+func OPENPGPKEYRecordPtr(v *OPENPGPKEYRecordArgs) OPENPGPKEYRecordPtrInput {
+	return (*openpgpkeyrecordPtrType)(v)
+}
+
+func (*openpgpkeyrecordPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**OPENPGPKEYRecord)(nil)).Elem()
+}
+
+func (i *openpgpkeyrecordPtrType) ToOPENPGPKEYRecordPtrOutput() OPENPGPKEYRecordPtrOutput {
+	return i.ToOPENPGPKEYRecordPtrOutputWithContext(context.Background())
+}
+
+func (i *openpgpkeyrecordPtrType) ToOPENPGPKEYRecordPtrOutputWithContext(ctx context.Context) OPENPGPKEYRecordPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OPENPGPKEYRecordPtrOutput)
+}
+
+type OPENPGPKEYRecordOutput struct{ *pulumi.OutputState }
+
+func (OPENPGPKEYRecordOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*OPENPGPKEYRecord)(nil)).Elem()
+}
+
+func (o OPENPGPKEYRecordOutput) ToOPENPGPKEYRecordOutput() OPENPGPKEYRecordOutput {
+	return o
+}
+
+func (o OPENPGPKEYRecordOutput) ToOPENPGPKEYRecordOutputWithContext(ctx context.Context) OPENPGPKEYRecordOutput {
+	return o
+}
+
+func (o OPENPGPKEYRecordOutput) ToOPENPGPKEYRecordPtrOutput() OPENPGPKEYRecordPtrOutput {
+	return o.ToOPENPGPKEYRecordPtrOutputWithContext(context.Background())
+}
+
+func (o OPENPGPKEYRecordOutput) ToOPENPGPKEYRecordPtrOutputWithContext(ctx context.Context) OPENPGPKEYRecordPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v OPENPGPKEYRecord) *OPENPGPKEYRecord {
+		return &v
+	}).(OPENPGPKEYRecordPtrOutput)
+}
+
+// The email address the key belongs to, within the domain of the record
+func (o OPENPGPKEYRecordOutput) Email() pulumi.StringOutput {
+	return o.ApplyT(func(v OPENPGPKEYRecord) string { return v.Email }).(pulumi.StringOutput)
+}
+
+// The ASCII armored OpenPGP public key, with a user ID of the email address
+func (o OPENPGPKEYRecordOutput) PublicKey() pulumi.StringOutput {
+	return o.ApplyT(func(v OPENPGPKEYRecord) string { return v.PublicKey }).(pulumi.StringOutput)
+}
+
+type OPENPGPKEYRecordPtrOutput struct{ *pulumi.OutputState }
+
+func (OPENPGPKEYRecordPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**OPENPGPKEYRecord)(nil)).Elem()
+}
+
+func (o OPENPGPKEYRecordPtrOutput) ToOPENPGPKEYRecordPtrOutput() OPENPGPKEYRecordPtrOutput {
+	return o
+}
+
+func (o OPENPGPKEYRecordPtrOutput) ToOPENPGPKEYRecordPtrOutputWithContext(ctx context.Context) OPENPGPKEYRecordPtrOutput {
+	return o
+}
+
+func (o OPENPGPKEYRecordPtrOutput) Elem() OPENPGPKEYRecordOutput {
+	return o.ApplyT(func(v *OPENPGPKEYRecord) OPENPGPKEYRecord {
+		if v != nil {
+			return *v
+		}
+		var ret OPENPGPKEYRecord
+		return ret
+	}).(OPENPGPKEYRecordOutput)
+}
+
+// The email address the key belongs to, within the domain of the record
+func (o OPENPGPKEYRecordPtrOutput) Email() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OPENPGPKEYRecord) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Email
+	}).(pulumi.StringPtrOutput)
+}
+
+// The ASCII armored OpenPGP public key, with a user ID of the email address
+func (o OPENPGPKEYRecordPtrOutput) PublicKey() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OPENPGPKEYRecord) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.PublicKey
+	}).(pulumi.StringPtrOutput)
+}
+
+type SMIMEARecord struct {
+	// The PEM encoded S/MIME certificate of the email address
+	Certificate string `pulumi:"certificate"`
+	// The email address the certificate is issued for, within the domain of the record
+	Email string `pulumi:"email"`
+	// The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512. Senders need the full certificate to encrypt, so it defaults to 0
+	MatchingType *int `pulumi:"matchingType"`
+	// The selector: 0 for the full certificate or 1 for the public key
+	Selector *int `pulumi:"selector"`
+	// The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+	Usage *int `pulumi:"usage"`
+}
+
+// Defaults sets the appropriate defaults for SMIMEARecord
+func (val *SMIMEARecord) Defaults() *SMIMEARecord {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.MatchingType == nil {
+		matchingType_ := 0
+		tmp.MatchingType = &matchingType_
+	}
+	if tmp.Selector == nil {
+		selector_ := 0
+		tmp.Selector = &selector_
+	}
+	if tmp.Usage == nil {
+		usage_ := 3
+		tmp.Usage = &usage_
+	}
+	return &tmp
+}
+
+// SMIMEARecordInput is an input type that accepts SMIMEARecordArgs and SMIMEARecordOutput values.
+// You can construct a concrete instance of `SMIMEARecordInput` via:
+//
+//	SMIMEARecordArgs{...}
+type SMIMEARecordInput interface {
+	pulumi.Input
+
+	ToSMIMEARecordOutput() SMIMEARecordOutput
+	ToSMIMEARecordOutputWithContext(context.Context) SMIMEARecordOutput
+}
+
+type SMIMEARecordArgs struct {
+	// The PEM encoded S/MIME certificate of the email address
+	Certificate pulumi.StringInput `pulumi:"certificate"`
+	// The email address the certificate is issued for, within the domain of the record
+	Email pulumi.StringInput `pulumi:"email"`
+	// The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512. Senders need the full certificate to encrypt, so it defaults to 0
+	MatchingType pulumi.IntPtrInput `pulumi:"matchingType"`
+	// The selector: 0 for the full certificate or 1 for the public key
+	Selector pulumi.IntPtrInput `pulumi:"selector"`
+	// The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+	Usage pulumi.IntPtrInput `pulumi:"usage"`
+}
+
+// Defaults sets the appropriate defaults for SMIMEARecordArgs
+func (val *SMIMEARecordArgs) Defaults() *SMIMEARecordArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.MatchingType == nil {
+		tmp.MatchingType = pulumi.IntPtr(0)
+	}
+	if tmp.Selector == nil {
+		tmp.Selector = pulumi.IntPtr(0)
+	}
+	if tmp.Usage == nil {
+		tmp.Usage = pulumi.IntPtr(3)
+	}
+	return &tmp
+}
+func (SMIMEARecordArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*SMIMEARecord)(nil)).Elem()
+}
+
+func (i SMIMEARecordArgs) ToSMIMEARecordOutput() SMIMEARecordOutput {
+	return i.ToSMIMEARecordOutputWithContext(context.Background())
+}
+
+func (i SMIMEARecordArgs) ToSMIMEARecordOutputWithContext(ctx context.Context) SMIMEARecordOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SMIMEARecordOutput)
+}
+
+func (i SMIMEARecordArgs) ToSMIMEARecordPtrOutput() SMIMEARecordPtrOutput {
+	return i.ToSMIMEARecordPtrOutputWithContext(context.Background())
+}
+
+func (i SMIMEARecordArgs) ToSMIMEARecordPtrOutputWithContext(ctx context.Context) SMIMEARecordPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SMIMEARecordOutput).ToSMIMEARecordPtrOutputWithContext(ctx)
+}
+
+// SMIMEARecordPtrInput is an input type that accepts SMIMEARecordArgs, SMIMEARecordPtr and SMIMEARecordPtrOutput values.
+// You can construct a concrete instance of `SMIMEARecordPtrInput` via:
+//
+//	        SMIMEARecordArgs{...}
+//
+//	or:
+//
+//	        nil
+type SMIMEARecordPtrInput interface {
+	pulumi.Input
+
+	ToSMIMEARecordPtrOutput() SMIMEARecordPtrOutput
+	ToSMIMEARecordPtrOutputWithContext(context.Context) SMIMEARecordPtrOutput
+}
+
+type smimearecordPtrType SMIMEARecordArgs
+
+func SMIMEARecordPtr(v *SMIMEARecordArgs) SMIMEARecordPtrInput {
+	return (*smimearecordPtrType)(v)
+}
+
+func (*smimearecordPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**SMIMEARecord)(nil)).Elem()
+}
+
+func (i *smimearecordPtrType) ToSMIMEARecordPtrOutput() SMIMEARecordPtrOutput {
+	return i.ToSMIMEARecordPtrOutputWithContext(context.Background())
+}
+
+func (i *smimearecordPtrType) ToSMIMEARecordPtrOutputWithContext(ctx context.Context) SMIMEARecordPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SMIMEARecordPtrOutput)
+}
+
+type SMIMEARecordOutput struct{ *pulumi.OutputState }
+
+func (SMIMEARecordOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SMIMEARecord)(nil)).Elem()
+}
+
+func (o SMIMEARecordOutput) ToSMIMEARecordOutput() SMIMEARecordOutput {
+	return o
+}
+
+func (o SMIMEARecordOutput) ToSMIMEARecordOutputWithContext(ctx context.Context) SMIMEARecordOutput {
+	return o
+}
+
+func (o SMIMEARecordOutput) ToSMIMEARecordPtrOutput() SMIMEARecordPtrOutput {
+	return o.ToSMIMEARecordPtrOutputWithContext(context.Background())
+}
+
+func (o SMIMEARecordOutput) ToSMIMEARecordPtrOutputWithContext(ctx context.Context) SMIMEARecordPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v SMIMEARecord) *SMIMEARecord {
+		return &v
+	}).(SMIMEARecordPtrOutput)
+}
+
+// The PEM encoded S/MIME certificate of the email address
+func (o SMIMEARecordOutput) Certificate() pulumi.StringOutput {
+	return o.ApplyT(func(v SMIMEARecord) string { return v.Certificate }).(pulumi.StringOutput)
+}
+
+// The email address the certificate is issued for, within the domain of the record
+func (o SMIMEARecordOutput) Email() pulumi.StringOutput {
+	return o.ApplyT(func(v SMIMEARecord) string { return v.Email }).(pulumi.StringOutput)
+}
+
+// The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512. Senders need the full certificate to encrypt, so it defaults to 0
+func (o SMIMEARecordOutput) MatchingType() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v SMIMEARecord) *int { return v.MatchingType }).(pulumi.IntPtrOutput)
+}
+
+// The selector: 0 for the full certificate or 1 for the public key
+func (o SMIMEARecordOutput) Selector() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v SMIMEARecord) *int { return v.Selector }).(pulumi.IntPtrOutput)
+}
+
+// The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+func (o SMIMEARecordOutput) Usage() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v SMIMEARecord) *int { return v.Usage }).(pulumi.IntPtrOutput)
+}
+
+type SMIMEARecordPtrOutput struct{ *pulumi.OutputState }
+
+func (SMIMEARecordPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**SMIMEARecord)(nil)).Elem()
+}
+
+func (o SMIMEARecordPtrOutput) ToSMIMEARecordPtrOutput() SMIMEARecordPtrOutput {
+	return o
+}
+
+func (o SMIMEARecordPtrOutput) ToSMIMEARecordPtrOutputWithContext(ctx context.Context) SMIMEARecordPtrOutput {
+	return o
+}
+
+func (o SMIMEARecordPtrOutput) Elem() SMIMEARecordOutput {
+	return o.ApplyT(func(v *SMIMEARecord) SMIMEARecord {
+		if v != nil {
+			return *v
+		}
+		var ret SMIMEARecord
+		return ret
+	}).(SMIMEARecordOutput)
+}
+
+// The PEM encoded S/MIME certificate of the email address
+func (o SMIMEARecordPtrOutput) Certificate() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *SMIMEARecord) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Certificate
+	}).(pulumi.StringPtrOutput)
+}
+
+// The email address the certificate is issued for, within the domain of the record
+func (o SMIMEARecordPtrOutput) Email() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *SMIMEARecord) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Email
+	}).(pulumi.StringPtrOutput)
+}
+
+// The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512. Senders need the full certificate to encrypt, so it defaults to 0
+func (o SMIMEARecordPtrOutput) MatchingType() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *SMIMEARecord) *int {
+		if v == nil {
+			return nil
+		}
+		return v.MatchingType
+	}).(pulumi.IntPtrOutput)
+}
+
+// The selector: 0 for the full certificate or 1 for the public key
+func (o SMIMEARecordPtrOutput) Selector() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *SMIMEARecord) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Selector
+	}).(pulumi.IntPtrOutput)
+}
+
+// The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+func (o SMIMEARecordPtrOutput) Usage() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *SMIMEARecord) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Usage
+	}).(pulumi.IntPtrOutput)
+}
+
+type SRVRecord struct {
+	// The port the service is offered on, between 0 and 65535
+	Port int `pulumi:"port"`
+	// The transport protocol without leading underscore (e.g., 'tcp', 'udp', 'tls')
+	Protocol string `pulumi:"protocol"`
+	// The symbolic name of the service without leading underscore (e.g., 'sip', 'xmpp-client')
+	Service string `pulumi:"service"`
+	// The hostname of the server offering the service, or '.' if the service is not offered
+	Target string `pulumi:"target"`
+	// The relative weight of records with the same priority, between 0 and 65535
+	Weight *int `pulumi:"weight"`
+}
+
+// Defaults sets the appropriate defaults for SRVRecord
+func (val *SRVRecord) Defaults() *SRVRecord {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.Weight == nil {
+		weight_ := 0
+		tmp.Weight = &weight_
+	}
+	return &tmp
+}
+
+// SRVRecordInput is an input type that accepts SRVRecordArgs and SRVRecordOutput values.
+// You can construct a concrete instance of `SRVRecordInput` via:
+//
+//	SRVRecordArgs{...}
+type SRVRecordInput interface {
+	pulumi.Input
+
+	ToSRVRecordOutput() SRVRecordOutput
+	ToSRVRecordOutputWithContext(context.Context) SRVRecordOutput
+}
+
+type SRVRecordArgs struct {
+	// The port the service is offered on, between 0 and 65535
+	Port pulumi.IntInput `pulumi:"port"`
+	// The transport protocol without leading underscore (e.g., 'tcp', 'udp', 'tls')
+	Protocol pulumi.StringInput `pulumi:"protocol"`
+	// The symbolic name of the service without leading underscore (e.g., 'sip', 'xmpp-client')
+	Service pulumi.StringInput `pulumi:"service"`
+	// The hostname of the server offering the service, or '.' if the service is not offered
+	Target pulumi.StringInput `pulumi:"target"`
+	// The relative weight of records with the same priority, between 0 and 65535
+	Weight pulumi.IntPtrInput `pulumi:"weight"`
+}
+
+// Defaults sets the appropriate defaults for SRVRecordArgs
+func (val *SRVRecordArgs) Defaults() *SRVRecordArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.Weight == nil {
+		tmp.Weight = pulumi.IntPtr(0)
+	}
+	return &tmp
+}
+func (SRVRecordArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*SRVRecord)(nil)).Elem()
+}
+
+func (i SRVRecordArgs) ToSRVRecordOutput() SRVRecordOutput {
+	return i.ToSRVRecordOutputWithContext(context.Background())
+}
+
+func (i SRVRecordArgs) ToSRVRecordOutputWithContext(ctx context.Context) SRVRecordOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SRVRecordOutput)
+}
+
+func (i SRVRecordArgs) ToSRVRecordPtrOutput() SRVRecordPtrOutput {
+	return i.ToSRVRecordPtrOutputWithContext(context.Background())
+}
+
+func (i SRVRecordArgs) ToSRVRecordPtrOutputWithContext(ctx context.Context) SRVRecordPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SRVRecordOutput).ToSRVRecordPtrOutputWithContext(ctx)
+}
+
+// SRVRecordPtrInput is an input type that accepts SRVRecordArgs, SRVRecordPtr and SRVRecordPtrOutput values.
+// You can construct a concrete instance of `SRVRecordPtrInput` via:
+//
+//	        SRVRecordArgs{...}
+//
+//	or:
+//
+//	        nil
+type SRVRecordPtrInput interface {
+	pulumi.Input
+
+	ToSRVRecordPtrOutput() SRVRecordPtrOutput
+	ToSRVRecordPtrOutputWithContext(context.Context) SRVRecordPtrOutput
+}
+
+type srvrecordPtrType SRVRecordArgs
+
+func SRVRecordPtr(v *SRVRecordArgs) SRVRecordPtrInput {
+	return (*srvrecordPtrType)(v)
+}
+
+func (*srvrecordPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**SRVRecord)(nil)).Elem()
+}
+
+func (i *srvrecordPtrType) ToSRVRecordPtrOutput() SRVRecordPtrOutput {
+	return i.ToSRVRecordPtrOutputWithContext(context.Background())
+}
+
+func (i *srvrecordPtrType) ToSRVRecordPtrOutputWithContext(ctx context.Context) SRVRecordPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SRVRecordPtrOutput)
+}
+
+type SRVRecordOutput struct{ *pulumi.OutputState }
+
+func (SRVRecordOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SRVRecord)(nil)).Elem()
+}
+
+func (o SRVRecordOutput) ToSRVRecordOutput() SRVRecordOutput {
+	return o
+}
+
+func (o SRVRecordOutput) ToSRVRecordOutputWithContext(ctx context.Context) SRVRecordOutput {
+	return o
+}
+
+func (o SRVRecordOutput) ToSRVRecordPtrOutput() SRVRecordPtrOutput {
+	return o.ToSRVRecordPtrOutputWithContext(context.Background())
+}
+
+func (o SRVRecordOutput) ToSRVRecordPtrOutputWithContext(ctx context.Context) SRVRecordPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v SRVRecord) *SRVRecord {
+		return &v
+	}).(SRVRecordPtrOutput)
+}
+
+// The port the service is offered on, between 0 and 65535
+func (o SRVRecordOutput) Port() pulumi.IntOutput {
+	return o.ApplyT(func(v SRVRecord) int { return v.Port }).(pulumi.IntOutput)
+}
+
+// The transport protocol without leading underscore (e.g., 'tcp', 'udp', 'tls')
+func (o SRVRecordOutput) Protocol() pulumi.StringOutput {
+	return o.ApplyT(func(v SRVRecord) string { return v.Protocol }).(pulumi.StringOutput)
+}
+
+// The symbolic name of the service without leading underscore (e.g., 'sip', 'xmpp-client')
+func (o SRVRecordOutput) Service() pulumi.StringOutput {
+	return o.ApplyT(func(v SRVRecord) string { return v.Service }).(pulumi.StringOutput)
+}
+
+// The hostname of the server offering the service, or '.' if the service is not offered
+func (o SRVRecordOutput) Target() pulumi.StringOutput {
+	return o.ApplyT(func(v SRVRecord) string { return v.Target }).(pulumi.StringOutput)
+}
+
+// The relative weight of records with the same priority, between 0 and 65535
+func (o SRVRecordOutput) Weight() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v SRVRecord) *int { return v.Weight }).(pulumi.IntPtrOutput)
+}
+
+type SRVRecordPtrOutput struct{ *pulumi.OutputState }
+
+func (SRVRecordPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**SRVRecord)(nil)).Elem()
+}
+
+func (o SRVRecordPtrOutput) ToSRVRecordPtrOutput() SRVRecordPtrOutput {
+	return o
+}
+
+func (o SRVRecordPtrOutput) ToSRVRecordPtrOutputWithContext(ctx context.Context) SRVRecordPtrOutput {
+	return o
+}
+
+func (o SRVRecordPtrOutput) Elem() SRVRecordOutput {
+	return o.ApplyT(func(v *SRVRecord) SRVRecord {
+		if v != nil {
+			return *v
+		}
+		var ret SRVRecord
+		return ret
+	}).(SRVRecordOutput)
+}
+
+// The port the service is offered on, between 0 and 65535
+func (o SRVRecordPtrOutput) Port() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *SRVRecord) *int {
+		if v == nil {
+			return nil
+		}
+		return &v.Port
+	}).(pulumi.IntPtrOutput)
+}
+
+// The transport protocol without leading underscore (e.g., 'tcp', 'udp', 'tls')
+func (o SRVRecordPtrOutput) Protocol() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *SRVRecord) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Protocol
+	}).(pulumi.StringPtrOutput)
+}
+
+// The symbolic name of the service without leading underscore (e.g., 'sip', 'xmpp-client')
+func (o SRVRecordPtrOutput) Service() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *SRVRecord) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Service
+	}).(pulumi.StringPtrOutput)
+}
+
+// The hostname of the server offering the service, or '.' if the service is not offered
+func (o SRVRecordPtrOutput) Target() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *SRVRecord) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Target
+	}).(pulumi.StringPtrOutput)
+}
+
+// The relative weight of records with the same priority, between 0 and 65535
+func (o SRVRecordPtrOutput) Weight() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *SRVRecord) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Weight
+	}).(pulumi.IntPtrOutput)
+}
+
+type SSHFPRecordEntry struct {
+	// The SSHFP algorithm number: 1 (RSA), 3 (ECDSA) or 4 (Ed25519)
+	Algorithm int `pulumi:"algorithm"`
+	// The hex encoded fingerprint of the key
+	Fingerprint string `pulumi:"fingerprint"`
+	// The fingerprint type: 1 (SHA-1) or 2 (SHA-256)
+	FingerprintType int `pulumi:"fingerprintType"`
+	// The value of the SSHFP record
+	Value string `pulumi:"value"`
+}
+
+type SSHFPRecordEntryOutput struct{ *pulumi.OutputState }
+
+func (SSHFPRecordEntryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SSHFPRecordEntry)(nil)).Elem()
+}
+
+func (o SSHFPRecordEntryOutput) ToSSHFPRecordEntryOutput() SSHFPRecordEntryOutput {
+	return o
+}
+
+func (o SSHFPRecordEntryOutput) ToSSHFPRecordEntryOutputWithContext(ctx context.Context) SSHFPRecordEntryOutput {
+	return o
+}
+
+// The SSHFP algorithm number: 1 (RSA), 3 (ECDSA) or 4 (Ed25519)
+func (o SSHFPRecordEntryOutput) Algorithm() pulumi.IntOutput {
+	return o.ApplyT(func(v SSHFPRecordEntry) int { return v.Algorithm }).(pulumi.IntOutput)
+}
+
+// The hex encoded fingerprint of the key
+func (o SSHFPRecordEntryOutput) Fingerprint() pulumi.StringOutput {
+	return o.ApplyT(func(v SSHFPRecordEntry) string { return v.Fingerprint }).(pulumi.StringOutput)
+}
+
+// The fingerprint type: 1 (SHA-1) or 2 (SHA-256)
+func (o SSHFPRecordEntryOutput) FingerprintType() pulumi.IntOutput {
+	return o.ApplyT(func(v SSHFPRecordEntry) int { return v.FingerprintType }).(pulumi.IntOutput)
+}
+
+// The value of the SSHFP record
+func (o SSHFPRecordEntryOutput) Value() pulumi.StringOutput {
+	return o.ApplyT(func(v SSHFPRecordEntry) string { return v.Value }).(pulumi.StringOutput)
+}
+
+type SSHFPRecordEntryArrayOutput struct{ *pulumi.OutputState }
+
+func (SSHFPRecordEntryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]SSHFPRecordEntry)(nil)).Elem()
+}
+
+func (o SSHFPRecordEntryArrayOutput) ToSSHFPRecordEntryArrayOutput() SSHFPRecordEntryArrayOutput {
+	return o
+}
+
+func (o SSHFPRecordEntryArrayOutput) ToSSHFPRecordEntryArrayOutputWithContext(ctx context.Context) SSHFPRecordEntryArrayOutput {
+	return o
+}
+
+func (o SSHFPRecordEntryArrayOutput) Index(i pulumi.IntInput) SSHFPRecordEntryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) SSHFPRecordEntry {
+		return vs[0].([]SSHFPRecordEntry)[vs[1].(int)]
+	}).(SSHFPRecordEntryOutput)
+}
+
+type SSHFPRecordSet struct {
+	// The RSA, ECDSA or Ed25519 host keys in OpenSSH authorized_keys format
+	PublicKeys []string `pulumi:"publicKeys"`
+}
+
+// SSHFPRecordSetInput is an input type that accepts SSHFPRecordSetArgs and SSHFPRecordSetOutput values.
+// You can construct a concrete instance of `SSHFPRecordSetInput` via:
+//
+//	SSHFPRecordSetArgs{...}
+type SSHFPRecordSetInput interface {
+	pulumi.Input
+
+	ToSSHFPRecordSetOutput() SSHFPRecordSetOutput
+	ToSSHFPRecordSetOutputWithContext(context.Context) SSHFPRecordSetOutput
+}
+
+type SSHFPRecordSetArgs struct {
+	// The RSA, ECDSA or Ed25519 host keys in OpenSSH authorized_keys format
+	PublicKeys pulumi.StringArrayInput `pulumi:"publicKeys"`
+}
+
+func (SSHFPRecordSetArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*SSHFPRecordSet)(nil)).Elem()
+}
+
+func (i SSHFPRecordSetArgs) ToSSHFPRecordSetOutput() SSHFPRecordSetOutput {
+	return i.ToSSHFPRecordSetOutputWithContext(context.Background())
+}
+
+func (i SSHFPRecordSetArgs) ToSSHFPRecordSetOutputWithContext(ctx context.Context) SSHFPRecordSetOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SSHFPRecordSetOutput)
+}
+
+func (i SSHFPRecordSetArgs) ToSSHFPRecordSetPtrOutput() SSHFPRecordSetPtrOutput {
+	return i.ToSSHFPRecordSetPtrOutputWithContext(context.Background())
+}
+
+func (i SSHFPRecordSetArgs) ToSSHFPRecordSetPtrOutputWithContext(ctx context.Context) SSHFPRecordSetPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SSHFPRecordSetOutput).ToSSHFPRecordSetPtrOutputWithContext(ctx)
+}
+
+// SSHFPRecordSetPtrInput is an input type that accepts SSHFPRecordSetArgs, SSHFPRecordSetPtr and SSHFPRecordSetPtrOutput values.
+// You can construct a concrete instance of `SSHFPRecordSetPtrInput` via:
+//
+//	        SSHFPRecordSetArgs{...}
+//
+//	or:
+//
+//	        nil
+type SSHFPRecordSetPtrInput interface {
+	pulumi.Input
+
+	ToSSHFPRecordSetPtrOutput() SSHFPRecordSetPtrOutput
+	ToSSHFPRecordSetPtrOutputWithContext(context.Context) SSHFPRecordSetPtrOutput
+}
+
+type sshfprecordSetPtrType SSHFPRecordSetArgs
+
+func SSHFPRecordSetPtr(v *SSHFPRecordSetArgs) SSHFPRecordSetPtrInput {
+	return (*sshfprecordSetPtrType)(v)
+}
+
+func (*sshfprecordSetPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**SSHFPRecordSet)(nil)).Elem()
+}
+
+func (i *sshfprecordSetPtrType) ToSSHFPRecordSetPtrOutput() SSHFPRecordSetPtrOutput {
+	return i.ToSSHFPRecordSetPtrOutputWithContext(context.Background())
+}
+
+func (i *sshfprecordSetPtrType) ToSSHFPRecordSetPtrOutputWithContext(ctx context.Context) SSHFPRecordSetPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SSHFPRecordSetPtrOutput)
+}
+
+type SSHFPRecordSetOutput struct{ *pulumi.OutputState }
+
+func (SSHFPRecordSetOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SSHFPRecordSet)(nil)).Elem()
+}
+
+func (o SSHFPRecordSetOutput) ToSSHFPRecordSetOutput() SSHFPRecordSetOutput {
+	return o
+}
+
+func (o SSHFPRecordSetOutput) ToSSHFPRecordSetOutputWithContext(ctx context.Context) SSHFPRecordSetOutput {
+	return o
+}
+
+func (o SSHFPRecordSetOutput) ToSSHFPRecordSetPtrOutput() SSHFPRecordSetPtrOutput {
+	return o.ToSSHFPRecordSetPtrOutputWithContext(context.Background())
+}
+
+func (o SSHFPRecordSetOutput) ToSSHFPRecordSetPtrOutputWithContext(ctx context.Context) SSHFPRecordSetPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v SSHFPRecordSet) *SSHFPRecordSet {
+		return &v
+	}).(SSHFPRecordSetPtrOutput)
+}
+
+// The RSA, ECDSA or Ed25519 host keys in OpenSSH authorized_keys format
+func (o SSHFPRecordSetOutput) PublicKeys() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v SSHFPRecordSet) []string { return v.PublicKeys }).(pulumi.StringArrayOutput)
+}
+
+type SSHFPRecordSetPtrOutput struct{ *pulumi.OutputState }
+
+func (SSHFPRecordSetPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**SSHFPRecordSet)(nil)).Elem()
+}
+
+func (o SSHFPRecordSetPtrOutput) ToSSHFPRecordSetPtrOutput() SSHFPRecordSetPtrOutput {
+	return o
+}
+
+func (o SSHFPRecordSetPtrOutput) ToSSHFPRecordSetPtrOutputWithContext(ctx context.Context) SSHFPRecordSetPtrOutput {
+	return o
+}
+
+func (o SSHFPRecordSetPtrOutput) Elem() SSHFPRecordSetOutput {
+	return o.ApplyT(func(v *SSHFPRecordSet) SSHFPRecordSet {
+		if v != nil {
+			return *v
+		}
+		var ret SSHFPRecordSet
+		return ret
+	}).(SSHFPRecordSetOutput)
+}
+
+// The RSA, ECDSA or Ed25519 host keys in OpenSSH authorized_keys format
+func (o SSHFPRecordSetPtrOutput) PublicKeys() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *SSHFPRecordSet) []string {
+		if v == nil {
+			return nil
+		}
+		return v.PublicKeys
+	}).(pulumi.StringArrayOutput)
+}
+
+type TLSARecord struct {
+	// The PEM encoded certificate or public key (SubjectPublicKeyInfo) the record is derived from. Of a certificate chain, the first certificate is used
+	Certificate string `pulumi:"certificate"`
+	// The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512
+	MatchingType *int `pulumi:"matchingType"`
+	// The port the TLS service is offered on, e.g. 25 for SMTP
+	Port int `pulumi:"port"`
+	// The transport protocol of the service without leading underscore
+	Protocol *string `pulumi:"protocol"`
+	// The selector: 0 for the full certificate or 1 for the public key
+	Selector *int `pulumi:"selector"`
+	// The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+	Usage *int `pulumi:"usage"`
+}
+
+// Defaults sets the appropriate defaults for TLSARecord
+func (val *TLSARecord) Defaults() *TLSARecord {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.MatchingType == nil {
+		matchingType_ := 1
+		tmp.MatchingType = &matchingType_
+	}
+	if tmp.Protocol == nil {
+		protocol_ := "tcp"
+		tmp.Protocol = &protocol_
+	}
+	if tmp.Selector == nil {
+		selector_ := 1
+		tmp.Selector = &selector_
+	}
+	if tmp.Usage == nil {
+		usage_ := 3
+		tmp.Usage = &usage_
+	}
+	return &tmp
+}
+
+// TLSARecordInput is an input type that accepts TLSARecordArgs and TLSARecordOutput values.
+// You can construct a concrete instance of `TLSARecordInput` via:
+//
+//	TLSARecordArgs{...}
+type TLSARecordInput interface {
+	pulumi.Input
+
+	ToTLSARecordOutput() TLSARecordOutput
+	ToTLSARecordOutputWithContext(context.Context) TLSARecordOutput
+}
+
+type TLSARecordArgs struct {
+	// The PEM encoded certificate or public key (SubjectPublicKeyInfo) the record is derived from. Of a certificate chain, the first certificate is used
+	Certificate pulumi.StringInput `pulumi:"certificate"`
+	// The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512
+	MatchingType pulumi.IntPtrInput `pulumi:"matchingType"`
+	// The port the TLS service is offered on, e.g. 25 for SMTP
+	Port pulumi.IntInput `pulumi:"port"`
+	// The transport protocol of the service without leading underscore
+	Protocol pulumi.StringPtrInput `pulumi:"protocol"`
+	// The selector: 0 for the full certificate or 1 for the public key
+	Selector pulumi.IntPtrInput `pulumi:"selector"`
+	// The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+	Usage pulumi.IntPtrInput `pulumi:"usage"`
+}
+
+// Defaults sets the appropriate defaults for TLSARecordArgs
+func (val *TLSARecordArgs) Defaults() *TLSARecordArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.MatchingType == nil {
+		tmp.MatchingType = pulumi.IntPtr(1)
+	}
+	if tmp.Protocol == nil {
+		tmp.Protocol = pulumi.StringPtr("tcp")
+	}
+	if tmp.Selector == nil {
+		tmp.Selector = pulumi.IntPtr(1)
+	}
+	if tmp.Usage == nil {
+		tmp.Usage = pulumi.IntPtr(3)
+	}
+	return &tmp
+}
+func (TLSARecordArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*TLSARecord)(nil)).Elem()
+}
+
+func (i TLSARecordArgs) ToTLSARecordOutput() TLSARecordOutput {
+	return i.ToTLSARecordOutputWithContext(context.Background())
+}
+
+func (i TLSARecordArgs) ToTLSARecordOutputWithContext(ctx context.Context) TLSARecordOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TLSARecordOutput)
+}
+
+func (i TLSARecordArgs) ToTLSARecordPtrOutput() TLSARecordPtrOutput {
+	return i.ToTLSARecordPtrOutputWithContext(context.Background())
+}
+
+func (i TLSARecordArgs) ToTLSARecordPtrOutputWithContext(ctx context.Context) TLSARecordPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TLSARecordOutput).ToTLSARecordPtrOutputWithContext(ctx)
+}
+
+// TLSARecordPtrInput is an input type that accepts TLSARecordArgs, TLSARecordPtr and TLSARecordPtrOutput values.
+// You can construct a concrete instance of `TLSARecordPtrInput` via:
+//
+//	        TLSARecordArgs{...}
+//
+//	or:
+//
+//	        nil
+type TLSARecordPtrInput interface {
+	pulumi.Input
+
+	ToTLSARecordPtrOutput() TLSARecordPtrOutput
+	ToTLSARecordPtrOutputWithContext(context.Context) TLSARecordPtrOutput
+}
+
+type tlsarecordPtrType TLSARecordArgs
+
+func TLSARecordPtr(v *TLSARecordArgs) TLSARecordPtrInput {
+	return (*tlsarecordPtrType)(v)
+}
+
+func (*tlsarecordPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**TLSARecord)(nil)).Elem()
+}
+
+func (i *tlsarecordPtrType) ToTLSARecordPtrOutput() TLSARecordPtrOutput {
+	return i.ToTLSARecordPtrOutputWithContext(context.Background())
+}
+
+func (i *tlsarecordPtrType) ToTLSARecordPtrOutputWithContext(ctx context.Context) TLSARecordPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TLSARecordPtrOutput)
+}
+
+type TLSARecordOutput struct{ *pulumi.OutputState }
+
+func (TLSARecordOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*TLSARecord)(nil)).Elem()
+}
+
+func (o TLSARecordOutput) ToTLSARecordOutput() TLSARecordOutput {
+	return o
+}
+
+func (o TLSARecordOutput) ToTLSARecordOutputWithContext(ctx context.Context) TLSARecordOutput {
+	return o
+}
+
+func (o TLSARecordOutput) ToTLSARecordPtrOutput() TLSARecordPtrOutput {
+	return o.ToTLSARecordPtrOutputWithContext(context.Background())
+}
+
+func (o TLSARecordOutput) ToTLSARecordPtrOutputWithContext(ctx context.Context) TLSARecordPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v TLSARecord) *TLSARecord {
+		return &v
+	}).(TLSARecordPtrOutput)
+}
+
+// The PEM encoded certificate or public key (SubjectPublicKeyInfo) the record is derived from. Of a certificate chain, the first certificate is used
+func (o TLSARecordOutput) Certificate() pulumi.StringOutput {
+	return o.ApplyT(func(v TLSARecord) string { return v.Certificate }).(pulumi.StringOutput)
+}
+
+// The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512
+func (o TLSARecordOutput) MatchingType() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v TLSARecord) *int { return v.MatchingType }).(pulumi.IntPtrOutput)
+}
+
+// The port the TLS service is offered on, e.g. 25 for SMTP
+func (o TLSARecordOutput) Port() pulumi.IntOutput {
+	return o.ApplyT(func(v TLSARecord) int { return v.Port }).(pulumi.IntOutput)
+}
+
+// The transport protocol of the service without leading underscore
+func (o TLSARecordOutput) Protocol() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v TLSARecord) *string { return v.Protocol }).(pulumi.StringPtrOutput)
+}
+
+// The selector: 0 for the full certificate or 1 for the public key
+func (o TLSARecordOutput) Selector() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v TLSARecord) *int { return v.Selector }).(pulumi.IntPtrOutput)
+}
+
+// The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+func (o TLSARecordOutput) Usage() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v TLSARecord) *int { return v.Usage }).(pulumi.IntPtrOutput)
+}
+
+type TLSARecordPtrOutput struct{ *pulumi.OutputState }
+
+func (TLSARecordPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**TLSARecord)(nil)).Elem()
+}
+
+func (o TLSARecordPtrOutput) ToTLSARecordPtrOutput() TLSARecordPtrOutput {
+	return o
+}
+
+func (o TLSARecordPtrOutput) ToTLSARecordPtrOutputWithContext(ctx context.Context) TLSARecordPtrOutput {
+	return o
+}
+
+func (o TLSARecordPtrOutput) Elem() TLSARecordOutput {
+	return o.ApplyT(func(v *TLSARecord) TLSARecord {
+		if v != nil {
+			return *v
+		}
+		var ret TLSARecord
+		return ret
+	}).(TLSARecordOutput)
+}
+
+// The PEM encoded certificate or public key (SubjectPublicKeyInfo) the record is derived from. Of a certificate chain, the first certificate is used
+func (o TLSARecordPtrOutput) Certificate() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *TLSARecord) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Certificate
+	}).(pulumi.StringPtrOutput)
+}
+
+// The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512
+func (o TLSARecordPtrOutput) MatchingType() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *TLSARecord) *int {
+		if v == nil {
+			return nil
+		}
+		return v.MatchingType
+	}).(pulumi.IntPtrOutput)
+}
+
+// The port the TLS service is offered on, e.g. 25 for SMTP
+func (o TLSARecordPtrOutput) Port() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *TLSARecord) *int {
+		if v == nil {
+			return nil
+		}
+		return &v.Port
+	}).(pulumi.IntPtrOutput)
+}
+
+// The transport protocol of the service without leading underscore
+func (o TLSARecordPtrOutput) Protocol() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *TLSARecord) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Protocol
+	}).(pulumi.StringPtrOutput)
+}
+
+// The selector: 0 for the full certificate or 1 for the public key
+func (o TLSARecordPtrOutput) Selector() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *TLSARecord) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Selector
+	}).(pulumi.IntPtrOutput)
+}
+
+// The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+func (o TLSARecordPtrOutput) Usage() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *TLSARecord) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Usage
+	}).(pulumi.IntPtrOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*CAARecordInput)(nil)).Elem(), CAARecordArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CAARecordPtrInput)(nil)).Elem(), CAARecordArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DNSRecordFilterInput)(nil)).Elem(), DNSRecordFilterArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DNSRecordFilterArrayInput)(nil)).Elem(), DNSRecordFilterArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DNSRecordSetValueInput)(nil)).Elem(), DNSRecordSetValueArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DNSRecordSetValueArrayInput)(nil)).Elem(), DNSRecordSetValueArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DNSZoneRecordInput)(nil)).Elem(), DNSZoneRecordArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DNSZoneRecordArrayInput)(nil)).Elem(), DNSZoneRecordArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*OPENPGPKEYRecordInput)(nil)).Elem(), OPENPGPKEYRecordArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*OPENPGPKEYRecordPtrInput)(nil)).Elem(), OPENPGPKEYRecordArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SMIMEARecordInput)(nil)).Elem(), SMIMEARecordArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SMIMEARecordPtrInput)(nil)).Elem(), SMIMEARecordArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SRVRecordInput)(nil)).Elem(), SRVRecordArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SRVRecordPtrInput)(nil)).Elem(), SRVRecordArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SSHFPRecordSetInput)(nil)).Elem(), SSHFPRecordSetArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SSHFPRecordSetPtrInput)(nil)).Elem(), SSHFPRecordSetArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*TLSARecordInput)(nil)).Elem(), TLSARecordArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*TLSARecordPtrInput)(nil)).Elem(), TLSARecordArgs{})
+	pulumi.RegisterOutputType(CAARecordOutput{})
+	pulumi.RegisterOutputType(CAARecordPtrOutput{})
+	pulumi.RegisterOutputType(DNSRecordEntryOutput{})
+	pulumi.RegisterOutputType(DNSRecordEntryArrayOutput{})
+	pulumi.RegisterOutputType(DNSRecordFilterOutput{})
+	pulumi.RegisterOutputType(DNSRecordFilterArrayOutput{})
+	pulumi.RegisterOutputType(DNSRecordSetValueOutput{})
+	pulumi.RegisterOutputType(DNSRecordSetValueArrayOutput{})
+	pulumi.RegisterOutputType(DNSZoneRecordOutput{})
+	pulumi.RegisterOutputType(DNSZoneRecordArrayOutput{})
+	pulumi.RegisterOutputType(DomainDetailsOutput{})
+	pulumi.RegisterOutputType(DomainDetailsArrayOutput{})
+	pulumi.RegisterOutputType(DomainHandleDetailsOutput{})
+	pulumi.RegisterOutputType(DomainHandleDetailsArrayOutput{})
+	pulumi.RegisterOutputType(OPENPGPKEYRecordOutput{})
+	pulumi.RegisterOutputType(OPENPGPKEYRecordPtrOutput{})
+	pulumi.RegisterOutputType(SMIMEARecordOutput{})
+	pulumi.RegisterOutputType(SMIMEARecordPtrOutput{})
+	pulumi.RegisterOutputType(SRVRecordOutput{})
+	pulumi.RegisterOutputType(SRVRecordPtrOutput{})
+	pulumi.RegisterOutputType(SSHFPRecordEntryOutput{})
+	pulumi.RegisterOutputType(SSHFPRecordEntryArrayOutput{})
+	pulumi.RegisterOutputType(SSHFPRecordSetOutput{})
+	pulumi.RegisterOutputType(SSHFPRecordSetPtrOutput{})
+	pulumi.RegisterOutputType(TLSARecordOutput{})
+	pulumi.RegisterOutputType(TLSARecordPtrOutput{})
+}
@@ -8,34 +8,122 @@ declare var exports: any;
 const __config = new pulumi.Config("netcup");
 
 /**
- * The Netcup API key for authentication
+ * The Netcup API key for authentication. Can also be set with NETCUP_API_KEY
  */
 export declare const apiKey: string | undefined;
 Object.defineProperty(exports, "apiKey", {
     get() {
-        return __config.get("apiKey");
+        return __config.get("apiKey") ?? utilities.getEnv("NETCUP_API_KEY");
     },
     enumerable: true,
 });
 
 /**
- * The Netcup API password for authentication
+ * The Netcup API password for authentication. Can also be set with NETCUP_API_PASSWORD
  */
 export declare const apiPassword: string | undefined;
 Object.defineProperty(exports, "apiPassword", {
     get() {
-        return __config.get("apiPassword");
+        return __config.get("apiPassword") ?? utilities.getEnv("NETCUP_API_PASSWORD");
     },
     enumerable: true,
 });
 
 /**
- * The Netcup customer ID
+ * Path to a PEM file with additional CA certificates trusted for API requests
+ */
+export declare const caBundle: string | undefined;
+Object.defineProperty(exports, "caBundle", {
+    get() {
+        return __config.get("caBundle");
+    },
+    enumerable: true,
+});
+
+/**
+ * The Netcup customer ID. Can also be set with NETCUP_CUSTOMER_ID
  */
 export declare const customerId: string | undefined;
 Object.defineProperty(exports, "customerId", {
     get() {
-        return __config.get("customerId");
+        return __config.get("customerId") ?? utilities.getEnv("NETCUP_CUSTOMER_ID");
+    },
+    enumerable: true,
+});
+
+/**
+ * The URL of the Netcup CCP JSON API. Defaults to https://ccp.netcup.net/run/webservice/servers/endpoint.php?JSON
+ */
+export declare const endpoint: string | undefined;
+Object.defineProperty(exports, "endpoint", {
+    get() {
+        return __config.get("endpoint");
+    },
+    enumerable: true,
+});
+
+/**
+ * How often a rate-limited (status 2057) or transiently failed API request is retried. Defaults to 5
+ */
+export declare const maxRetries: number | undefined;
+Object.defineProperty(exports, "maxRetries", {
+    get() {
+        return __config.getObject<number>("maxRetries");
+    },
+    enumerable: true,
+});
+
+/**
+ * The nameserver (host:port) DNSKEY records of DNSSEC signed zones are read from. Defaults to root-dns.netcup.net:53
+ */
+export declare const nameserver: string | undefined;
+Object.defineProperty(exports, "nameserver", {
+    get() {
+        return __config.get("nameserver");
+    },
+    enumerable: true,
+});
+
+/**
+ * The URL of an HTTP proxy for API requests. Defaults to the HTTPS_PROXY and NO_PROXY environment variables
+ */
+export declare const proxyUrl: string | undefined;
+Object.defineProperty(exports, "proxyUrl", {
+    get() {
+        return __config.get("proxyUrl");
+    },
+    enumerable: true,
+});
+
+/**
+ * The timeout of a single API request in seconds. Defaults to 30
+ */
+export declare const requestTimeout: number | undefined;
+Object.defineProperty(exports, "requestTimeout", {
+    get() {
+        return __config.getObject<number>("requestTimeout");
+    },
+    enumerable: true,
+});
+
+/**
+ * The maximum number of Netcup API requests per minute made by the provider. The limit is shared by all provider instances in the process. Defaults to 180
+ */
+export declare const requestsPerMinute: number | undefined;
+Object.defineProperty(exports, "requestsPerMinute", {
+    get() {
+        return __config.getObject<number>("requestsPerMinute");
+    },
+    enumerable: true,
+});
+
+/**
+ * The User-Agent header sent with API requests
+ */
+export declare const userAgent: string | undefined;
+Object.defineProperty(exports, "userAgent", {
+    get() {
+        return __config.get("userAgent");
     },
     enumerable: true,
 });
@@ -2,10 +2,12 @@
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
 import * as utilities from "./utilities";
 
 /**
- * A DNS record managed by Netcup DNS service
+ * A DNS record managed by Netcup DNS service. Existing records are imported with the ID 'domain:recordID' or by content with 'domain/name/type' or 'domain/name/type/value'
  */
 export class DNSRecord extends pulumi.CustomResource {
     /**
@@ -34,6 +36,10 @@ export class DNSRecord extends pulumi.CustomResource {
         return obj['__pulumiType'] === DNSRecord.__pulumiType;
     }
 
+    /**
+     * The structured inputs of a CAA record
+     */
+    public readonly caa!: pulumi.Output<outputs.CAARecord | undefined>;
     /**
      * The domain name for the DNS record
      */
@@ -45,7 +51,11 @@ export class DNSRecord extends pulumi.CustomResource {
     /**
      * The hostname for the DNS record
      */
-    public readonly name!: pulumi.Output<string>;
+    public readonly name!: pulumi.Output<string | undefined>;
+    /**
+     * The inputs the OPENPGPKEY record is derived from
+     */
+    public readonly openpgpkey!: pulumi.Output<outputs.OPENPGPKEYRecord | undefined>;
     /**
      * The priority for the DNS record
      */
@@ -54,6 +64,18 @@ export class DNSRecord extends pulumi.CustomResource {
      * The unique identifier for the DNS record
      */
     public /*out*/ readonly recordId!: pulumi.Output<string>;
+    /**
+     * The inputs the SMIMEA record is derived from
+     */
+    public readonly smimea!: pulumi.Output<outputs.SMIMEARecord | undefined>;
+    /**
+     * The structured inputs of an SRV record
+     */
+    public readonly srv!: pulumi.Output<outputs.SRVRecord | undefined>;
+    /**
+     * The inputs the TLSA record is derived from
+     */
+    public readonly tlsa!: pulumi.Output<outputs.TLSARecord | undefined>;
     /**
      * The DNS record type
      */
@@ -61,7 +83,7 @@ export class DNSRecord extends pulumi.CustomResource {
     /**
      * The value/destination for the DNS record
      */
-    public readonly value!: pulumi.Output<string>;
+    public readonly value!: pulumi.Output<string | undefined>;
 
     /**
      * Create a DNSRecord resource with the given unique name, arguments, and options.
@@ -77,28 +99,32 @@ export class DNSRecord extends pulumi.CustomResource {
             if ((!args || args.domain === undefined) && !opts.urn) {
                 throw new Error("Missing required property 'domain'");
             }
-            if ((!args || args.name === undefined) && !opts.urn) {
-                throw new Error("Missing required property 'name'");
-            }
             if ((!args || args.type === undefined) && !opts.urn) {
                 throw new Error("Missing required property 'type'");
             }
-            if ((!args || args.value === undefined) && !opts.urn) {
-                throw new Error("Missing required property 'value'");
-            }
+            resourceInputs["caa"] = args ? (args.caa ? pulumi.output(args.caa).apply(inputs.caarecordArgsProvideDefaults) : undefined) : undefined;
             resourceInputs["domain"] = args ? args.domain : undefined;
             resourceInputs["name"] = args ? args.name : undefined;
+            resourceInputs["openpgpkey"] = args ? args.openpgpkey : undefined;
             resourceInputs["priority"] = args ? args.priority : undefined;
+            resourceInputs["smimea"] = args ? (args.smimea ? pulumi.output(args.smimea).apply(inputs.smimearecordArgsProvideDefaults) : undefined) : undefined;
+            resourceInputs["srv"] = args ? (args.srv ? pulumi.output(args.srv).apply(inputs.srvrecordArgsProvideDefaults) : undefined) : undefined;
+            resourceInputs["tlsa"] = args ? (args.tlsa ? pulumi.output(args.tlsa).apply(inputs.tlsarecordArgsProvideDefaults) : undefined) : undefined;
             resourceInputs["type"] = args ? args.type : undefined;
             resourceInputs["value"] = args ? args.value : undefined;
             resourceInputs["fqdn"] = undefined /*out*/;
             resourceInputs["recordId"] = undefined /*out*/;
         } else {
+            resourceInputs["caa"] = undefined /*out*/;
             resourceInputs["domain"] = undefined /*out*/;
             resourceInputs["fqdn"] = undefined /*out*/;
             resourceInputs["name"] = undefined /*out*/;
+            resourceInputs["openpgpkey"] = undefined /*out*/;
             resourceInputs["priority"] = undefined /*out*/;
             resourceInputs["recordId"] = undefined /*out*/;
+            resourceInputs["smimea"] = undefined /*out*/;
+            resourceInputs["srv"] = undefined /*out*/;
+            resourceInputs["tlsa"] = undefined /*out*/;
             resourceInputs["type"] = undefined /*out*/;
             resourceInputs["value"] = undefined /*out*/;
         }
@@ -111,24 +137,44 @@ export class DNSRecord extends pulumi.CustomResource {
  * The set of arguments for constructing a DNSRecord resource.
  */
 export interface DNSRecordArgs {
+    /**
+     * Structured inputs of a CAA record. The value is composed as 'flags tag "value"'
+     */
+    caa?: pulumi.Input<inputs.CAARecordArgs>;
     /**
      * The domain name for the DNS record (e.g., 'example.com')
      */
     domain: pulumi.Input<string>;
     /**
-     * The hostname for the DNS record. Use '@' for root domain, or specify subdomain (e.g., 'www', 'mail')
+     * The hostname for the DNS record. Use '@' for root domain, or specify subdomain (e.g., 'www', 'mail'). Defaults to '@', for openpgpkey and smimea records to the name derived from the email address
      */
-    name: pulumi.Input<string>;
+    name?: pulumi.Input<string>;
+    /**
+     * Inputs of an OPENPGPKEY record publishing the key of an email address. The name is derived from the hashed local part below '_openpgpkey' and the value is the base64 encoded key
+     */
+    openpgpkey?: pulumi.Input<inputs.OPENPGPKEYRecordArgs>;
     /**
      * The priority for MX and SRV records (required for these types, ignored for others)
      */
     priority?: pulumi.Input<string>;
+    /**
+     * Inputs of an SMIMEA record publishing the S/MIME certificate of an email address. The name is derived from the hashed local part below '_smimecert'
+     */
+    smimea?: pulumi.Input<inputs.SMIMEARecordArgs>;
+    /**
+     * Structured inputs of an SRV record. The value is composed as 'weight port target' and the name is placed below '_service._proto', using '@' for the service of the domain itself
+     */
+    srv?: pulumi.Input<inputs.SRVRecordArgs>;
+    /**
+     * Inputs of a TLSA record derived from a certificate or public key. The association data is computed from the certificate and the name is placed below '_port._proto'
+     */
+    tlsa?: pulumi.Input<inputs.TLSARecordArgs>;
     /**
      * The DNS record type. Supported types: A, AAAA, CNAME, MX, TXT, SRV, CAA, TLSA, NS, DS, OPENPGPKEY, SMIMEA, SSHFP
      */
     type: pulumi.Input<string>;
     /**
-     * The value/destination for the DNS record (e.g., IP address for A records, hostname for CNAME)
+     * The value/destination for the DNS record (e.g., IP address for A records, hostname for CNAME). Required unless the value is composed from structured inputs such as srv
      */
-    value: pulumi.Input<string>;
+    value?: pulumi.Input<string>;
 }
@@ -0,0 +1,133 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * All DNS records of one hostname and type, such as round-robin A records, several MX hosts or multiple TXT values. Records of the hostname and type that are not listed are deleted
+ */
+export class DNSRecordSet extends pulumi.CustomResource {
+    /**
+     * Get an existing DNSRecordSet resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): DNSRecordSet {
+        return new DNSRecordSet(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'netcup:index:DNSRecordSet';
+
+    /**
+     * Returns true if the given object is an instance of DNSRecordSet.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is DNSRecordSet {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === DNSRecordSet.__pulumiType;
+    }
+
+    /**
+     * The domain name for the DNS records
+     */
+    public readonly domain!: pulumi.Output<string>;
+    /**
+     * The fully qualified domain name
+     */
+    public /*out*/ readonly fqdn!: pulumi.Output<string>;
+    /**
+     * The hostname of the DNS records
+     */
+    public readonly name!: pulumi.Output<string>;
+    /**
+     * The Netcup IDs of the records, in the order of values
+     */
+    public /*out*/ readonly recordIds!: pulumi.Output<string[]>;
+    /**
+     * The host keys the values of an SSHFP record set are derived from
+     */
+    public readonly sshfp!: pulumi.Output<outputs.SSHFPRecordSet | undefined>;
+    /**
+     * The DNS record type
+     */
+    public readonly type!: pulumi.Output<string>;
+    /**
+     * The values of the records
+     */
+    public readonly values!: pulumi.Output<outputs.DNSRecordSetValue[] | undefined>;
+
+    /**
+     * Create a DNSRecordSet resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: DNSRecordSetArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.domain === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'domain'");
+            }
+            if ((!args || args.name === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'name'");
+            }
+            if ((!args || args.type === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'type'");
+            }
+            resourceInputs["domain"] = args ? args.domain : undefined;
+            resourceInputs["name"] = args ? args.name : undefined;
+            resourceInputs["sshfp"] = args ? args.sshfp : undefined;
+            resourceInputs["type"] = args ? args.type : undefined;
+            resourceInputs["values"] = args ? args.values : undefined;
+            resourceInputs["fqdn"] = undefined /*out*/;
+            resourceInputs["recordIds"] = undefined /*out*/;
+        } else {
+            resourceInputs["domain"] = undefined /*out*/;
+            resourceInputs["fqdn"] = undefined /*out*/;
+            resourceInputs["name"] = undefined /*out*/;
+            resourceInputs["recordIds"] = undefined /*out*/;
+            resourceInputs["sshfp"] = undefined /*out*/;
+            resourceInputs["type"] = undefined /*out*/;
+            resourceInputs["values"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(DNSRecordSet.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a DNSRecordSet resource.
+ */
+export interface DNSRecordSetArgs {
+    /**
+     * The domain name for the DNS records (e.g., 'example.com')
+     */
+    domain: pulumi.Input<string>;
+    /**
+     * The hostname of the DNS records. Use '@' for root domain
+     */
+    name: pulumi.Input<string>;
+    /**
+     * Host keys the values of an SSHFP record set are derived from, a SHA-1 and a SHA-256 record per key
+     */
+    sshfp?: pulumi.Input<inputs.SSHFPRecordSetArgs>;
+    /**
+     * The DNS record type. Supported types: A, AAAA, CNAME, MX, TXT, SRV, CAA, TLSA, NS, DS, OPENPGPKEY, SMIMEA, SSHFP
+     */
+    type: pulumi.Input<string>;
+    /**
+     * The values of the records, one record per value. Required unless sshfp is set
+     */
+    values?: pulumi.Input<pulumi.Input<inputs.DNSRecordSetValueArgs>[]>;
+}
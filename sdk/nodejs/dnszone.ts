@@ -0,0 +1,141 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * The settings of a DNS zone managed by Netcup DNS service. The zone of the domain must already exist; creating the resource adopts it and deleting the resource leaves the zone and its settings untouched
+ */
+export class DNSZone extends pulumi.CustomResource {
+    /**
+     * Get an existing DNSZone resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): DNSZone {
+        return new DNSZone(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'netcup:index:DNSZone';
+
+    /**
+     * Returns true if the given object is an instance of DNSZone.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is DNSZone {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === DNSZone.__pulumiType;
+    }
+
+    /**
+     * The DNSKEY records of the signed zone in presentation format. Empty while DNSSEC is disabled or Netcup has not finished signing the zone
+     */
+    public /*out*/ readonly dnskeys!: pulumi.Output<string[]>;
+    /**
+     * Whether the zone is signed with DNSSEC
+     */
+    public readonly dnssec!: pulumi.Output<boolean | undefined>;
+    /**
+     * The domain name of the zone
+     */
+    public readonly domain!: pulumi.Output<string>;
+    /**
+     * The SHA-256 DS records of the key signing keys, to be published in the parent zone or at the registrar
+     */
+    public /*out*/ readonly dsRecords!: pulumi.Output<string[]>;
+    /**
+     * The SOA expire time in seconds
+     */
+    public readonly expire!: pulumi.Output<number | undefined>;
+    /**
+     * The SOA refresh interval in seconds
+     */
+    public readonly refresh!: pulumi.Output<number | undefined>;
+    /**
+     * The SOA retry interval in seconds
+     */
+    public readonly retry!: pulumi.Output<number | undefined>;
+    /**
+     * The current SOA serial of the zone
+     */
+    public /*out*/ readonly serial!: pulumi.Output<string>;
+    /**
+     * The default TTL of the zone in seconds
+     */
+    public readonly ttl!: pulumi.Output<number | undefined>;
+
+    /**
+     * Create a DNSZone resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: DNSZoneArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.domain === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'domain'");
+            }
+            resourceInputs["dnssec"] = args ? args.dnssec : undefined;
+            resourceInputs["domain"] = args ? args.domain : undefined;
+            resourceInputs["expire"] = args ? args.expire : undefined;
+            resourceInputs["refresh"] = args ? args.refresh : undefined;
+            resourceInputs["retry"] = args ? args.retry : undefined;
+            resourceInputs["ttl"] = args ? args.ttl : undefined;
+            resourceInputs["dnskeys"] = undefined /*out*/;
+            resourceInputs["dsRecords"] = undefined /*out*/;
+            resourceInputs["serial"] = undefined /*out*/;
+        } else {
+            resourceInputs["dnskeys"] = undefined /*out*/;
+            resourceInputs["dnssec"] = undefined /*out*/;
+            resourceInputs["domain"] = undefined /*out*/;
+            resourceInputs["dsRecords"] = undefined /*out*/;
+            resourceInputs["expire"] = undefined /*out*/;
+            resourceInputs["refresh"] = undefined /*out*/;
+            resourceInputs["retry"] = undefined /*out*/;
+            resourceInputs["serial"] = undefined /*out*/;
+            resourceInputs["ttl"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(DNSZone.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a DNSZone resource.
+ */
+export interface DNSZoneArgs {
+    /**
+     * Whether the zone is signed with DNSSEC. Left unchanged when not set
+     */
+    dnssec?: pulumi.Input<boolean>;
+    /**
+     * The domain name of the zone (e.g., 'example.com')
+     */
+    domain: pulumi.Input<string>;
+    /**
+     * The SOA expire time in seconds (RFC 1912 recommends at least 604800). Left unchanged when not set
+     */
+    expire?: pulumi.Input<number>;
+    /**
+     * The SOA refresh interval in seconds (RFC 1912 recommends at least 1200). Left unchanged when not set
+     */
+    refresh?: pulumi.Input<number>;
+    /**
+     * The SOA retry interval in seconds (RFC 1912 recommends at least 180). Left unchanged when not set
+     */
+    retry?: pulumi.Input<number>;
+    /**
+     * The default TTL of the zone in seconds (RFC 1912 recommends at least 300). Left unchanged when not set
+     */
+    ttl?: pulumi.Input<number>;
+}
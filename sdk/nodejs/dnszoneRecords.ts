@@ -0,0 +1,111 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * The complete record set of a domain managed by Netcup DNS service. Records of the domain that are not listed are deleted unless they match an ignore rule. Do not combine with DNSRecord resources for the same domain
+ */
+export class DNSZoneRecords extends pulumi.CustomResource {
+    /**
+     * Get an existing DNSZoneRecords resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): DNSZoneRecords {
+        return new DNSZoneRecords(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'netcup:index:DNSZoneRecords';
+
+    /**
+     * Returns true if the given object is an instance of DNSZoneRecords.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is DNSZoneRecords {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === DNSZoneRecords.__pulumiType;
+    }
+
+    /**
+     * The domain name whose records are managed
+     */
+    public readonly domain!: pulumi.Output<string>;
+    /**
+     * The rules selecting records that are left alone
+     */
+    public readonly ignore!: pulumi.Output<outputs.DNSRecordFilter[] | undefined>;
+    /**
+     * The Netcup IDs of the records, in the order of records
+     */
+    public /*out*/ readonly recordIds!: pulumi.Output<string[]>;
+    /**
+     * The records of the domain
+     */
+    public readonly records!: pulumi.Output<outputs.DNSZoneRecord[] | undefined>;
+    /**
+     * The zone file the records were taken from
+     */
+    public readonly zoneFile!: pulumi.Output<string | undefined>;
+
+    /**
+     * Create a DNSZoneRecords resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: DNSZoneRecordsArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.domain === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'domain'");
+            }
+            resourceInputs["domain"] = args ? args.domain : undefined;
+            resourceInputs["ignore"] = args ? args.ignore : undefined;
+            resourceInputs["records"] = args ? args.records : undefined;
+            resourceInputs["zoneFile"] = args ? args.zoneFile : undefined;
+            resourceInputs["recordIds"] = undefined /*out*/;
+        } else {
+            resourceInputs["domain"] = undefined /*out*/;
+            resourceInputs["ignore"] = undefined /*out*/;
+            resourceInputs["recordIds"] = undefined /*out*/;
+            resourceInputs["records"] = undefined /*out*/;
+            resourceInputs["zoneFile"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(DNSZoneRecords.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a DNSZoneRecords resource.
+ */
+export interface DNSZoneRecordsArgs {
+    /**
+     * The domain name whose records are managed (e.g., 'example.com')
+     */
+    domain: pulumi.Input<string>;
+    /**
+     * Existing records matching any of these rules are left alone instead of being deleted
+     */
+    ignore?: pulumi.Input<pulumi.Input<inputs.DNSRecordFilterArgs>[]>;
+    /**
+     * The complete list of records the domain should have
+     */
+    records?: pulumi.Input<pulumi.Input<inputs.DNSZoneRecordArgs>[]>;
+    /**
+     * An RFC 1035 zone file (BIND format) to take the records from instead of records. SOA and apex NS records are skipped and record TTLs are ignored
+     */
+    zoneFile?: pulumi.Input<string>;
+}
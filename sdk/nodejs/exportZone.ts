@@ -0,0 +1,67 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Exports the records and zone settings of a domain as an RFC 1035 zone file, a JSON document or an octoDNS YAML zone, for backups, audits and migrations. Records are sorted, so exports of an unchanged zone are identical
+ */
+export function exportZone(args: ExportZoneArgs, opts?: pulumi.InvokeOptions): Promise<ExportZoneResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("netcup:index:exportZone", {
+        "domain": args.domain,
+        "format": args.format,
+    }, opts);
+}
+
+export interface ExportZoneArgs {
+    /**
+     * The domain name to export (e.g., 'example.com')
+     */
+    domain: string;
+    /**
+     * The format of the export: 'bind' for a zone file, 'json' or 'octodns'. Defaults to 'bind'
+     */
+    format?: string;
+}
+
+export interface ExportZoneResult {
+    /**
+     * The exported zone
+     */
+    readonly content: string;
+    /**
+     * The exported domain name
+     */
+    readonly domain: string;
+    /**
+     * The format of the export
+     */
+    readonly format: string;
+    /**
+     * The serial of the zone at the time of the export
+     */
+    readonly serial: string;
+}
+/**
+ * Exports the records and zone settings of a domain as an RFC 1035 zone file, a JSON document or an octoDNS YAML zone, for backups, audits and migrations. Records are sorted, so exports of an unchanged zone are identical
+ */
+export function exportZoneOutput(args: ExportZoneOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<ExportZoneResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("netcup:index:exportZone", {
+        "domain": args.domain,
+        "format": args.format,
+    }, opts);
+}
+
+export interface ExportZoneOutputArgs {
+    /**
+     * The domain name to export (e.g., 'example.com')
+     */
+    domain: pulumi.Input<string>;
+    /**
+     * The format of the export: 'bind' for a zone file, 'json' or 'octodns'. Defaults to 'bind'
+     */
+    format?: pulumi.Input<string>;
+}
@@ -0,0 +1,81 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Returns the DNS records of a domain, optionally filtered by hostname, type or value. Records created outside of Pulumi, such as by the hosting panel, are included
+ */
+export function getDnsRecords(args: GetDnsRecordsArgs, opts?: pulumi.InvokeOptions): Promise<GetDnsRecordsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("netcup:index:getDnsRecords", {
+        "domain": args.domain,
+        "name": args.name,
+        "type": args.type,
+        "valueRegex": args.valueRegex,
+    }, opts);
+}
+
+export interface GetDnsRecordsArgs {
+    /**
+     * The domain name to look up the DNS records of (e.g., 'example.com')
+     */
+    domain: string;
+    /**
+     * Only return records of this hostname. Use '@' for root domain
+     */
+    name?: string;
+    /**
+     * Only return records of this DNS record type
+     */
+    type?: string;
+    /**
+     * Only return records whose value/destination matches this regular expression
+     */
+    valueRegex?: string;
+}
+
+export interface GetDnsRecordsResult {
+    /**
+     * The domain name the DNS records belong to
+     */
+    readonly domain: string;
+    /**
+     * The DNS records matching the filters, in the order Netcup returns them
+     */
+    readonly records: outputs.DNSRecordEntry[];
+}
+/**
+ * Returns the DNS records of a domain, optionally filtered by hostname, type or value. Records created outside of Pulumi, such as by the hosting panel, are included
+ */
+export function getDnsRecordsOutput(args: GetDnsRecordsOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetDnsRecordsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("netcup:index:getDnsRecords", {
+        "domain": args.domain,
+        "name": args.name,
+        "type": args.type,
+        "valueRegex": args.valueRegex,
+    }, opts);
+}
+
+export interface GetDnsRecordsOutputArgs {
+    /**
+     * The domain name to look up the DNS records of (e.g., 'example.com')
+     */
+    domain: pulumi.Input<string>;
+    /**
+     * Only return records of this hostname. Use '@' for root domain
+     */
+    name?: pulumi.Input<string>;
+    /**
+     * Only return records of this DNS record type
+     */
+    type?: pulumi.Input<string>;
+    /**
+     * Only return records whose value/destination matches this regular expression
+     */
+    valueRegex?: pulumi.Input<string>;
+}
@@ -0,0 +1,67 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Returns the registration details of a domain owned by the Netcup customer account
+ */
+export function getDomainInfo(args: GetDomainInfoArgs, opts?: pulumi.InvokeOptions): Promise<GetDomainInfoResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("netcup:index:getDomainInfo", {
+        "domain": args.domain,
+    }, opts);
+}
+
+export interface GetDomainInfoArgs {
+    /**
+     * The domain name to look up (e.g., 'example.com')
+     */
+    domain: string;
+}
+
+export interface GetDomainInfoResult {
+    /**
+     * The domain name
+     */
+    readonly domain: string;
+    /**
+     * The contact handles assigned to the domain
+     */
+    readonly handles: outputs.DomainHandleDetails[];
+    /**
+     * The hostnames of the nameservers the domain is delegated to
+     */
+    readonly nameservers: string[];
+    /**
+     * The end date of the current contract runtime
+     */
+    readonly runtimeEnd: string;
+    /**
+     * The start date of the current contract runtime
+     */
+    readonly runtimeStart: string;
+    /**
+     * The registration state of the domain as reported by Netcup
+     */
+    readonly state: string;
+}
+/**
+ * Returns the registration details of a domain owned by the Netcup customer account
+ */
+export function getDomainInfoOutput(args: GetDomainInfoOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetDomainInfoResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("netcup:index:getDomainInfo", {
+        "domain": args.domain,
+    }, opts);
+}
+
+export interface GetDomainInfoOutputArgs {
+    /**
+     * The domain name to look up (e.g., 'example.com')
+     */
+    domain: pulumi.Input<string>;
+}
@@ -0,0 +1,47 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Returns the SHA-1 and SHA-256 SSHFP records of SSH host keys, such as the contents of /etc/ssh/ssh_host_*_key.pub
+ */
+export function getSshfpRecords(args: GetSshfpRecordsArgs, opts?: pulumi.InvokeOptions): Promise<GetSshfpRecordsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("netcup:index:getSshfpRecords", {
+        "publicKeys": args.publicKeys,
+    }, opts);
+}
+
+export interface GetSshfpRecordsArgs {
+    /**
+     * The RSA, ECDSA or Ed25519 host keys in OpenSSH authorized_keys format
+     */
+    publicKeys: string[];
+}
+
+export interface GetSshfpRecordsResult {
+    /**
+     * The SSHFP records, a SHA-1 and a SHA-256 record per key
+     */
+    readonly records: outputs.SSHFPRecordEntry[];
+}
+/**
+ * Returns the SHA-1 and SHA-256 SSHFP records of SSH host keys, such as the contents of /etc/ssh/ssh_host_*_key.pub
+ */
+export function getSshfpRecordsOutput(args: GetSshfpRecordsOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetSshfpRecordsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("netcup:index:getSshfpRecords", {
+        "publicKeys": args.publicKeys,
+    }, opts);
+}
+
+export interface GetSshfpRecordsOutputArgs {
+    /**
+     * The RSA, ECDSA or Ed25519 host keys in OpenSSH authorized_keys format
+     */
+    publicKeys: pulumi.Input<pulumi.Input<string>[]>;
+}
@@ -10,6 +10,51 @@ export type DNSRecord = import("./dnsrecord").DNSRecord;
 export const DNSRecord: typeof import("./dnsrecord").DNSRecord = null as any;
 utilities.lazyLoad(exports, ["DNSRecord"], () => require("./dnsrecord"));
 
+export { DNSRecordSetArgs } from "./dnsrecordSet";
+export type DNSRecordSet = import("./dnsrecordSet").DNSRecordSet;
+export const DNSRecordSet: typeof import("./dnsrecordSet").DNSRecordSet = null as any;
+utilities.lazyLoad(exports, ["DNSRecordSet"], () => require("./dnsrecordSet"));
+
+export { DNSZoneArgs } from "./dnszone";
+export type DNSZone = import("./dnszone").DNSZone;
+export const DNSZone: typeof import("./dnszone").DNSZone = null as any;
+utilities.lazyLoad(exports, ["DNSZone"], () => require("./dnszone"));
+
+export { DNSZoneRecordsArgs } from "./dnszoneRecords";
+export type DNSZoneRecords = import("./dnszoneRecords").DNSZoneRecords;
+export const DNSZoneRecords: typeof import("./dnszoneRecords").DNSZoneRecords = null as any;
+utilities.lazyLoad(exports, ["DNSZoneRecords"], () => require("./dnszoneRecords"));
+
+export { ExportZoneArgs, ExportZoneResult, ExportZoneOutputArgs } from "./exportZone";
+export const exportZone: typeof import("./exportZone").exportZone = null as any;
+export const exportZoneOutput: typeof import("./exportZone").exportZoneOutput = null as any;
+utilities.lazyLoad(exports, ["exportZone","exportZoneOutput"], () => require("./exportZone"));
+
+export { GetDnsRecordsArgs, GetDnsRecordsResult, GetDnsRecordsOutputArgs } from "./getDnsRecords";
+export const getDnsRecords: typeof import("./getDnsRecords").getDnsRecords = null as any;
+export const getDnsRecordsOutput: typeof import("./getDnsRecords").getDnsRecordsOutput = null as any;
+utilities.lazyLoad(exports, ["getDnsRecords","getDnsRecordsOutput"], () => require("./getDnsRecords"));
+
+export { GetDomainInfoArgs, GetDomainInfoResult, GetDomainInfoOutputArgs } from "./getDomainInfo";
+export const getDomainInfo: typeof import("./getDomainInfo").getDomainInfo = null as any;
+export const getDomainInfoOutput: typeof import("./getDomainInfo").getDomainInfoOutput = null as any;
+utilities.lazyLoad(exports, ["getDomainInfo","getDomainInfoOutput"], () => require("./getDomainInfo"));
+
+export { GetSshfpRecordsArgs, GetSshfpRecordsResult, GetSshfpRecordsOutputArgs } from "./getSshfpRecords";
+export const getSshfpRecords: typeof import("./getSshfpRecords").getSshfpRecords = null as any;
+export const getSshfpRecordsOutput: typeof import("./getSshfpRecords").getSshfpRecordsOutput = null as any;
+utilities.lazyLoad(exports, ["getSshfpRecords","getSshfpRecordsOutput"], () => require("./getSshfpRecords"));
+
+export { ListDomainsArgs, ListDomainsResult } from "./listDomains";
+export const listDomains: typeof import("./listDomains").listDomains = null as any;
+export const listDomainsOutput: typeof import("./listDomains").listDomainsOutput = null as any;
+utilities.lazyLoad(exports, ["listDomains","listDomainsOutput"], () => require("./listDomains"));
+
+export { ParseZoneFileArgs, ParseZoneFileResult, ParseZoneFileOutputArgs } from "./parseZoneFile";
+export const parseZoneFile: typeof import("./parseZoneFile").parseZoneFile = null as any;
+export const parseZoneFileOutput: typeof import("./parseZoneFile").parseZoneFileOutput = null as any;
+utilities.lazyLoad(exports, ["parseZoneFile","parseZoneFileOutput"], () => require("./parseZoneFile"));
+
 export { ProviderArgs } from "./provider";
 export type Provider = import("./provider").Provider;
 export const Provider: typeof import("./provider").Provider = null as any;
@@ -18,9 +63,11 @@ utilities.lazyLoad(exports, ["Provider"], () => require("./provider"));
 
 // Export sub-modules:
 import * as config from "./config";
+import * as types from "./types";
 
 export {
     config,
+    types,
 };
 
 const _module = {
@@ -29,6 +76,12 @@ const _module = {
         switch (type) {
             case "netcup:index:DNSRecord":
                 return new DNSRecord(name, <any>undefined, { urn })
+            case "netcup:index:DNSRecordSet":
+                return new DNSRecordSet(name, <any>undefined, { urn })
+            case "netcup:index:DNSZone":
+                return new DNSZone(name, <any>undefined, { urn })
+            case "netcup:index:DNSZoneRecords":
+                return new DNSZoneRecords(name, <any>undefined, { urn })
             default:
                 throw new Error(`unknown resource type ${type}`);
         }
@@ -0,0 +1,36 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Returns all domains owned by the Netcup customer account
+ */
+export function listDomains(args?: ListDomainsArgs, opts?: pulumi.InvokeOptions): Promise<ListDomainsResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("netcup:index:listDomains", {
+    }, opts);
+}
+
+export interface ListDomainsArgs {
+}
+
+export interface ListDomainsResult {
+    /**
+     * The domains of the account, sorted by name
+     */
+    readonly domains: outputs.DomainDetails[];
+}
+/**
+ * Returns all domains owned by the Netcup customer account
+ */
+export function listDomainsOutput(opts?: pulumi.InvokeOutputOptions): pulumi.Output<ListDomainsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("netcup:index:listDomains", {
+    }, opts);
+}
+
@@ -0,0 +1,57 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Parses an RFC 1035 zone file (BIND format) into the records of a domain, ready to be used as records of a DNSZoneRecords resource. SOA and apex NS records are skipped, as Netcup manages them, and record TTLs are ignored
+ */
+export function parseZoneFile(args: ParseZoneFileArgs, opts?: pulumi.InvokeOptions): Promise<ParseZoneFileResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("netcup:index:parseZoneFile", {
+        "domain": args.domain,
+        "zoneFile": args.zoneFile,
+    }, opts);
+}
+
+export interface ParseZoneFileArgs {
+    /**
+     * The domain name the zone file belongs to. It is the initial $ORIGIN
+     */
+    domain: string;
+    /**
+     * The content of the zone file
+     */
+    zoneFile: string;
+}
+
+export interface ParseZoneFileResult {
+    /**
+     * The records of the zone file, in the order they are defined
+     */
+    readonly records: outputs.DNSZoneRecord[];
+}
+/**
+ * Parses an RFC 1035 zone file (BIND format) into the records of a domain, ready to be used as records of a DNSZoneRecords resource. SOA and apex NS records are skipped, as Netcup manages them, and record TTLs are ignored
+ */
+export function parseZoneFileOutput(args: ParseZoneFileOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<ParseZoneFileResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("netcup:index:parseZoneFile", {
+        "domain": args.domain,
+        "zoneFile": args.zoneFile,
+    }, opts);
+}
+
+export interface ParseZoneFileOutputArgs {
+    /**
+     * The domain name the zone file belongs to. It is the initial $ORIGIN
+     */
+    domain: pulumi.Input<string>;
+    /**
+     * The content of the zone file
+     */
+    zoneFile: pulumi.Input<string>;
+}
@@ -20,17 +20,37 @@ export class Provider extends pulumi.ProviderResource {
     }
 
     /**
-     * The Netcup API key for authentication
+     * The Netcup API key for authentication. Can also be set with NETCUP_API_KEY
      */
-    public readonly apiKey!: pulumi.Output<string>;
+    public readonly apiKey!: pulumi.Output<string | undefined>;
     /**
-     * The Netcup API password for authentication
+     * The Netcup API password for authentication. Can also be set with NETCUP_API_PASSWORD
      */
-    public readonly apiPassword!: pulumi.Output<string>;
+    public readonly apiPassword!: pulumi.Output<string | undefined>;
     /**
-     * The Netcup customer ID
+     * Path to a PEM file with additional CA certificates trusted for API requests
      */
-    public readonly customerId!: pulumi.Output<string>;
+    public readonly caBundle!: pulumi.Output<string | undefined>;
+    /**
+     * The Netcup customer ID. Can also be set with NETCUP_CUSTOMER_ID
+     */
+    public readonly customerId!: pulumi.Output<string | undefined>;
+    /**
+     * The URL of the Netcup CCP JSON API. Defaults to https://ccp.netcup.net/run/webservice/servers/endpoint.php?JSON
+     */
+    public readonly endpoint!: pulumi.Output<string | undefined>;
+    /**
+     * The nameserver (host:port) DNSKEY records of DNSSEC signed zones are read from. Defaults to root-dns.netcup.net:53
+     */
+    public readonly nameserver!: pulumi.Output<string | undefined>;
+    /**
+     * The URL of an HTTP proxy for API requests. Defaults to the HTTPS_PROXY and NO_PROXY environment variables
+     */
+    public readonly proxyUrl!: pulumi.Output<string | undefined>;
+    /**
+     * The User-Agent header sent with API requests
+     */
+    public readonly userAgent!: pulumi.Output<string | undefined>;
 
     /**
      * Create a Provider resource with the given unique name, arguments, and options.
@@ -39,22 +59,21 @@ export class Provider extends pulumi.ProviderResource {
      * @param args The arguments to use to populate this resource's properties.
      * @param opts A bag of options that control this resource's behavior.
      */
-    constructor(name: string, args: ProviderArgs, opts?: pulumi.ResourceOptions) {
+    constructor(name: string, args?: ProviderArgs, opts?: pulumi.ResourceOptions) {
         let resourceInputs: pulumi.Inputs = {};
         opts = opts || {};
         {
-            if ((!args || args.apiKey === undefined) && !opts.urn) {
-                throw new Error("Missing required property 'apiKey'");
-            }
-            if ((!args || args.apiPassword === undefined) && !opts.urn) {
-                throw new Error("Missing required property 'apiPassword'");
-            }
-            if ((!args || args.customerId === undefined) && !opts.urn) {
-                throw new Error("Missing required property 'customerId'");
-            }
-            resourceInputs["apiKey"] = args?.apiKey ? pulumi.secret(args.apiKey) : undefined;
-            resourceInputs["apiPassword"] = args?.apiPassword ? pulumi.secret(args.apiPassword) : undefined;
-            resourceInputs["customerId"] = args ? args.customerId : undefined;
+            resourceInputs["apiKey"] = (args?.apiKey ? pulumi.secret(args.apiKey) : undefined) ?? utilities.getEnv("NETCUP_API_KEY");
+            resourceInputs["apiPassword"] = (args?.apiPassword ? pulumi.secret(args.apiPassword) : undefined) ?? utilities.getEnv("NETCUP_API_PASSWORD");
+            resourceInputs["caBundle"] = args ? args.caBundle : undefined;
+            resourceInputs["customerId"] = (args ? args.customerId : undefined) ?? utilities.getEnv("NETCUP_CUSTOMER_ID");
+            resourceInputs["endpoint"] = args ? args.endpoint : undefined;
+            resourceInputs["maxRetries"] = pulumi.output(args ? args.maxRetries : undefined).apply(JSON.stringify);
+            resourceInputs["nameserver"] = args ? args.nameserver : undefined;
+            resourceInputs["proxyUrl"] = args ? args.proxyUrl : undefined;
+            resourceInputs["requestTimeout"] = pulumi.output(args ? args.requestTimeout : undefined).apply(JSON.stringify);
+            resourceInputs["requestsPerMinute"] = pulumi.output(args ? args.requestsPerMinute : undefined).apply(JSON.stringify);
+            resourceInputs["userAgent"] = args ? args.userAgent : undefined;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
         const secretOpts = { additionalSecretOutputs: ["apiKey", "apiPassword"] };
@@ -68,15 +87,47 @@ export class Provider extends pulumi.ProviderResource {
  */
 export interface ProviderArgs {
     /**
-     * The Netcup API key for authentication
+     * The Netcup API key for authentication. Can also be set with NETCUP_API_KEY
+     */
+    apiKey?: pulumi.Input<string>;
+    /**
+     * The Netcup API password for authentication. Can also be set with NETCUP_API_PASSWORD
+     */
+    apiPassword?: pulumi.Input<string>;
+    /**
+     * Path to a PEM file with additional CA certificates trusted for API requests
+     */
+    caBundle?: pulumi.Input<string>;
+    /**
+     * The Netcup customer ID. Can also be set with NETCUP_CUSTOMER_ID
+     */
+    customerId?: pulumi.Input<string>;
+    /**
+     * The URL of the Netcup CCP JSON API. Defaults to https://ccp.netcup.net/run/webservice/servers/endpoint.php?JSON
+     */
+    endpoint?: pulumi.Input<string>;
+    /**
+     * How often a rate-limited (status 2057) or transiently failed API request is retried. Defaults to 5
+     */
+    maxRetries?: pulumi.Input<number>;
+    /**
+     * The nameserver (host:port) DNSKEY records of DNSSEC signed zones are read from. Defaults to root-dns.netcup.net:53
+     */
+    nameserver?: pulumi.Input<string>;
+    /**
+     * The URL of an HTTP proxy for API requests. Defaults to the HTTPS_PROXY and NO_PROXY environment variables
+     */
+    proxyUrl?: pulumi.Input<string>;
+    /**
+     * The timeout of a single API request in seconds. Defaults to 30
      */
-    apiKey: pulumi.Input<string>;
+    requestTimeout?: pulumi.Input<number>;
     /**
-     * The Netcup API password for authentication
+     * The maximum number of Netcup API requests per minute made by the provider. The limit is shared by all provider instances in the process. Defaults to 180
      */
-    apiPassword: pulumi.Input<string>;
+    requestsPerMinute?: pulumi.Input<number>;
     /**
-     * The Netcup customer ID
+     * The User-Agent header sent with API requests
      */
-    customerId: pulumi.Input<string>;
+    userAgent?: pulumi.Input<string>;
 }
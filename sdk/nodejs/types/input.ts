@@ -0,0 +1,196 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+
+import * as utilities from "../utilities";
+
+export interface CAARecordArgs {
+    /**
+     * The flags of the record: 0, or 128 to mark the property as critical for issuers
+     */
+    flags?: pulumi.Input<number>;
+    /**
+     * The property tag: issue, issuewild, iodef, contactemail or contactphone
+     */
+    tag: pulumi.Input<string>;
+    /**
+     * The unquoted property value, e.g. 'letsencrypt.org' for issue or 'mailto:security@example.com' for iodef
+     */
+    value: pulumi.Input<string>;
+}
+/**
+ * caarecordArgsProvideDefaults sets the appropriate defaults for CAARecordArgs
+ */
+export function caarecordArgsProvideDefaults(val: CAARecordArgs): CAARecordArgs {
+    return {
+        ...val,
+        flags: (val.flags) ?? 0,
+    };
+}
+
+export interface DNSRecordFilterArgs {
+    /**
+     * The hostname to match. Matches every hostname when not set
+     */
+    name?: pulumi.Input<string>;
+    /**
+     * The record type to match. Matches every type when not set
+     */
+    type?: pulumi.Input<string>;
+}
+
+export interface DNSRecordSetValueArgs {
+    /**
+     * The priority for MX and SRV records (required for these types, ignored for others)
+     */
+    priority?: pulumi.Input<string>;
+    /**
+     * The value/destination of the record
+     */
+    value: pulumi.Input<string>;
+}
+
+export interface DNSZoneRecordArgs {
+    /**
+     * The hostname of the record. Use '@' for the root domain
+     */
+    name: pulumi.Input<string>;
+    /**
+     * The priority for MX and SRV records
+     */
+    priority?: pulumi.Input<string>;
+    /**
+     * The DNS record type
+     */
+    type: pulumi.Input<string>;
+    /**
+     * The value/destination of the record
+     */
+    value: pulumi.Input<string>;
+}
+
+export interface OPENPGPKEYRecordArgs {
+    /**
+     * The email address the key belongs to, within the domain of the record
+     */
+    email: pulumi.Input<string>;
+    /**
+     * The ASCII armored OpenPGP public key, with a user ID of the email address
+     */
+    publicKey: pulumi.Input<string>;
+}
+
+export interface SMIMEARecordArgs {
+    /**
+     * The PEM encoded S/MIME certificate of the email address
+     */
+    certificate: pulumi.Input<string>;
+    /**
+     * The email address the certificate is issued for, within the domain of the record
+     */
+    email: pulumi.Input<string>;
+    /**
+     * The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512. Senders need the full certificate to encrypt, so it defaults to 0
+     */
+    matchingType?: pulumi.Input<number>;
+    /**
+     * The selector: 0 for the full certificate or 1 for the public key
+     */
+    selector?: pulumi.Input<number>;
+    /**
+     * The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+     */
+    usage?: pulumi.Input<number>;
+}
+/**
+ * smimearecordArgsProvideDefaults sets the appropriate defaults for SMIMEARecordArgs
+ */
+export function smimearecordArgsProvideDefaults(val: SMIMEARecordArgs): SMIMEARecordArgs {
+    return {
+        ...val,
+        matchingType: (val.matchingType) ?? 0,
+        selector: (val.selector) ?? 0,
+        usage: (val.usage) ?? 3,
+    };
+}
+
+export interface SRVRecordArgs {
+    /**
+     * The port the service is offered on, between 0 and 65535
+     */
+    port: pulumi.Input<number>;
+    /**
+     * The transport protocol without leading underscore (e.g., 'tcp', 'udp', 'tls')
+     */
+    protocol: pulumi.Input<string>;
+    /**
+     * The symbolic name of the service without leading underscore (e.g., 'sip', 'xmpp-client')
+     */
+    service: pulumi.Input<string>;
+    /**
+     * The hostname of the server offering the service, or '.' if the service is not offered
+     */
+    target: pulumi.Input<string>;
+    /**
+     * The relative weight of records with the same priority, between 0 and 65535
+     */
+    weight?: pulumi.Input<number>;
+}
+/**
+ * srvrecordArgsProvideDefaults sets the appropriate defaults for SRVRecordArgs
+ */
+export function srvrecordArgsProvideDefaults(val: SRVRecordArgs): SRVRecordArgs {
+    return {
+        ...val,
+        weight: (val.weight) ?? 0,
+    };
+}
+
+export interface SSHFPRecordSetArgs {
+    /**
+     * The RSA, ECDSA or Ed25519 host keys in OpenSSH authorized_keys format
+     */
+    publicKeys: pulumi.Input<pulumi.Input<string>[]>;
+}
+
+export interface TLSARecordArgs {
+    /**
+     * The PEM encoded certificate or public key (SubjectPublicKeyInfo) the record is derived from. Of a certificate chain, the first certificate is used
+     */
+    certificate: pulumi.Input<string>;
+    /**
+     * The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512
+     */
+    matchingType?: pulumi.Input<number>;
+    /**
+     * The port the TLS service is offered on, e.g. 25 for SMTP
+     */
+    port: pulumi.Input<number>;
+    /**
+     * The transport protocol of the service without leading underscore
+     */
+    protocol?: pulumi.Input<string>;
+    /**
+     * The selector: 0 for the full certificate or 1 for the public key
+     */
+    selector?: pulumi.Input<number>;
+    /**
+     * The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+     */
+    usage?: pulumi.Input<number>;
+}
+/**
+ * tlsarecordArgsProvideDefaults sets the appropriate defaults for TLSARecordArgs
+ */
+export function tlsarecordArgsProvideDefaults(val: TLSARecordArgs): TLSARecordArgs {
+    return {
+        ...val,
+        matchingType: (val.matchingType) ?? 1,
+        protocol: (val.protocol) ?? "tcp",
+        selector: (val.selector) ?? 1,
+        usage: (val.usage) ?? 3,
+    };
+}
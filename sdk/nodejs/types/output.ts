@@ -0,0 +1,281 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+
+import * as utilities from "../utilities";
+
+export interface CAARecord {
+    /**
+     * The flags of the record: 0, or 128 to mark the property as critical for issuers
+     */
+    flags?: number;
+    /**
+     * The property tag: issue, issuewild, iodef, contactemail or contactphone
+     */
+    tag: string;
+    /**
+     * The unquoted property value, e.g. 'letsencrypt.org' for issue or 'mailto:security@example.com' for iodef
+     */
+    value: string;
+}
+/**
+ * caarecordProvideDefaults sets the appropriate defaults for CAARecord
+ */
+export function caarecordProvideDefaults(val: CAARecord): CAARecord {
+    return {
+        ...val,
+        flags: (val.flags) ?? 0,
+    };
+}
+
+export interface DNSRecordEntry {
+    /**
+     * The fully qualified domain name
+     */
+    fqdn: string;
+    /**
+     * The hostname of the DNS record
+     */
+    name: string;
+    /**
+     * The priority of MX and SRV records
+     */
+    priority?: string;
+    /**
+     * The Netcup record ID
+     */
+    recordId: string;
+    /**
+     * The DNS record type
+     */
+    type: string;
+    /**
+     * The value/destination of the DNS record
+     */
+    value: string;
+}
+
+export interface DNSRecordFilter {
+    /**
+     * The hostname to match. Matches every hostname when not set
+     */
+    name?: string;
+    /**
+     * The record type to match. Matches every type when not set
+     */
+    type?: string;
+}
+
+export interface DNSRecordSetValue {
+    /**
+     * The priority for MX and SRV records (required for these types, ignored for others)
+     */
+    priority?: string;
+    /**
+     * The value/destination of the record
+     */
+    value: string;
+}
+
+export interface DNSZoneRecord {
+    /**
+     * The hostname of the record. Use '@' for the root domain
+     */
+    name: string;
+    /**
+     * The priority for MX and SRV records
+     */
+    priority?: string;
+    /**
+     * The DNS record type
+     */
+    type: string;
+    /**
+     * The value/destination of the record
+     */
+    value: string;
+}
+
+export interface DomainDetails {
+    /**
+     * The domain name
+     */
+    domain: string;
+    /**
+     * The contact handles assigned to the domain
+     */
+    handles: outputs.DomainHandleDetails[];
+    /**
+     * The hostnames of the nameservers the domain is delegated to
+     */
+    nameservers: string[];
+    /**
+     * The end date of the current contract runtime
+     */
+    runtimeEnd: string;
+    /**
+     * The start date of the current contract runtime
+     */
+    runtimeStart: string;
+    /**
+     * The registration state of the domain as reported by Netcup
+     */
+    state: string;
+}
+
+export interface DomainHandleDetails {
+    /**
+     * The Netcup ID of the handle
+     */
+    handleId: string;
+    /**
+     * The role of the handle, such as 'ownerc', 'adminc', 'techc' or 'zonec'
+     */
+    type: string;
+}
+
+export interface OPENPGPKEYRecord {
+    /**
+     * The email address the key belongs to, within the domain of the record
+     */
+    email: string;
+    /**
+     * The ASCII armored OpenPGP public key, with a user ID of the email address
+     */
+    publicKey: string;
+}
+
+export interface SMIMEARecord {
+    /**
+     * The PEM encoded S/MIME certificate of the email address
+     */
+    certificate: string;
+    /**
+     * The email address the certificate is issued for, within the domain of the record
+     */
+    email: string;
+    /**
+     * The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512. Senders need the full certificate to encrypt, so it defaults to 0
+     */
+    matchingType?: number;
+    /**
+     * The selector: 0 for the full certificate or 1 for the public key
+     */
+    selector?: number;
+    /**
+     * The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+     */
+    usage?: number;
+}
+/**
+ * smimearecordProvideDefaults sets the appropriate defaults for SMIMEARecord
+ */
+export function smimearecordProvideDefaults(val: SMIMEARecord): SMIMEARecord {
+    return {
+        ...val,
+        matchingType: (val.matchingType) ?? 0,
+        selector: (val.selector) ?? 0,
+        usage: (val.usage) ?? 3,
+    };
+}
+
+export interface SRVRecord {
+    /**
+     * The port the service is offered on, between 0 and 65535
+     */
+    port: number;
+    /**
+     * The transport protocol without leading underscore (e.g., 'tcp', 'udp', 'tls')
+     */
+    protocol: string;
+    /**
+     * The symbolic name of the service without leading underscore (e.g., 'sip', 'xmpp-client')
+     */
+    service: string;
+    /**
+     * The hostname of the server offering the service, or '.' if the service is not offered
+     */
+    target: string;
+    /**
+     * The relative weight of records with the same priority, between 0 and 65535
+     */
+    weight?: number;
+}
+/**
+ * srvrecordProvideDefaults sets the appropriate defaults for SRVRecord
+ */
+export function srvrecordProvideDefaults(val: SRVRecord): SRVRecord {
+    return {
+        ...val,
+        weight: (val.weight) ?? 0,
+    };
+}
+
+export interface SSHFPRecordEntry {
+    /**
+     * The SSHFP algorithm number: 1 (RSA), 3 (ECDSA) or 4 (Ed25519)
+     */
+    algorithm: number;
+    /**
+     * The hex encoded fingerprint of the key
+     */
+    fingerprint: string;
+    /**
+     * The fingerprint type: 1 (SHA-1) or 2 (SHA-256)
+     */
+    fingerprintType: number;
+    /**
+     * The value of the SSHFP record
+     */
+    value: string;
+}
+
+export interface SSHFPRecordSet {
+    /**
+     * The RSA, ECDSA or Ed25519 host keys in OpenSSH authorized_keys format
+     */
+    publicKeys: string[];
+}
+
+export interface TLSARecord {
+    /**
+     * The PEM encoded certificate or public key (SubjectPublicKeyInfo) the record is derived from. Of a certificate chain, the first certificate is used
+     */
+    certificate: string;
+    /**
+     * The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512
+     */
+    matchingType?: number;
+    /**
+     * The port the TLS service is offered on, e.g. 25 for SMTP
+     */
+    port: number;
+    /**
+     * The transport protocol of the service without leading underscore
+     */
+    protocol?: string;
+    /**
+     * The selector: 0 for the full certificate or 1 for the public key
+     */
+    selector?: number;
+    /**
+     * The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+     */
+    usage?: number;
+}
+/**
+ * tlsarecordProvideDefaults sets the appropriate defaults for TLSARecord
+ */
+export function tlsarecordProvideDefaults(val: TLSARecord): TLSARecord {
+    return {
+        ...val,
+        matchingType: (val.matchingType) ?? 1,
+        protocol: (val.protocol) ?? "tcp",
+        selector: (val.selector) ?? 1,
+        usage: (val.usage) ?? 3,
+    };
+}
+
@@ -7,7 +7,18 @@ from . import _utilities
 import typing
 # Export this package's modules as members:
 from .dns_record import *
+from .dns_record_set import *
+from .dns_zone import *
+from .dns_zone_records import *
+from .export_zone import *
+from .get_dns_records import *
+from .get_domain_info import *
+from .get_sshfp_records import *
+from .list_domains import *
+from .parse_zone_file import *
 from .provider import *
+from ._inputs import *
+from . import outputs
 
 # Make subpackages available:
 if typing.TYPE_CHECKING:
@@ -24,7 +35,10 @@ _utilities.register(
   "mod": "index",
   "fqn": "blackdark_netcup",
   "classes": {
-   "netcup:index:DNSRecord": "DNSRecord"
+   "netcup:index:DNSRecord": "DNSRecord",
+   "netcup:index:DNSRecordSet": "DNSRecordSet",
+   "netcup:index:DNSZone": "DNSZone",
+   "netcup:index:DNSZoneRecords": "DNSZoneRecords"
   }
  }
 ]
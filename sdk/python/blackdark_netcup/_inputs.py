@@ -0,0 +1,749 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = [
+    'CAARecordArgs',
+    'CAARecordArgsDict',
+    'DNSRecordFilterArgs',
+    'DNSRecordFilterArgsDict',
+    'DNSRecordSetValueArgs',
+    'DNSRecordSetValueArgsDict',
+    'DNSZoneRecordArgs',
+    'DNSZoneRecordArgsDict',
+    'OPENPGPKEYRecordArgs',
+    'OPENPGPKEYRecordArgsDict',
+    'SMIMEARecordArgs',
+    'SMIMEARecordArgsDict',
+    'SRVRecordArgs',
+    'SRVRecordArgsDict',
+    'SSHFPRecordSetArgs',
+    'SSHFPRecordSetArgsDict',
+    'TLSARecordArgs',
+    'TLSARecordArgsDict',
+]
+
+MYPY = False
+
+if not MYPY:
+    class CAARecordArgsDict(TypedDict):
+        tag: pulumi.Input[builtins.str]
+        """
+        The property tag: issue, issuewild, iodef, contactemail or contactphone
+        """
+        value: pulumi.Input[builtins.str]
+        """
+        The unquoted property value, e.g. 'letsencrypt.org' for issue or 'mailto:security@example.com' for iodef
+        """
+        flags: NotRequired[pulumi.Input[builtins.int]]
+        """
+        The flags of the record: 0, or 128 to mark the property as critical for issuers
+        """
+elif False:
+    CAARecordArgsDict: TypeAlias = Mapping[str, Any]
+
+@pulumi.input_type
+class CAARecordArgs:
+    def __init__(__self__, *,
+                 tag: pulumi.Input[builtins.str],
+                 value: pulumi.Input[builtins.str],
+                 flags: Optional[pulumi.Input[builtins.int]] = None):
+        """
+        :param pulumi.Input[builtins.str] tag: The property tag: issue, issuewild, iodef, contactemail or contactphone
+        :param pulumi.Input[builtins.str] value: The unquoted property value, e.g. 'letsencrypt.org' for issue or 'mailto:security@example.com' for iodef
+        :param pulumi.Input[builtins.int] flags: The flags of the record: 0, or 128 to mark the property as critical for issuers
+        """
+        pulumi.set(__self__, "tag", tag)
+        pulumi.set(__self__, "value", value)
+        if flags is None:
+            flags = 0
+        if flags is not None:
+            pulumi.set(__self__, "flags", flags)
+
+    @property
+    @pulumi.getter
+    def tag(self) -> pulumi.Input[builtins.str]:
+        """
+        The property tag: issue, issuewild, iodef, contactemail or contactphone
+        """
+        return pulumi.get(self, "tag")
+
+    @tag.setter
+    def tag(self, value: pulumi.Input[builtins.str]):
+        pulumi.set(self, "tag", value)
+
+    @property
+    @pulumi.getter
+    def value(self) -> pulumi.Input[builtins.str]:
+        """
+        The unquoted property value, e.g. 'letsencrypt.org' for issue or 'mailto:security@example.com' for iodef
+        """
+        return pulumi.get(self, "value")
+
+    @value.setter
+    def value(self, value: pulumi.Input[builtins.str]):
+        pulumi.set(self, "value", value)
+
+    @property
+    @pulumi.getter
+    def flags(self) -> Optional[pulumi.Input[builtins.int]]:
+        """
+        The flags of the record: 0, or 128 to mark the property as critical for issuers
+        """
+        return pulumi.get(self, "flags")
+
+    @flags.setter
+    def flags(self, value: Optional[pulumi.Input[builtins.int]]):
+        pulumi.set(self, "flags", value)
+
+
+if not MYPY:
+    class DNSRecordFilterArgsDict(TypedDict):
+        name: NotRequired[pulumi.Input[builtins.str]]
+        """
+        The hostname to match. Matches every hostname when not set
+        """
+        type: NotRequired[pulumi.Input[builtins.str]]
+        """
+        The record type to match. Matches every type when not set
+        """
+elif False:
+    DNSRecordFilterArgsDict: TypeAlias = Mapping[str, Any]
+
+@pulumi.input_type
+class DNSRecordFilterArgs:
+    def __init__(__self__, *,
+                 name: Optional[pulumi.Input[builtins.str]] = None,
+                 type: Optional[pulumi.Input[builtins.str]] = None):
+        """
+        :param pulumi.Input[builtins.str] name: The hostname to match. Matches every hostname when not set
+        :param pulumi.Input[builtins.str] type: The record type to match. Matches every type when not set
+        """
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+        if type is not None:
+            pulumi.set(__self__, "type", type)
+
+    @property
+    @pulumi.getter
+    def name(self) -> Optional[pulumi.Input[builtins.str]]:
+        """
+        The hostname to match. Matches every hostname when not set
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: Optional[pulumi.Input[builtins.str]]):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter
+    def type(self) -> Optional[pulumi.Input[builtins.str]]:
+        """
+        The record type to match. Matches every type when not set
+        """
+        return pulumi.get(self, "type")
+
+    @type.setter
+    def type(self, value: Optional[pulumi.Input[builtins.str]]):
+        pulumi.set(self, "type", value)
+
+
+if not MYPY:
+    class DNSRecordSetValueArgsDict(TypedDict):
+        value: pulumi.Input[builtins.str]
+        """
+        The value/destination of the record
+        """
+        priority: NotRequired[pulumi.Input[builtins.str]]
+        """
+        The priority for MX and SRV records (required for these types, ignored for others)
+        """
+elif False:
+    DNSRecordSetValueArgsDict: TypeAlias = Mapping[str, Any]
+
+@pulumi.input_type
+class DNSRecordSetValueArgs:
+    def __init__(__self__, *,
+                 value: pulumi.Input[builtins.str],
+                 priority: Optional[pulumi.Input[builtins.str]] = None):
+        """
+        :param pulumi.Input[builtins.str] value: The value/destination of the record
+        :param pulumi.Input[builtins.str] priority: The priority for MX and SRV records (required for these types, ignored for others)
+        """
+        pulumi.set(__self__, "value", value)
+        if priority is not None:
+            pulumi.set(__self__, "priority", priority)
+
+    @property
+    @pulumi.getter
+    def value(self) -> pulumi.Input[builtins.str]:
+        """
+        The value/destination of the record
+        """
+        return pulumi.get(self, "value")
+
+    @value.setter
+    def value(self, value: pulumi.Input[builtins.str]):
+        pulumi.set(self, "value", value)
+
+    @property
+    @pulumi.getter
+    def priority(self) -> Optional[pulumi.Input[builtins.str]]:
+        """
+        The priority for MX and SRV records (required for these types, ignored for others)
+        """
+        return pulumi.get(self, "priority")
+
+    @priority.setter
+    def priority(self, value: Optional[pulumi.Input[builtins.str]]):
+        pulumi.set(self, "priority", value)
+
+
+if not MYPY:
+    class DNSZoneRecordArgsDict(TypedDict):
+        name: pulumi.Input[builtins.str]
+        """
+        The hostname of the record. Use '@' for the root domain
+        """
+        type: pulumi.Input[builtins.str]
+        """
+        The DNS record type
+        """
+        value: pulumi.Input[builtins.str]
+        """
+        The value/destination of the record
+        """
+        priority: NotRequired[pulumi.Input[builtins.str]]
+        """
+        The priority for MX and SRV records
+        """
+elif False:
+    DNSZoneRecordArgsDict: TypeAlias = Mapping[str, Any]
+
+@pulumi.input_type
+class DNSZoneRecordArgs:
+    def __init__(__self__, *,
+                 name: pulumi.Input[builtins.str],
+                 type: pulumi.Input[builtins.str],
+                 value: pulumi.Input[builtins.str],
+                 priority: Optional[pulumi.Input[builtins.str]] = None):
+        """
+        :param pulumi.Input[builtins.str] name: The hostname of the record. Use '@' for the root domain
+        :param pulumi.Input[builtins.str] type: The DNS record type
+        :param pulumi.Input[builtins.str] value: The value/destination of the record
+        :param pulumi.Input[builtins.str] priority: The priority for MX and SRV records
+        """
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "type", type)
+        pulumi.set(__self__, "value", value)
+        if priority is not None:
+            pulumi.set(__self__, "priority", priority)
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Input[builtins.str]:
+        """
+        The hostname of the record. Use '@' for the root domain
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: pulumi.Input[builtins.str]):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter
+    def type(self) -> pulumi.Input[builtins.str]:
+        """
+        The DNS record type
+        """
+        return pulumi.get(self, "type")
+
+    @type.setter
+    def type(self, value: pulumi.Input[builtins.str]):
+        pulumi.set(self, "type", value)
+
+    @property
+    @pulumi.getter
+    def value(self) -> pulumi.Input[builtins.str]:
+        """
+        The value/destination of the record
+        """
+        return pulumi.get(self, "value")
+
+    @value.setter
+    def value(self, value: pulumi.Input[builtins.str]):
+        pulumi.set(self, "value", value)
+
+    @property
+    @pulumi.getter
+    def priority(self) -> Optional[pulumi.Input[builtins.str]]:
+        """
+        The priority for MX and SRV records
+        """
+        return pulumi.get(self, "priority")
+
+    @priority.setter
+    def priority(self, value: Optional[pulumi.Input[builtins.str]]):
+        pulumi.set(self, "priority", value)
+
+
+if not MYPY:
+    class OPENPGPKEYRecordArgsDict(TypedDict):
+        email: pulumi.Input[builtins.str]
+        """
+        The email address the key belongs to, within the domain of the record
+        """
+        public_key: pulumi.Input[builtins.str]
+        """
+        The ASCII armored OpenPGP public key, with a user ID of the email address
+        """
+elif False:
+    OPENPGPKEYRecordArgsDict: TypeAlias = Mapping[str, Any]
+
+@pulumi.input_type
+class OPENPGPKEYRecordArgs:
+    def __init__(__self__, *,
+                 email: pulumi.Input[builtins.str],
+                 public_key: pulumi.Input[builtins.str]):
+        """
+        :param pulumi.Input[builtins.str] email: The email address the key belongs to, within the domain of the record
+        :param pulumi.Input[builtins.str] public_key: The ASCII armored OpenPGP public key, with a user ID of the email address
+        """
+        pulumi.set(__self__, "email", email)
+        pulumi.set(__self__, "public_key", public_key)
+
+    @property
+    @pulumi.getter
+    def email(self) -> pulumi.Input[builtins.str]:
+        """
+        The email address the key belongs to, within the domain of the record
+        """
+        return pulumi.get(self, "email")
+
+    @email.setter
+    def email(self, value: pulumi.Input[builtins.str]):
+        pulumi.set(self, "email", value)
+
+    @property
+    @pulumi.getter(name="publicKey")
+    def public_key(self) -> pulumi.Input[builtins.str]:
+        """
+        The ASCII armored OpenPGP public key, with a user ID of the email address
+        """
+        return pulumi.get(self, "public_key")
+
+    @public_key.setter
+    def public_key(self, value: pulumi.Input[builtins.str]):
+        pulumi.set(self, "public_key", value)
+
+
+if not MYPY:
+    class SMIMEARecordArgsDict(TypedDict):
+        certificate: pulumi.Input[builtins.str]
+        """
+        The PEM encoded S/MIME certificate of the email address
+        """
+        email: pulumi.Input[builtins.str]
+        """
+        The email address the certificate is issued for, within the domain of the record
+        """
+        matching_type: NotRequired[pulumi.Input[builtins.int]]
+        """
+        The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512. Senders need the full certificate to encrypt, so it defaults to 0
+        """
+        selector: NotRequired[pulumi.Input[builtins.int]]
+        """
+        The selector: 0 for the full certificate or 1 for the public key
+        """
+        usage: NotRequired[pulumi.Input[builtins.int]]
+        """
+        The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+        """
+elif False:
+    SMIMEARecordArgsDict: TypeAlias = Mapping[str, Any]
+
+@pulumi.input_type
+class SMIMEARecordArgs:
+    def __init__(__self__, *,
+                 certificate: pulumi.Input[builtins.str],
+                 email: pulumi.Input[builtins.str],
+                 matching_type: Optional[pulumi.Input[builtins.int]] = None,
+                 selector: Optional[pulumi.Input[builtins.int]] = None,
+                 usage: Optional[pulumi.Input[builtins.int]] = None):
+        """
+        :param pulumi.Input[builtins.str] certificate: The PEM encoded S/MIME certificate of the email address
+        :param pulumi.Input[builtins.str] email: The email address the certificate is issued for, within the domain of the record
+        :param pulumi.Input[builtins.int] matching_type: The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512. Senders need the full certificate to encrypt, so it defaults to 0
+        :param pulumi.Input[builtins.int] selector: The selector: 0 for the full certificate or 1 for the public key
+        :param pulumi.Input[builtins.int] usage: The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+        """
+        pulumi.set(__self__, "certificate", certificate)
+        pulumi.set(__self__, "email", email)
+        if matching_type is None:
+            matching_type = 0
+        if matching_type is not None:
+            pulumi.set(__self__, "matching_type", matching_type)
+        if selector is None:
+            selector = 0
+        if selector is not None:
+            pulumi.set(__self__, "selector", selector)
+        if usage is None:
+            usage = 3
+        if usage is not None:
+            pulumi.set(__self__, "usage", usage)
+
+    @property
+    @pulumi.getter
+    def certificate(self) -> pulumi.Input[builtins.str]:
+        """
+        The PEM encoded S/MIME certificate of the email address
+        """
+        return pulumi.get(self, "certificate")
+
+    @certificate.setter
+    def certificate(self, value: pulumi.Input[builtins.str]):
+        pulumi.set(self, "certificate", value)
+
+    @property
+    @pulumi.getter
+    def email(self) -> pulumi.Input[builtins.str]:
+        """
+        The email address the certificate is issued for, within the domain of the record
+        """
+        return pulumi.get(self, "email")
+
+    @email.setter
+    def email(self, value: pulumi.Input[builtins.str]):
+        pulumi.set(self, "email", value)
+
+    @property
+    @pulumi.getter(name="matchingType")
+    def matching_type(self) -> Optional[pulumi.Input[builtins.int]]:
+        """
+        The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512. Senders need the full certificate to encrypt, so it defaults to 0
+        """
+        return pulumi.get(self, "matching_type")
+
+    @matching_type.setter
+    def matching_type(self, value: Optional[pulumi.Input[builtins.int]]):
+        pulumi.set(self, "matching_type", value)
+
+    @property
+    @pulumi.getter
+    def selector(self) -> Optional[pulumi.Input[builtins.int]]:
+        """
+        The selector: 0 for the full certificate or 1 for the public key
+        """
+        return pulumi.get(self, "selector")
+
+    @selector.setter
+    def selector(self, value: Optional[pulumi.Input[builtins.int]]):
+        pulumi.set(self, "selector", value)
+
+    @property
+    @pulumi.getter
+    def usage(self) -> Optional[pulumi.Input[builtins.int]]:
+        """
+        The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+        """
+        return pulumi.get(self, "usage")
+
+    @usage.setter
+    def usage(self, value: Optional[pulumi.Input[builtins.int]]):
+        pulumi.set(self, "usage", value)
+
+
+if not MYPY:
+    class SRVRecordArgsDict(TypedDict):
+        port: pulumi.Input[builtins.int]
+        """
+        The port the service is offered on, between 0 and 65535
+        """
+        protocol: pulumi.Input[builtins.str]
+        """
+        The transport protocol without leading underscore (e.g., 'tcp', 'udp', 'tls')
+        """
+        service: pulumi.Input[builtins.str]
+        """
+        The symbolic name of the service without leading underscore (e.g., 'sip', 'xmpp-client')
+        """
+        target: pulumi.Input[builtins.str]
+        """
+        The hostname of the server offering the service, or '.' if the service is not offered
+        """
+        weight: NotRequired[pulumi.Input[builtins.int]]
+        """
+        The relative weight of records with the same priority, between 0 and 65535
+        """
+elif False:
+    SRVRecordArgsDict: TypeAlias = Mapping[str, Any]
+
+@pulumi.input_type
+class SRVRecordArgs:
+    def __init__(__self__, *,
+                 port: pulumi.Input[builtins.int],
+                 protocol: pulumi.Input[builtins.str],
+                 service: pulumi.Input[builtins.str],
+                 target: pulumi.Input[builtins.str],
+                 weight: Optional[pulumi.Input[builtins.int]] = None):
+        """
+        :param pulumi.Input[builtins.int] port: The port the service is offered on, between 0 and 65535
+        :param pulumi.Input[builtins.str] protocol: The transport protocol without leading underscore (e.g., 'tcp', 'udp', 'tls')
+        :param pulumi.Input[builtins.str] service: The symbolic name of the service without leading underscore (e.g., 'sip', 'xmpp-client')
+        :param pulumi.Input[builtins.str] target: The hostname of the server offering the service, or '.' if the service is not offered
+        :param pulumi.Input[builtins.int] weight: The relative weight of records with the same priority, between 0 and 65535
+        """
+        pulumi.set(__self__, "port", port)
+        pulumi.set(__self__, "protocol", protocol)
+        pulumi.set(__self__, "service", service)
+        pulumi.set(__self__, "target", target)
+        if weight is None:
+            weight = 0
+        if weight is not None:
+            pulumi.set(__self__, "weight", weight)
+
+    @property
+    @pulumi.getter
+    def port(self) -> pulumi.Input[builtins.int]:
+        """
+        The port the service is offered on, between 0 and 65535
+        """
+        return pulumi.get(self, "port")
+
+    @port.setter
+    def port(self, value: pulumi.Input[builtins.int]):
+        pulumi.set(self, "port", value)
+
+    @property
+    @pulumi.getter
+    def protocol(self) -> pulumi.Input[builtins.str]:
+        """
+        The transport protocol without leading underscore (e.g., 'tcp', 'udp', 'tls')
+        """
+        return pulumi.get(self, "protocol")
+
+    @protocol.setter
+    def protocol(self, value: pulumi.Input[builtins.str]):
+        pulumi.set(self, "protocol", value)
+
+    @property
+    @pulumi.getter
+    def service(self) -> pulumi.Input[builtins.str]:
+        """
+        The symbolic name of the service without leading underscore (e.g., 'sip', 'xmpp-client')
+        """
+        return pulumi.get(self, "service")
+
+    @service.setter
+    def service(self, value: pulumi.Input[builtins.str]):
+        pulumi.set(self, "service", value)
+
+    @property
+    @pulumi.getter
+    def target(self) -> pulumi.Input[builtins.str]:
+        """
+        The hostname of the server offering the service, or '.' if the service is not offered
+        """
+        return pulumi.get(self, "target")
+
+    @target.setter
+    def target(self, value: pulumi.Input[builtins.str]):
+        pulumi.set(self, "target", value)
+
+    @property
+    @pulumi.getter
+    def weight(self) -> Optional[pulumi.Input[builtins.int]]:
+        """
+        The relative weight of records with the same priority, between 0 and 65535
+        """
+        return pulumi.get(self, "weight")
+
+    @weight.setter
+    def weight(self, value: Optional[pulumi.Input[builtins.int]]):
+        pulumi.set(self, "weight", value)
+
+
+if not MYPY:
+    class SSHFPRecordSetArgsDict(TypedDict):
+        public_keys: pulumi.Input[Sequence[pulumi.Input[builtins.str]]]
+        """
+        The RSA, ECDSA or Ed25519 host keys in OpenSSH authorized_keys format
+        """
+elif False:
+    SSHFPRecordSetArgsDict: TypeAlias = Mapping[str, Any]
+
+@pulumi.input_type
+class SSHFPRecordSetArgs:
+    def __init__(__self__, *,
+                 public_keys: pulumi.Input[Sequence[pulumi.Input[builtins.str]]]):
+        """
+        :param pulumi.Input[Sequence[pulumi.Input[builtins.str]]] public_keys: The RSA, ECDSA or Ed25519 host keys in OpenSSH authorized_keys format
+        """
+        pulumi.set(__self__, "public_keys", public_keys)
+
+    @property
+    @pulumi.getter(name="publicKeys")
+    def public_keys(self) -> pulumi.Input[Sequence[pulumi.Input[builtins.str]]]:
+        """
+        The RSA, ECDSA or Ed25519 host keys in OpenSSH authorized_keys format
+        """
+        return pulumi.get(self, "public_keys")
+
+    @public_keys.setter
+    def public_keys(self, value: pulumi.Input[Sequence[pulumi.Input[builtins.str]]]):
+        pulumi.set(self, "public_keys", value)
+
+
+if not MYPY:
+    class TLSARecordArgsDict(TypedDict):
+        certificate: pulumi.Input[builtins.str]
+        """
+        The PEM encoded certificate or public key (SubjectPublicKeyInfo) the record is derived from. Of a certificate chain, the first certificate is used
+        """
+        port: pulumi.Input[builtins.int]
+        """
+        The port the TLS service is offered on, e.g. 25 for SMTP
+        """
+        matching_type: NotRequired[pulumi.Input[builtins.int]]
+        """
+        The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512
+        """
+        protocol: NotRequired[pulumi.Input[builtins.str]]
+        """
+        The transport protocol of the service without leading underscore
+        """
+        selector: NotRequired[pulumi.Input[builtins.int]]
+        """
+        The selector: 0 for the full certificate or 1 for the public key
+        """
+        usage: NotRequired[pulumi.Input[builtins.int]]
+        """
+        The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+        """
+elif False:
+    TLSARecordArgsDict: TypeAlias = Mapping[str, Any]
+
+@pulumi.input_type
+class TLSARecordArgs:
+    def __init__(__self__, *,
+                 certificate: pulumi.Input[builtins.str],
+                 port: pulumi.Input[builtins.int],
+                 matching_type: Optional[pulumi.Input[builtins.int]] = None,
+                 protocol: Optional[pulumi.Input[builtins.str]] = None,
+                 selector: Optional[pulumi.Input[builtins.int]] = None,
+                 usage: Optional[pulumi.Input[builtins.int]] = None):
+        """
+        :param pulumi.Input[builtins.str] certificate: The PEM encoded certificate or public key (SubjectPublicKeyInfo) the record is derived from. Of a certificate chain, the first certificate is used
+        :param pulumi.Input[builtins.int] port: The port the TLS service is offered on, e.g. 25 for SMTP
+        :param pulumi.Input[builtins.int] matching_type: The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512
+        :param pulumi.Input[builtins.str] protocol: The transport protocol of the service without leading underscore
+        :param pulumi.Input[builtins.int] selector: The selector: 0 for the full certificate or 1 for the public key
+        :param pulumi.Input[builtins.int] usage: The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+        """
+        pulumi.set(__self__, "certificate", certificate)
+        pulumi.set(__self__, "port", port)
+        if matching_type is None:
+            matching_type = 1
+        if matching_type is not None:
+            pulumi.set(__self__, "matching_type", matching_type)
+        if protocol is None:
+            protocol = 'tcp'
+        if protocol is not None:
+            pulumi.set(__self__, "protocol", protocol)
+        if selector is None:
+            selector = 1
+        if selector is not None:
+            pulumi.set(__self__, "selector", selector)
+        if usage is None:
+            usage = 3
+        if usage is not None:
+            pulumi.set(__self__, "usage", usage)
+
+    @property
+    @pulumi.getter
+    def certificate(self) -> pulumi.Input[builtins.str]:
+        """
+        The PEM encoded certificate or public key (SubjectPublicKeyInfo) the record is derived from. Of a certificate chain, the first certificate is used
+        """
+        return pulumi.get(self, "certificate")
+
+    @certificate.setter
+    def certificate(self, value: pulumi.Input[builtins.str]):
+        pulumi.set(self, "certificate", value)
+
+    @property
+    @pulumi.getter
+    def port(self) -> pulumi.Input[builtins.int]:
+        """
+        The port the TLS service is offered on, e.g. 25 for SMTP
+        """
+        return pulumi.get(self, "port")
+
+    @port.setter
+    def port(self, value: pulumi.Input[builtins.int]):
+        pulumi.set(self, "port", value)
+
+    @property
+    @pulumi.getter(name="matchingType")
+    def matching_type(self) -> Optional[pulumi.Input[builtins.int]]:
+        """
+        The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512
+        """
+        return pulumi.get(self, "matching_type")
+
+    @matching_type.setter
+    def matching_type(self, value: Optional[pulumi.Input[builtins.int]]):
+        pulumi.set(self, "matching_type", value)
+
+    @property
+    @pulumi.getter
+    def protocol(self) -> Optional[pulumi.Input[builtins.str]]:
+        """
+        The transport protocol of the service without leading underscore
+        """
+        return pulumi.get(self, "protocol")
+
+    @protocol.setter
+    def protocol(self, value: Optional[pulumi.Input[builtins.str]]):
+        pulumi.set(self, "protocol", value)
+
+    @property
+    @pulumi.getter
+    def selector(self) -> Optional[pulumi.Input[builtins.int]]:
+        """
+        The selector: 0 for the full certificate or 1 for the public key
+        """
+        return pulumi.get(self, "selector")
+
+    @selector.setter
+    def selector(self, value: Optional[pulumi.Input[builtins.int]]):
+        pulumi.set(self, "selector", value)
+
+    @property
+    @pulumi.getter
+    def usage(self) -> Optional[pulumi.Input[builtins.int]]:
+        """
+        The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+        """
+        return pulumi.get(self, "usage")
+
+    @usage.setter
+    def usage(self, value: Optional[pulumi.Input[builtins.int]]):
+        pulumi.set(self, "usage", value)
+
+
@@ -17,16 +17,56 @@ from .. import _utilities
 
 apiKey: Optional[str]
 """
-The Netcup API key for authentication
+The Netcup API key for authentication. Can also be set with NETCUP_API_KEY
 """
 
 apiPassword: Optional[str]
 """
-The Netcup API password for authentication
+The Netcup API password for authentication. Can also be set with NETCUP_API_PASSWORD
+"""
+
+caBundle: Optional[str]
+"""
+Path to a PEM file with additional CA certificates trusted for API requests
 """
 
 customerId: Optional[str]
 """
-The Netcup customer ID
+The Netcup customer ID. Can also be set with NETCUP_CUSTOMER_ID
+"""
+
+endpoint: Optional[str]
+"""
+The URL of the Netcup CCP JSON API. Defaults to https://ccp.netcup.net/run/webservice/servers/endpoint.php?JSON
+"""
+
+maxRetries: Optional[int]
+"""
+How often a rate-limited (status 2057) or transiently failed API request is retried. Defaults to 5
+"""
+
+nameserver: Optional[str]
+"""
+The nameserver (host:port) DNSKEY records of DNSSEC signed zones are read from. Defaults to root-dns.netcup.net:53
+"""
+
+proxyUrl: Optional[str]
+"""
+The URL of an HTTP proxy for API requests. Defaults to the HTTPS_PROXY and NO_PROXY environment variables
+"""
+
+requestTimeout: Optional[int]
+"""
+The timeout of a single API request in seconds. Defaults to 30
+"""
+
+requestsPerMinute: Optional[int]
+"""
+The maximum number of Netcup API requests per minute made by the provider. The limit is shared by all provider instances in the process. Defaults to 180
+"""
+
+userAgent: Optional[str]
+"""
+The User-Agent header sent with API requests
 """
 
@@ -24,21 +24,77 @@ class _ExportableConfig(types.ModuleType):
     @property
     def api_key(self) -> Optional[str]:
         """
-        The Netcup API key for authentication
+        The Netcup API key for authentication. Can also be set with NETCUP_API_KEY
         """
-        return __config__.get('apiKey')
+        return __config__.get('apiKey') or _utilities.get_env('NETCUP_API_KEY')
 
     @property
     def api_password(self) -> Optional[str]:
         """
-        The Netcup API password for authentication
+        The Netcup API password for authentication. Can also be set with NETCUP_API_PASSWORD
         """
-        return __config__.get('apiPassword')
+        return __config__.get('apiPassword') or _utilities.get_env('NETCUP_API_PASSWORD')
+
+    @property
+    def ca_bundle(self) -> Optional[str]:
+        """
+        Path to a PEM file with additional CA certificates trusted for API requests
+        """
+        return __config__.get('caBundle')
 
     @property
     def customer_id(self) -> Optional[str]:
         """
-        The Netcup customer ID
+        The Netcup customer ID. Can also be set with NETCUP_CUSTOMER_ID
+        """
+        return __config__.get('customerId') or _utilities.get_env('NETCUP_CUSTOMER_ID')
+
+    @property
+    def endpoint(self) -> Optional[str]:
+        """
+        The URL of the Netcup CCP JSON API. Defaults to https://ccp.netcup.net/run/webservice/servers/endpoint.php?JSON
+        """
+        return __config__.get('endpoint')
+
+    @property
+    def max_retries(self) -> Optional[int]:
+        """
+        How often a rate-limited (status 2057) or transiently failed API request is retried. Defaults to 5
+        """
+        return __config__.get_int('maxRetries')
+
+    @property
+    def nameserver(self) -> Optional[str]:
+        """
+        The nameserver (host:port) DNSKEY records of DNSSEC signed zones are read from. Defaults to root-dns.netcup.net:53
+        """
+        return __config__.get('nameserver')
+
+    @property
+    def proxy_url(self) -> Optional[str]:
+        """
+        The URL of an HTTP proxy for API requests. Defaults to the HTTPS_PROXY and NO_PROXY environment variables
+        """
+        return __config__.get('proxyUrl')
+
+    @property
+    def request_timeout(self) -> Optional[int]:
+        """
+        The timeout of a single API request in seconds. Defaults to 30
+        """
+        return __config__.get_int('requestTimeout')
+
+    @property
+    def requests_per_minute(self) -> Optional[int]:
+        """
+        The maximum number of Netcup API requests per minute made by the provider. The limit is shared by all provider instances in the process. Defaults to 180
+        """
+        return __config__.get_int('requestsPerMinute')
+
+    @property
+    def user_agent(self) -> Optional[str]:
+        """
+        The User-Agent header sent with API requests
         """
-        return __config__.get('customerId')
+        return __config__.get('userAgent')
 
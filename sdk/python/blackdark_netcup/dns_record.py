@@ -14,6 +14,8 @@ if sys.version_info >= (3, 11):
 else:
     from typing_extensions import NotRequired, TypedDict, TypeAlias
 from . import _utilities
+from . import outputs
+from ._inputs import *
 
 __all__ = ['DNSRecordArgs', 'DNSRecord']
 
@@ -21,24 +23,46 @@ __all__ = ['DNSRecordArgs', 'DNSRecord']
 class DNSRecordArgs:
     def __init__(__self__, *,
                  domain: pulumi.Input[builtins.str],
-                 name: pulumi.Input[builtins.str],
                  type: pulumi.Input[builtins.str],
-                 value: pulumi.Input[builtins.str],
-                 priority: Optional[pulumi.Input[builtins.str]] = None):
+                 caa: Optional[pulumi.Input['CAARecordArgs']] = None,
+                 name: Optional[pulumi.Input[builtins.str]] = None,
+                 openpgpkey: Optional[pulumi.Input['OPENPGPKEYRecordArgs']] = None,
+                 priority: Optional[pulumi.Input[builtins.str]] = None,
+                 smimea: Optional[pulumi.Input['SMIMEARecordArgs']] = None,
+                 srv: Optional[pulumi.Input['SRVRecordArgs']] = None,
+                 tlsa: Optional[pulumi.Input['TLSARecordArgs']] = None,
+                 value: Optional[pulumi.Input[builtins.str]] = None):
         """
         The set of arguments for constructing a DNSRecord resource.
         :param pulumi.Input[builtins.str] domain: The domain name for the DNS record (e.g., 'example.com')
-        :param pulumi.Input[builtins.str] name: The hostname for the DNS record. Use '@' for root domain, or specify subdomain (e.g., 'www', 'mail')
         :param pulumi.Input[builtins.str] type: The DNS record type. Supported types: A, AAAA, CNAME, MX, TXT, SRV, CAA, TLSA, NS, DS, OPENPGPKEY, SMIMEA, SSHFP
-        :param pulumi.Input[builtins.str] value: The value/destination for the DNS record (e.g., IP address for A records, hostname for CNAME)
+        :param pulumi.Input['CAARecordArgs'] caa: Structured inputs of a CAA record. The value is composed as 'flags tag "value"'
+        :param pulumi.Input[builtins.str] name: The hostname for the DNS record. Use '@' for root domain, or specify subdomain (e.g., 'www', 'mail'). Defaults to '@', for openpgpkey and smimea records to the name derived from the email address
+        :param pulumi.Input['OPENPGPKEYRecordArgs'] openpgpkey: Inputs of an OPENPGPKEY record publishing the key of an email address. The name is derived from the hashed local part below '_openpgpkey' and the value is the base64 encoded key
         :param pulumi.Input[builtins.str] priority: The priority for MX and SRV records (required for these types, ignored for others)
+        :param pulumi.Input['SMIMEARecordArgs'] smimea: Inputs of an SMIMEA record publishing the S/MIME certificate of an email address. The name is derived from the hashed local part below '_smimecert'
+        :param pulumi.Input['SRVRecordArgs'] srv: Structured inputs of an SRV record. The value is composed as 'weight port target' and the name is placed below '_service._proto', using '@' for the service of the domain itself
+        :param pulumi.Input['TLSARecordArgs'] tlsa: Inputs of a TLSA record derived from a certificate or public key. The association data is computed from the certificate and the name is placed below '_port._proto'
+        :param pulumi.Input[builtins.str] value: The value/destination for the DNS record (e.g., IP address for A records, hostname for CNAME). Required unless the value is composed from structured inputs such as srv
         """
         pulumi.set(__self__, "domain", domain)
-        pulumi.set(__self__, "name", name)
         pulumi.set(__self__, "type", type)
-        pulumi.set(__self__, "value", value)
+        if caa is not None:
+            pulumi.set(__self__, "caa", caa)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+        if openpgpkey is not None:
+            pulumi.set(__self__, "openpgpkey", openpgpkey)
         if priority is not None:
             pulumi.set(__self__, "priority", priority)
+        if smimea is not None:
+            pulumi.set(__self__, "smimea", smimea)
+        if srv is not None:
+            pulumi.set(__self__, "srv", srv)
+        if tlsa is not None:
+            pulumi.set(__self__, "tlsa", tlsa)
+        if value is not None:
+            pulumi.set(__self__, "value", value)
 
     @property
     @pulumi.getter
@@ -52,18 +76,6 @@ class DNSRecordArgs:
     def domain(self, value: pulumi.Input[builtins.str]):
         pulumi.set(self, "domain", value)
 
-    @property
-    @pulumi.getter
-    def name(self) -> pulumi.Input[builtins.str]:
-        """
-        The hostname for the DNS record. Use '@' for root domain, or specify subdomain (e.g., 'www', 'mail')
-        """
-        return pulumi.get(self, "name")
-
-    @name.setter
-    def name(self, value: pulumi.Input[builtins.str]):
-        pulumi.set(self, "name", value)
-
     @property
     @pulumi.getter
     def type(self) -> pulumi.Input[builtins.str]:
@@ -78,15 +90,39 @@ class DNSRecordArgs:
 
     @property
     @pulumi.getter
-    def value(self) -> pulumi.Input[builtins.str]:
+    def caa(self) -> Optional[pulumi.Input['CAARecordArgs']]:
         """
-        The value/destination for the DNS record (e.g., IP address for A records, hostname for CNAME)
+        Structured inputs of a CAA record. The value is composed as 'flags tag "value"'
         """
-        return pulumi.get(self, "value")
+        return pulumi.get(self, "caa")
 
-    @value.setter
-    def value(self, value: pulumi.Input[builtins.str]):
-        pulumi.set(self, "value", value)
+    @caa.setter
+    def caa(self, value: Optional[pulumi.Input['CAARecordArgs']]):
+        pulumi.set(self, "caa", value)
+
+    @property
+    @pulumi.getter
+    def name(self) -> Optional[pulumi.Input[builtins.str]]:
+        """
+        The hostname for the DNS record. Use '@' for root domain, or specify subdomain (e.g., 'www', 'mail'). Defaults to '@', for openpgpkey and smimea records to the name derived from the email address
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: Optional[pulumi.Input[builtins.str]]):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter
+    def openpgpkey(self) -> Optional[pulumi.Input['OPENPGPKEYRecordArgs']]:
+        """
+        Inputs of an OPENPGPKEY record publishing the key of an email address. The name is derived from the hashed local part below '_openpgpkey' and the value is the base64 encoded key
+        """
+        return pulumi.get(self, "openpgpkey")
+
+    @openpgpkey.setter
+    def openpgpkey(self, value: Optional[pulumi.Input['OPENPGPKEYRecordArgs']]):
+        pulumi.set(self, "openpgpkey", value)
 
     @property
     @pulumi.getter
@@ -100,6 +136,54 @@ class DNSRecordArgs:
     def priority(self, value: Optional[pulumi.Input[builtins.str]]):
         pulumi.set(self, "priority", value)
 
+    @property
+    @pulumi.getter
+    def smimea(self) -> Optional[pulumi.Input['SMIMEARecordArgs']]:
+        """
+        Inputs of an SMIMEA record publishing the S/MIME certificate of an email address. The name is derived from the hashed local part below '_smimecert'
+        """
+        return pulumi.get(self, "smimea")
+
+    @smimea.setter
+    def smimea(self, value: Optional[pulumi.Input['SMIMEARecordArgs']]):
+        pulumi.set(self, "smimea", value)
+
+    @property
+    @pulumi.getter
+    def srv(self) -> Optional[pulumi.Input['SRVRecordArgs']]:
+        """
+        Structured inputs of an SRV record. The value is composed as 'weight port target' and the name is placed below '_service._proto', using '@' for the service of the domain itself
+        """
+        return pulumi.get(self, "srv")
+
+    @srv.setter
+    def srv(self, value: Optional[pulumi.Input['SRVRecordArgs']]):
+        pulumi.set(self, "srv", value)
+
+    @property
+    @pulumi.getter
+    def tlsa(self) -> Optional[pulumi.Input['TLSARecordArgs']]:
+        """
+        Inputs of a TLSA record derived from a certificate or public key. The association data is computed from the certificate and the name is placed below '_port._proto'
+        """
+        return pulumi.get(self, "tlsa")
+
+    @tlsa.setter
+    def tlsa(self, value: Optional[pulumi.Input['TLSARecordArgs']]):
+        pulumi.set(self, "tlsa", value)
+
+    @property
+    @pulumi.getter
+    def value(self) -> Optional[pulumi.Input[builtins.str]]:
+        """
+        The value/destination for the DNS record (e.g., IP address for A records, hostname for CNAME). Required unless the value is composed from structured inputs such as srv
+        """
+        return pulumi.get(self, "value")
+
+    @value.setter
+    def value(self, value: Optional[pulumi.Input[builtins.str]]):
+        pulumi.set(self, "value", value)
+
 
 @pulumi.type_token("netcup:index:DNSRecord")
 class DNSRecord(pulumi.CustomResource):
@@ -107,22 +191,32 @@ class DNSRecord(pulumi.CustomResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 caa: Optional[pulumi.Input[Union['CAARecordArgs', 'CAARecordArgsDict']]] = None,
                  domain: Optional[pulumi.Input[builtins.str]] = None,
                  name: Optional[pulumi.Input[builtins.str]] = None,
+                 openpgpkey: Optional[pulumi.Input[Union['OPENPGPKEYRecordArgs', 'OPENPGPKEYRecordArgsDict']]] = None,
                  priority: Optional[pulumi.Input[builtins.str]] = None,
+                 smimea: Optional[pulumi.Input[Union['SMIMEARecordArgs', 'SMIMEARecordArgsDict']]] = None,
+                 srv: Optional[pulumi.Input[Union['SRVRecordArgs', 'SRVRecordArgsDict']]] = None,
+                 tlsa: Optional[pulumi.Input[Union['TLSARecordArgs', 'TLSARecordArgsDict']]] = None,
                  type: Optional[pulumi.Input[builtins.str]] = None,
                  value: Optional[pulumi.Input[builtins.str]] = None,
                  __props__=None):
         """
-        A DNS record managed by Netcup DNS service
+        A DNS record managed by Netcup DNS service. Existing records are imported with the ID 'domain:recordID' or by content with 'domain/name/type' or 'domain/name/type/value'
 
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Union['CAARecordArgs', 'CAARecordArgsDict']] caa: Structured inputs of a CAA record. The value is composed as 'flags tag "value"'
         :param pulumi.Input[builtins.str] domain: The domain name for the DNS record (e.g., 'example.com')
-        :param pulumi.Input[builtins.str] name: The hostname for the DNS record. Use '@' for root domain, or specify subdomain (e.g., 'www', 'mail')
+        :param pulumi.Input[builtins.str] name: The hostname for the DNS record. Use '@' for root domain, or specify subdomain (e.g., 'www', 'mail'). Defaults to '@', for openpgpkey and smimea records to the name derived from the email address
+        :param pulumi.Input[Union['OPENPGPKEYRecordArgs', 'OPENPGPKEYRecordArgsDict']] openpgpkey: Inputs of an OPENPGPKEY record publishing the key of an email address. The name is derived from the hashed local part below '_openpgpkey' and the value is the base64 encoded key
         :param pulumi.Input[builtins.str] priority: The priority for MX and SRV records (required for these types, ignored for others)
+        :param pulumi.Input[Union['SMIMEARecordArgs', 'SMIMEARecordArgsDict']] smimea: Inputs of an SMIMEA record publishing the S/MIME certificate of an email address. The name is derived from the hashed local part below '_smimecert'
+        :param pulumi.Input[Union['SRVRecordArgs', 'SRVRecordArgsDict']] srv: Structured inputs of an SRV record. The value is composed as 'weight port target' and the name is placed below '_service._proto', using '@' for the service of the domain itself
+        :param pulumi.Input[Union['TLSARecordArgs', 'TLSARecordArgsDict']] tlsa: Inputs of a TLSA record derived from a certificate or public key. The association data is computed from the certificate and the name is placed below '_port._proto'
         :param pulumi.Input[builtins.str] type: The DNS record type. Supported types: A, AAAA, CNAME, MX, TXT, SRV, CAA, TLSA, NS, DS, OPENPGPKEY, SMIMEA, SSHFP
-        :param pulumi.Input[builtins.str] value: The value/destination for the DNS record (e.g., IP address for A records, hostname for CNAME)
+        :param pulumi.Input[builtins.str] value: The value/destination for the DNS record (e.g., IP address for A records, hostname for CNAME). Required unless the value is composed from structured inputs such as srv
         """
         ...
     @overload
@@ -131,7 +225,7 @@ class DNSRecord(pulumi.CustomResource):
                  args: DNSRecordArgs,
                  opts: Optional[pulumi.ResourceOptions] = None):
         """
-        A DNS record managed by Netcup DNS service
+        A DNS record managed by Netcup DNS service. Existing records are imported with the ID 'domain:recordID' or by content with 'domain/name/type' or 'domain/name/type/value'
 
         :param str resource_name: The name of the resource.
         :param DNSRecordArgs args: The arguments to use to populate this resource's properties.
@@ -148,9 +242,14 @@ class DNSRecord(pulumi.CustomResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 caa: Optional[pulumi.Input[Union['CAARecordArgs', 'CAARecordArgsDict']]] = None,
                  domain: Optional[pulumi.Input[builtins.str]] = None,
                  name: Optional[pulumi.Input[builtins.str]] = None,
+                 openpgpkey: Optional[pulumi.Input[Union['OPENPGPKEYRecordArgs', 'OPENPGPKEYRecordArgsDict']]] = None,
                  priority: Optional[pulumi.Input[builtins.str]] = None,
+                 smimea: Optional[pulumi.Input[Union['SMIMEARecordArgs', 'SMIMEARecordArgsDict']]] = None,
+                 srv: Optional[pulumi.Input[Union['SRVRecordArgs', 'SRVRecordArgsDict']]] = None,
+                 tlsa: Optional[pulumi.Input[Union['TLSARecordArgs', 'TLSARecordArgsDict']]] = None,
                  type: Optional[pulumi.Input[builtins.str]] = None,
                  value: Optional[pulumi.Input[builtins.str]] = None,
                  __props__=None):
@@ -162,18 +261,19 @@ class DNSRecord(pulumi.CustomResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = DNSRecordArgs.__new__(DNSRecordArgs)
 
+            __props__.__dict__["caa"] = caa
             if domain is None and not opts.urn:
                 raise TypeError("Missing required property 'domain'")
             __props__.__dict__["domain"] = domain
-            if name is None and not opts.urn:
-                raise TypeError("Missing required property 'name'")
             __props__.__dict__["name"] = name
+            __props__.__dict__["openpgpkey"] = openpgpkey
             __props__.__dict__["priority"] = priority
+            __props__.__dict__["smimea"] = smimea
+            __props__.__dict__["srv"] = srv
+            __props__.__dict__["tlsa"] = tlsa
             if type is None and not opts.urn:
                 raise TypeError("Missing required property 'type'")
             __props__.__dict__["type"] = type
-            if value is None and not opts.urn:
-                raise TypeError("Missing required property 'value'")
             __props__.__dict__["value"] = value
             __props__.__dict__["fqdn"] = None
             __props__.__dict__["record_id"] = None
@@ -199,15 +299,28 @@ class DNSRecord(pulumi.CustomResource):
 
         __props__ = DNSRecordArgs.__new__(DNSRecordArgs)
 
+        __props__.__dict__["caa"] = None
         __props__.__dict__["domain"] = None
         __props__.__dict__["fqdn"] = None
         __props__.__dict__["name"] = None
+        __props__.__dict__["openpgpkey"] = None
         __props__.__dict__["priority"] = None
         __props__.__dict__["record_id"] = None
+        __props__.__dict__["smimea"] = None
+        __props__.__dict__["srv"] = None
+        __props__.__dict__["tlsa"] = None
         __props__.__dict__["type"] = None
         __props__.__dict__["value"] = None
         return DNSRecord(resource_name, opts=opts, __props__=__props__)
 
+    @property
+    @pulumi.getter
+    def caa(self) -> pulumi.Output[Optional['outputs.CAARecord']]:
+        """
+        The structured inputs of a CAA record
+        """
+        return pulumi.get(self, "caa")
+
     @property
     @pulumi.getter
     def domain(self) -> pulumi.Output[builtins.str]:
@@ -226,12 +339,20 @@ class DNSRecord(pulumi.CustomResource):
 
     @property
     @pulumi.getter
-    def name(self) -> pulumi.Output[builtins.str]:
+    def name(self) -> pulumi.Output[Optional[builtins.str]]:
         """
         The hostname for the DNS record
         """
         return pulumi.get(self, "name")
 
+    @property
+    @pulumi.getter
+    def openpgpkey(self) -> pulumi.Output[Optional['outputs.OPENPGPKEYRecord']]:
+        """
+        The inputs the OPENPGPKEY record is derived from
+        """
+        return pulumi.get(self, "openpgpkey")
+
     @property
     @pulumi.getter
     def priority(self) -> pulumi.Output[Optional[builtins.str]]:
@@ -248,6 +369,30 @@ class DNSRecord(pulumi.CustomResource):
         """
         return pulumi.get(self, "record_id")
 
+    @property
+    @pulumi.getter
+    def smimea(self) -> pulumi.Output[Optional['outputs.SMIMEARecord']]:
+        """
+        The inputs the SMIMEA record is derived from
+        """
+        return pulumi.get(self, "smimea")
+
+    @property
+    @pulumi.getter
+    def srv(self) -> pulumi.Output[Optional['outputs.SRVRecord']]:
+        """
+        The structured inputs of an SRV record
+        """
+        return pulumi.get(self, "srv")
+
+    @property
+    @pulumi.getter
+    def tlsa(self) -> pulumi.Output[Optional['outputs.TLSARecord']]:
+        """
+        The inputs the TLSA record is derived from
+        """
+        return pulumi.get(self, "tlsa")
+
     @property
     @pulumi.getter
     def type(self) -> pulumi.Output[builtins.str]:
@@ -258,7 +403,7 @@ class DNSRecord(pulumi.CustomResource):
 
     @property
     @pulumi.getter
-    def value(self) -> pulumi.Output[builtins.str]:
+    def value(self) -> pulumi.Output[Optional[builtins.str]]:
         """
         The value/destination for the DNS record
         """
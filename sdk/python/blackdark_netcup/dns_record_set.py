@@ -0,0 +1,267 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = ['DNSRecordSetArgs', 'DNSRecordSet']
+
+@pulumi.input_type
+class DNSRecordSetArgs:
+    def __init__(__self__, *,
+                 domain: pulumi.Input[builtins.str],
+                 name: pulumi.Input[builtins.str],
+                 type: pulumi.Input[builtins.str],
+                 sshfp: Optional[pulumi.Input['SSHFPRecordSetArgs']] = None,
+                 values: Optional[pulumi.Input[Sequence[pulumi.Input['DNSRecordSetValueArgs']]]] = None):
+        """
+        The set of arguments for constructing a DNSRecordSet resource.
+        :param pulumi.Input[builtins.str] domain: The domain name for the DNS records (e.g., 'example.com')
+        :param pulumi.Input[builtins.str] name: The hostname of the DNS records. Use '@' for root domain
+        :param pulumi.Input[builtins.str] type: The DNS record type. Supported types: A, AAAA, CNAME, MX, TXT, SRV, CAA, TLSA, NS, DS, OPENPGPKEY, SMIMEA, SSHFP
+        :param pulumi.Input['SSHFPRecordSetArgs'] sshfp: Host keys the values of an SSHFP record set are derived from, a SHA-1 and a SHA-256 record per key
+        :param pulumi.Input[Sequence[pulumi.Input['DNSRecordSetValueArgs']]] values: The values of the records, one record per value. Required unless sshfp is set
+        """
+        pulumi.set(__self__, "domain", domain)
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "type", type)
+        if sshfp is not None:
+            pulumi.set(__self__, "sshfp", sshfp)
+        if values is not None:
+            pulumi.set(__self__, "values", values)
+
+    @property
+    @pulumi.getter
+    def domain(self) -> pulumi.Input[builtins.str]:
+        """
+        The domain name for the DNS records (e.g., 'example.com')
+        """
+        return pulumi.get(self, "domain")
+
+    @domain.setter
+    def domain(self, value: pulumi.Input[builtins.str]):
+        pulumi.set(self, "domain", value)
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Input[builtins.str]:
+        """
+        The hostname of the DNS records. Use '@' for root domain
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: pulumi.Input[builtins.str]):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter
+    def type(self) -> pulumi.Input[builtins.str]:
+        """
+        The DNS record type. Supported types: A, AAAA, CNAME, MX, TXT, SRV, CAA, TLSA, NS, DS, OPENPGPKEY, SMIMEA, SSHFP
+        """
+        return pulumi.get(self, "type")
+
+    @type.setter
+    def type(self, value: pulumi.Input[builtins.str]):
+        pulumi.set(self, "type", value)
+
+    @property
+    @pulumi.getter
+    def sshfp(self) -> Optional[pulumi.Input['SSHFPRecordSetArgs']]:
+        """
+        Host keys the values of an SSHFP record set are derived from, a SHA-1 and a SHA-256 record per key
+        """
+        return pulumi.get(self, "sshfp")
+
+    @sshfp.setter
+    def sshfp(self, value: Optional[pulumi.Input['SSHFPRecordSetArgs']]):
+        pulumi.set(self, "sshfp", value)
+
+    @property
+    @pulumi.getter
+    def values(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['DNSRecordSetValueArgs']]]]:
+        """
+        The values of the records, one record per value. Required unless sshfp is set
+        """
+        return pulumi.get(self, "values")
+
+    @values.setter
+    def values(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['DNSRecordSetValueArgs']]]]):
+        pulumi.set(self, "values", value)
+
+
+@pulumi.type_token("netcup:index:DNSRecordSet")
+class DNSRecordSet(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 domain: Optional[pulumi.Input[builtins.str]] = None,
+                 name: Optional[pulumi.Input[builtins.str]] = None,
+                 sshfp: Optional[pulumi.Input[Union['SSHFPRecordSetArgs', 'SSHFPRecordSetArgsDict']]] = None,
+                 type: Optional[pulumi.Input[builtins.str]] = None,
+                 values: Optional[pulumi.Input[Sequence[pulumi.Input[Union['DNSRecordSetValueArgs', 'DNSRecordSetValueArgsDict']]]]] = None,
+                 __props__=None):
+        """
+        All DNS records of one hostname and type, such as round-robin A records, several MX hosts or multiple TXT values. Records of the hostname and type that are not listed are deleted
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[builtins.str] domain: The domain name for the DNS records (e.g., 'example.com')
+        :param pulumi.Input[builtins.str] name: The hostname of the DNS records. Use '@' for root domain
+        :param pulumi.Input[Union['SSHFPRecordSetArgs', 'SSHFPRecordSetArgsDict']] sshfp: Host keys the values of an SSHFP record set are derived from, a SHA-1 and a SHA-256 record per key
+        :param pulumi.Input[builtins.str] type: The DNS record type. Supported types: A, AAAA, CNAME, MX, TXT, SRV, CAA, TLSA, NS, DS, OPENPGPKEY, SMIMEA, SSHFP
+        :param pulumi.Input[Sequence[pulumi.Input[Union['DNSRecordSetValueArgs', 'DNSRecordSetValueArgsDict']]]] values: The values of the records, one record per value. Required unless sshfp is set
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: DNSRecordSetArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        All DNS records of one hostname and type, such as round-robin A records, several MX hosts or multiple TXT values. Records of the hostname and type that are not listed are deleted
+
+        :param str resource_name: The name of the resource.
+        :param DNSRecordSetArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(DNSRecordSetArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 domain: Optional[pulumi.Input[builtins.str]] = None,
+                 name: Optional[pulumi.Input[builtins.str]] = None,
+                 sshfp: Optional[pulumi.Input[Union['SSHFPRecordSetArgs', 'SSHFPRecordSetArgsDict']]] = None,
+                 type: Optional[pulumi.Input[builtins.str]] = None,
+                 values: Optional[pulumi.Input[Sequence[pulumi.Input[Union['DNSRecordSetValueArgs', 'DNSRecordSetValueArgsDict']]]]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = DNSRecordSetArgs.__new__(DNSRecordSetArgs)
+
+            if domain is None and not opts.urn:
+                raise TypeError("Missing required property 'domain'")
+            __props__.__dict__["domain"] = domain
+            if name is None and not opts.urn:
+                raise TypeError("Missing required property 'name'")
+            __props__.__dict__["name"] = name
+            __props__.__dict__["sshfp"] = sshfp
+            if type is None and not opts.urn:
+                raise TypeError("Missing required property 'type'")
+            __props__.__dict__["type"] = type
+            __props__.__dict__["values"] = values
+            __props__.__dict__["fqdn"] = None
+            __props__.__dict__["record_ids"] = None
+        super(DNSRecordSet, __self__).__init__(
+            'netcup:index:DNSRecordSet',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'DNSRecordSet':
+        """
+        Get an existing DNSRecordSet resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = DNSRecordSetArgs.__new__(DNSRecordSetArgs)
+
+        __props__.__dict__["domain"] = None
+        __props__.__dict__["fqdn"] = None
+        __props__.__dict__["name"] = None
+        __props__.__dict__["record_ids"] = None
+        __props__.__dict__["sshfp"] = None
+        __props__.__dict__["type"] = None
+        __props__.__dict__["values"] = None
+        return DNSRecordSet(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def domain(self) -> pulumi.Output[builtins.str]:
+        """
+        The domain name for the DNS records
+        """
+        return pulumi.get(self, "domain")
+
+    @property
+    @pulumi.getter
+    def fqdn(self) -> pulumi.Output[builtins.str]:
+        """
+        The fully qualified domain name
+        """
+        return pulumi.get(self, "fqdn")
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[builtins.str]:
+        """
+        The hostname of the DNS records
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="recordIds")
+    def record_ids(self) -> pulumi.Output[Sequence[builtins.str]]:
+        """
+        The Netcup IDs of the records, in the order of values
+        """
+        return pulumi.get(self, "record_ids")
+
+    @property
+    @pulumi.getter
+    def sshfp(self) -> pulumi.Output[Optional['outputs.SSHFPRecordSet']]:
+        """
+        The host keys the values of an SSHFP record set are derived from
+        """
+        return pulumi.get(self, "sshfp")
+
+    @property
+    @pulumi.getter
+    def type(self) -> pulumi.Output[builtins.str]:
+        """
+        The DNS record type
+        """
+        return pulumi.get(self, "type")
+
+    @property
+    @pulumi.getter
+    def values(self) -> pulumi.Output[Optional[Sequence['outputs.DNSRecordSetValue']]]:
+        """
+        The values of the records
+        """
+        return pulumi.get(self, "values")
+
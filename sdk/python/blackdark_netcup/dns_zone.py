@@ -0,0 +1,302 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = ['DNSZoneArgs', 'DNSZone']
+
+@pulumi.input_type
+class DNSZoneArgs:
+    def __init__(__self__, *,
+                 domain: pulumi.Input[builtins.str],
+                 dnssec: Optional[pulumi.Input[builtins.bool]] = None,
+                 expire: Optional[pulumi.Input[builtins.int]] = None,
+                 refresh: Optional[pulumi.Input[builtins.int]] = None,
+                 retry: Optional[pulumi.Input[builtins.int]] = None,
+                 ttl: Optional[pulumi.Input[builtins.int]] = None):
+        """
+        The set of arguments for constructing a DNSZone resource.
+        :param pulumi.Input[builtins.str] domain: The domain name of the zone (e.g., 'example.com')
+        :param pulumi.Input[builtins.bool] dnssec: Whether the zone is signed with DNSSEC. Left unchanged when not set
+        :param pulumi.Input[builtins.int] expire: The SOA expire time in seconds (RFC 1912 recommends at least 604800). Left unchanged when not set
+        :param pulumi.Input[builtins.int] refresh: The SOA refresh interval in seconds (RFC 1912 recommends at least 1200). Left unchanged when not set
+        :param pulumi.Input[builtins.int] retry: The SOA retry interval in seconds (RFC 1912 recommends at least 180). Left unchanged when not set
+        :param pulumi.Input[builtins.int] ttl: The default TTL of the zone in seconds (RFC 1912 recommends at least 300). Left unchanged when not set
+        """
+        pulumi.set(__self__, "domain", domain)
+        if dnssec is not None:
+            pulumi.set(__self__, "dnssec", dnssec)
+        if expire is not None:
+            pulumi.set(__self__, "expire", expire)
+        if refresh is not None:
+            pulumi.set(__self__, "refresh", refresh)
+        if retry is not None:
+            pulumi.set(__self__, "retry", retry)
+        if ttl is not None:
+            pulumi.set(__self__, "ttl", ttl)
+
+    @property
+    @pulumi.getter
+    def domain(self) -> pulumi.Input[builtins.str]:
+        """
+        The domain name of the zone (e.g., 'example.com')
+        """
+        return pulumi.get(self, "domain")
+
+    @domain.setter
+    def domain(self, value: pulumi.Input[builtins.str]):
+        pulumi.set(self, "domain", value)
+
+    @property
+    @pulumi.getter
+    def dnssec(self) -> Optional[pulumi.Input[builtins.bool]]:
+        """
+        Whether the zone is signed with DNSSEC. Left unchanged when not set
+        """
+        return pulumi.get(self, "dnssec")
+
+    @dnssec.setter
+    def dnssec(self, value: Optional[pulumi.Input[builtins.bool]]):
+        pulumi.set(self, "dnssec", value)
+
+    @property
+    @pulumi.getter
+    def expire(self) -> Optional[pulumi.Input[builtins.int]]:
+        """
+        The SOA expire time in seconds (RFC 1912 recommends at least 604800). Left unchanged when not set
+        """
+        return pulumi.get(self, "expire")
+
+    @expire.setter
+    def expire(self, value: Optional[pulumi.Input[builtins.int]]):
+        pulumi.set(self, "expire", value)
+
+    @property
+    @pulumi.getter
+    def refresh(self) -> Optional[pulumi.Input[builtins.int]]:
+        """
+        The SOA refresh interval in seconds (RFC 1912 recommends at least 1200). Left unchanged when not set
+        """
+        return pulumi.get(self, "refresh")
+
+    @refresh.setter
+    def refresh(self, value: Optional[pulumi.Input[builtins.int]]):
+        pulumi.set(self, "refresh", value)
+
+    @property
+    @pulumi.getter
+    def retry(self) -> Optional[pulumi.Input[builtins.int]]:
+        """
+        The SOA retry interval in seconds (RFC 1912 recommends at least 180). Left unchanged when not set
+        """
+        return pulumi.get(self, "retry")
+
+    @retry.setter
+    def retry(self, value: Optional[pulumi.Input[builtins.int]]):
+        pulumi.set(self, "retry", value)
+
+    @property
+    @pulumi.getter
+    def ttl(self) -> Optional[pulumi.Input[builtins.int]]:
+        """
+        The default TTL of the zone in seconds (RFC 1912 recommends at least 300). Left unchanged when not set
+        """
+        return pulumi.get(self, "ttl")
+
+    @ttl.setter
+    def ttl(self, value: Optional[pulumi.Input[builtins.int]]):
+        pulumi.set(self, "ttl", value)
+
+
+@pulumi.type_token("netcup:index:DNSZone")
+class DNSZone(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 dnssec: Optional[pulumi.Input[builtins.bool]] = None,
+                 domain: Optional[pulumi.Input[builtins.str]] = None,
+                 expire: Optional[pulumi.Input[builtins.int]] = None,
+                 refresh: Optional[pulumi.Input[builtins.int]] = None,
+                 retry: Optional[pulumi.Input[builtins.int]] = None,
+                 ttl: Optional[pulumi.Input[builtins.int]] = None,
+                 __props__=None):
+        """
+        The settings of a DNS zone managed by Netcup DNS service. The zone of the domain must already exist; creating the resource adopts it and deleting the resource leaves the zone and its settings untouched
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[builtins.bool] dnssec: Whether the zone is signed with DNSSEC. Left unchanged when not set
+        :param pulumi.Input[builtins.str] domain: The domain name of the zone (e.g., 'example.com')
+        :param pulumi.Input[builtins.int] expire: The SOA expire time in seconds (RFC 1912 recommends at least 604800). Left unchanged when not set
+        :param pulumi.Input[builtins.int] refresh: The SOA refresh interval in seconds (RFC 1912 recommends at least 1200). Left unchanged when not set
+        :param pulumi.Input[builtins.int] retry: The SOA retry interval in seconds (RFC 1912 recommends at least 180). Left unchanged when not set
+        :param pulumi.Input[builtins.int] ttl: The default TTL of the zone in seconds (RFC 1912 recommends at least 300). Left unchanged when not set
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: DNSZoneArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        The settings of a DNS zone managed by Netcup DNS service. The zone of the domain must already exist; creating the resource adopts it and deleting the resource leaves the zone and its settings untouched
+
+        :param str resource_name: The name of the resource.
+        :param DNSZoneArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(DNSZoneArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 dnssec: Optional[pulumi.Input[builtins.bool]] = None,
+                 domain: Optional[pulumi.Input[builtins.str]] = None,
+                 expire: Optional[pulumi.Input[builtins.int]] = None,
+                 refresh: Optional[pulumi.Input[builtins.int]] = None,
+                 retry: Optional[pulumi.Input[builtins.int]] = None,
+                 ttl: Optional[pulumi.Input[builtins.int]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = DNSZoneArgs.__new__(DNSZoneArgs)
+
+            __props__.__dict__["dnssec"] = dnssec
+            if domain is None and not opts.urn:
+                raise TypeError("Missing required property 'domain'")
+            __props__.__dict__["domain"] = domain
+            __props__.__dict__["expire"] = expire
+            __props__.__dict__["refresh"] = refresh
+            __props__.__dict__["retry"] = retry
+            __props__.__dict__["ttl"] = ttl
+            __props__.__dict__["dnskeys"] = None
+            __props__.__dict__["ds_records"] = None
+            __props__.__dict__["serial"] = None
+        super(DNSZone, __self__).__init__(
+            'netcup:index:DNSZone',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'DNSZone':
+        """
+        Get an existing DNSZone resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = DNSZoneArgs.__new__(DNSZoneArgs)
+
+        __props__.__dict__["dnskeys"] = None
+        __props__.__dict__["dnssec"] = None
+        __props__.__dict__["domain"] = None
+        __props__.__dict__["ds_records"] = None
+        __props__.__dict__["expire"] = None
+        __props__.__dict__["refresh"] = None
+        __props__.__dict__["retry"] = None
+        __props__.__dict__["serial"] = None
+        __props__.__dict__["ttl"] = None
+        return DNSZone(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def dnskeys(self) -> pulumi.Output[Sequence[builtins.str]]:
+        """
+        The DNSKEY records of the signed zone in presentation format. Empty while DNSSEC is disabled or Netcup has not finished signing the zone
+        """
+        return pulumi.get(self, "dnskeys")
+
+    @property
+    @pulumi.getter
+    def dnssec(self) -> pulumi.Output[Optional[builtins.bool]]:
+        """
+        Whether the zone is signed with DNSSEC
+        """
+        return pulumi.get(self, "dnssec")
+
+    @property
+    @pulumi.getter
+    def domain(self) -> pulumi.Output[builtins.str]:
+        """
+        The domain name of the zone
+        """
+        return pulumi.get(self, "domain")
+
+    @property
+    @pulumi.getter(name="dsRecords")
+    def ds_records(self) -> pulumi.Output[Sequence[builtins.str]]:
+        """
+        The SHA-256 DS records of the key signing keys, to be published in the parent zone or at the registrar
+        """
+        return pulumi.get(self, "ds_records")
+
+    @property
+    @pulumi.getter
+    def expire(self) -> pulumi.Output[Optional[builtins.int]]:
+        """
+        The SOA expire time in seconds
+        """
+        return pulumi.get(self, "expire")
+
+    @property
+    @pulumi.getter
+    def refresh(self) -> pulumi.Output[Optional[builtins.int]]:
+        """
+        The SOA refresh interval in seconds
+        """
+        return pulumi.get(self, "refresh")
+
+    @property
+    @pulumi.getter
+    def retry(self) -> pulumi.Output[Optional[builtins.int]]:
+        """
+        The SOA retry interval in seconds
+        """
+        return pulumi.get(self, "retry")
+
+    @property
+    @pulumi.getter
+    def serial(self) -> pulumi.Output[builtins.str]:
+        """
+        The current SOA serial of the zone
+        """
+        return pulumi.get(self, "serial")
+
+    @property
+    @pulumi.getter
+    def ttl(self) -> pulumi.Output[Optional[builtins.int]]:
+        """
+        The default TTL of the zone in seconds
+        """
+        return pulumi.get(self, "ttl")
+
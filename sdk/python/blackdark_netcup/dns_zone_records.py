@@ -0,0 +1,226 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = ['DNSZoneRecordsArgs', 'DNSZoneRecords']
+
+@pulumi.input_type
+class DNSZoneRecordsArgs:
+    def __init__(__self__, *,
+                 domain: pulumi.Input[builtins.str],
+                 ignore: Optional[pulumi.Input[Sequence[pulumi.Input['DNSRecordFilterArgs']]]] = None,
+                 records: Optional[pulumi.Input[Sequence[pulumi.Input['DNSZoneRecordArgs']]]] = None,
+                 zone_file: Optional[pulumi.Input[builtins.str]] = None):
+        """
+        The set of arguments for constructing a DNSZoneRecords resource.
+        :param pulumi.Input[builtins.str] domain: The domain name whose records are managed (e.g., 'example.com')
+        :param pulumi.Input[Sequence[pulumi.Input['DNSRecordFilterArgs']]] ignore: Existing records matching any of these rules are left alone instead of being deleted
+        :param pulumi.Input[Sequence[pulumi.Input['DNSZoneRecordArgs']]] records: The complete list of records the domain should have
+        :param pulumi.Input[builtins.str] zone_file: An RFC 1035 zone file (BIND format) to take the records from instead of records. SOA and apex NS records are skipped and record TTLs are ignored
+        """
+        pulumi.set(__self__, "domain", domain)
+        if ignore is not None:
+            pulumi.set(__self__, "ignore", ignore)
+        if records is not None:
+            pulumi.set(__self__, "records", records)
+        if zone_file is not None:
+            pulumi.set(__self__, "zone_file", zone_file)
+
+    @property
+    @pulumi.getter
+    def domain(self) -> pulumi.Input[builtins.str]:
+        """
+        The domain name whose records are managed (e.g., 'example.com')
+        """
+        return pulumi.get(self, "domain")
+
+    @domain.setter
+    def domain(self, value: pulumi.Input[builtins.str]):
+        pulumi.set(self, "domain", value)
+
+    @property
+    @pulumi.getter
+    def ignore(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['DNSRecordFilterArgs']]]]:
+        """
+        Existing records matching any of these rules are left alone instead of being deleted
+        """
+        return pulumi.get(self, "ignore")
+
+    @ignore.setter
+    def ignore(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['DNSRecordFilterArgs']]]]):
+        pulumi.set(self, "ignore", value)
+
+    @property
+    @pulumi.getter
+    def records(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['DNSZoneRecordArgs']]]]:
+        """
+        The complete list of records the domain should have
+        """
+        return pulumi.get(self, "records")
+
+    @records.setter
+    def records(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['DNSZoneRecordArgs']]]]):
+        pulumi.set(self, "records", value)
+
+    @property
+    @pulumi.getter(name="zoneFile")
+    def zone_file(self) -> Optional[pulumi.Input[builtins.str]]:
+        """
+        An RFC 1035 zone file (BIND format) to take the records from instead of records. SOA and apex NS records are skipped and record TTLs are ignored
+        """
+        return pulumi.get(self, "zone_file")
+
+    @zone_file.setter
+    def zone_file(self, value: Optional[pulumi.Input[builtins.str]]):
+        pulumi.set(self, "zone_file", value)
+
+
+@pulumi.type_token("netcup:index:DNSZoneRecords")
+class DNSZoneRecords(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 domain: Optional[pulumi.Input[builtins.str]] = None,
+                 ignore: Optional[pulumi.Input[Sequence[pulumi.Input[Union['DNSRecordFilterArgs', 'DNSRecordFilterArgsDict']]]]] = None,
+                 records: Optional[pulumi.Input[Sequence[pulumi.Input[Union['DNSZoneRecordArgs', 'DNSZoneRecordArgsDict']]]]] = None,
+                 zone_file: Optional[pulumi.Input[builtins.str]] = None,
+                 __props__=None):
+        """
+        The complete record set of a domain managed by Netcup DNS service. Records of the domain that are not listed are deleted unless they match an ignore rule. Do not combine with DNSRecord resources for the same domain
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[builtins.str] domain: The domain name whose records are managed (e.g., 'example.com')
+        :param pulumi.Input[Sequence[pulumi.Input[Union['DNSRecordFilterArgs', 'DNSRecordFilterArgsDict']]]] ignore: Existing records matching any of these rules are left alone instead of being deleted
+        :param pulumi.Input[Sequence[pulumi.Input[Union['DNSZoneRecordArgs', 'DNSZoneRecordArgsDict']]]] records: The complete list of records the domain should have
+        :param pulumi.Input[builtins.str] zone_file: An RFC 1035 zone file (BIND format) to take the records from instead of records. SOA and apex NS records are skipped and record TTLs are ignored
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: DNSZoneRecordsArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        The complete record set of a domain managed by Netcup DNS service. Records of the domain that are not listed are deleted unless they match an ignore rule. Do not combine with DNSRecord resources for the same domain
+
+        :param str resource_name: The name of the resource.
+        :param DNSZoneRecordsArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(DNSZoneRecordsArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 domain: Optional[pulumi.Input[builtins.str]] = None,
+                 ignore: Optional[pulumi.Input[Sequence[pulumi.Input[Union['DNSRecordFilterArgs', 'DNSRecordFilterArgsDict']]]]] = None,
+                 records: Optional[pulumi.Input[Sequence[pulumi.Input[Union['DNSZoneRecordArgs', 'DNSZoneRecordArgsDict']]]]] = None,
+                 zone_file: Optional[pulumi.Input[builtins.str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = DNSZoneRecordsArgs.__new__(DNSZoneRecordsArgs)
+
+            if domain is None and not opts.urn:
+                raise TypeError("Missing required property 'domain'")
+            __props__.__dict__["domain"] = domain
+            __props__.__dict__["ignore"] = ignore
+            __props__.__dict__["records"] = records
+            __props__.__dict__["zone_file"] = zone_file
+            __props__.__dict__["record_ids"] = None
+        super(DNSZoneRecords, __self__).__init__(
+            'netcup:index:DNSZoneRecords',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'DNSZoneRecords':
+        """
+        Get an existing DNSZoneRecords resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = DNSZoneRecordsArgs.__new__(DNSZoneRecordsArgs)
+
+        __props__.__dict__["domain"] = None
+        __props__.__dict__["ignore"] = None
+        __props__.__dict__["record_ids"] = None
+        __props__.__dict__["records"] = None
+        __props__.__dict__["zone_file"] = None
+        return DNSZoneRecords(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def domain(self) -> pulumi.Output[builtins.str]:
+        """
+        The domain name whose records are managed
+        """
+        return pulumi.get(self, "domain")
+
+    @property
+    @pulumi.getter
+    def ignore(self) -> pulumi.Output[Optional[Sequence['outputs.DNSRecordFilter']]]:
+        """
+        The rules selecting records that are left alone
+        """
+        return pulumi.get(self, "ignore")
+
+    @property
+    @pulumi.getter(name="recordIds")
+    def record_ids(self) -> pulumi.Output[Sequence[builtins.str]]:
+        """
+        The Netcup IDs of the records, in the order of records
+        """
+        return pulumi.get(self, "record_ids")
+
+    @property
+    @pulumi.getter
+    def records(self) -> pulumi.Output[Optional[Sequence['outputs.DNSZoneRecord']]]:
+        """
+        The records of the domain
+        """
+        return pulumi.get(self, "records")
+
+    @property
+    @pulumi.getter(name="zoneFile")
+    def zone_file(self) -> pulumi.Output[Optional[builtins.str]]:
+        """
+        The zone file the records were taken from
+        """
+        return pulumi.get(self, "zone_file")
+
@@ -0,0 +1,126 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = [
+    'ExportZoneResult',
+    'AwaitableExportZoneResult',
+    'export_zone',
+    'export_zone_output',
+]
+
+@pulumi.output_type
+class ExportZoneResult:
+    def __init__(__self__, content=None, domain=None, format=None, serial=None):
+        if content and not isinstance(content, str):
+            raise TypeError("Expected argument 'content' to be a str")
+        pulumi.set(__self__, "content", content)
+        if domain and not isinstance(domain, str):
+            raise TypeError("Expected argument 'domain' to be a str")
+        pulumi.set(__self__, "domain", domain)
+        if format and not isinstance(format, str):
+            raise TypeError("Expected argument 'format' to be a str")
+        pulumi.set(__self__, "format", format)
+        if serial and not isinstance(serial, str):
+            raise TypeError("Expected argument 'serial' to be a str")
+        pulumi.set(__self__, "serial", serial)
+
+    @property
+    @pulumi.getter
+    def content(self) -> builtins.str:
+        """
+        The exported zone
+        """
+        return pulumi.get(self, "content")
+
+    @property
+    @pulumi.getter
+    def domain(self) -> builtins.str:
+        """
+        The exported domain name
+        """
+        return pulumi.get(self, "domain")
+
+    @property
+    @pulumi.getter
+    def format(self) -> builtins.str:
+        """
+        The format of the export
+        """
+        return pulumi.get(self, "format")
+
+    @property
+    @pulumi.getter
+    def serial(self) -> builtins.str:
+        """
+        The serial of the zone at the time of the export
+        """
+        return pulumi.get(self, "serial")
+
+
+class AwaitableExportZoneResult(ExportZoneResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return ExportZoneResult(
+            content=self.content,
+            domain=self.domain,
+            format=self.format,
+            serial=self.serial)
+
+
+def export_zone(domain: Optional[builtins.str] = None,
+                format: Optional[builtins.str] = None,
+                opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableExportZoneResult:
+    """
+    Exports the records and zone settings of a domain as an RFC 1035 zone file, a JSON document or an octoDNS YAML zone, for backups, audits and migrations. Records are sorted, so exports of an unchanged zone are identical
+
+
+    :param builtins.str domain: The domain name to export (e.g., 'example.com')
+    :param builtins.str format: The format of the export: 'bind' for a zone file, 'json' or 'octodns'. Defaults to 'bind'
+    """
+    __args__ = dict()
+    __args__['domain'] = domain
+    __args__['format'] = format
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('netcup:index:exportZone', __args__, opts=opts, typ=ExportZoneResult).value
+
+    return AwaitableExportZoneResult(
+        content=pulumi.get(__ret__, 'content'),
+        domain=pulumi.get(__ret__, 'domain'),
+        format=pulumi.get(__ret__, 'format'),
+        serial=pulumi.get(__ret__, 'serial'))
+def export_zone_output(domain: Optional[pulumi.Input[builtins.str]] = None,
+                       format: Optional[pulumi.Input[Optional[builtins.str]]] = None,
+                       opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[ExportZoneResult]:
+    """
+    Exports the records and zone settings of a domain as an RFC 1035 zone file, a JSON document or an octoDNS YAML zone, for backups, audits and migrations. Records are sorted, so exports of an unchanged zone are identical
+
+
+    :param builtins.str domain: The domain name to export (e.g., 'example.com')
+    :param builtins.str format: The format of the export: 'bind' for a zone file, 'json' or 'octodns'. Defaults to 'bind'
+    """
+    __args__ = dict()
+    __args__['domain'] = domain
+    __args__['format'] = format
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('netcup:index:exportZone', __args__, opts=opts, typ=ExportZoneResult)
+    return __ret__.apply(lambda __response__: ExportZoneResult(
+        content=pulumi.get(__response__, 'content'),
+        domain=pulumi.get(__response__, 'domain'),
+        format=pulumi.get(__response__, 'format'),
+        serial=pulumi.get(__response__, 'serial')))
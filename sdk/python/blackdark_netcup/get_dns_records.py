@@ -0,0 +1,111 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetDnsRecordsResult',
+    'AwaitableGetDnsRecordsResult',
+    'get_dns_records',
+    'get_dns_records_output',
+]
+
+@pulumi.output_type
+class GetDnsRecordsResult:
+    def __init__(__self__, domain=None, records=None):
+        if domain and not isinstance(domain, str):
+            raise TypeError("Expected argument 'domain' to be a str")
+        pulumi.set(__self__, "domain", domain)
+        if records and not isinstance(records, list):
+            raise TypeError("Expected argument 'records' to be a list")
+        pulumi.set(__self__, "records", records)
+
+    @property
+    @pulumi.getter
+    def domain(self) -> builtins.str:
+        """
+        The domain name the DNS records belong to
+        """
+        return pulumi.get(self, "domain")
+
+    @property
+    @pulumi.getter
+    def records(self) -> Sequence['outputs.DNSRecordEntry']:
+        """
+        The DNS records matching the filters, in the order Netcup returns them
+        """
+        return pulumi.get(self, "records")
+
+
+class AwaitableGetDnsRecordsResult(GetDnsRecordsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetDnsRecordsResult(
+            domain=self.domain,
+            records=self.records)
+
+
+def get_dns_records(domain: Optional[builtins.str] = None,
+                    name: Optional[builtins.str] = None,
+                    type: Optional[builtins.str] = None,
+                    value_regex: Optional[builtins.str] = None,
+                    opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetDnsRecordsResult:
+    """
+    Returns the DNS records of a domain, optionally filtered by hostname, type or value. Records created outside of Pulumi, such as by the hosting panel, are included
+
+
+    :param builtins.str domain: The domain name to look up the DNS records of (e.g., 'example.com')
+    :param builtins.str name: Only return records of this hostname. Use '@' for root domain
+    :param builtins.str type: Only return records of this DNS record type
+    :param builtins.str value_regex: Only return records whose value/destination matches this regular expression
+    """
+    __args__ = dict()
+    __args__['domain'] = domain
+    __args__['name'] = name
+    __args__['type'] = type
+    __args__['valueRegex'] = value_regex
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('netcup:index:getDnsRecords', __args__, opts=opts, typ=GetDnsRecordsResult).value
+
+    return AwaitableGetDnsRecordsResult(
+        domain=pulumi.get(__ret__, 'domain'),
+        records=pulumi.get(__ret__, 'records'))
+def get_dns_records_output(domain: Optional[pulumi.Input[builtins.str]] = None,
+                           name: Optional[pulumi.Input[Optional[builtins.str]]] = None,
+                           type: Optional[pulumi.Input[Optional[builtins.str]]] = None,
+                           value_regex: Optional[pulumi.Input[Optional[builtins.str]]] = None,
+                           opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetDnsRecordsResult]:
+    """
+    Returns the DNS records of a domain, optionally filtered by hostname, type or value. Records created outside of Pulumi, such as by the hosting panel, are included
+
+
+    :param builtins.str domain: The domain name to look up the DNS records of (e.g., 'example.com')
+    :param builtins.str name: Only return records of this hostname. Use '@' for root domain
+    :param builtins.str type: Only return records of this DNS record type
+    :param builtins.str value_regex: Only return records whose value/destination matches this regular expression
+    """
+    __args__ = dict()
+    __args__['domain'] = domain
+    __args__['name'] = name
+    __args__['type'] = type
+    __args__['valueRegex'] = value_regex
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('netcup:index:getDnsRecords', __args__, opts=opts, typ=GetDnsRecordsResult)
+    return __ret__.apply(lambda __response__: GetDnsRecordsResult(
+        domain=pulumi.get(__response__, 'domain'),
+        records=pulumi.get(__response__, 'records')))
@@ -0,0 +1,149 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetDomainInfoResult',
+    'AwaitableGetDomainInfoResult',
+    'get_domain_info',
+    'get_domain_info_output',
+]
+
+@pulumi.output_type
+class GetDomainInfoResult:
+    def __init__(__self__, domain=None, handles=None, nameservers=None, runtime_end=None, runtime_start=None, state=None):
+        if domain and not isinstance(domain, str):
+            raise TypeError("Expected argument 'domain' to be a str")
+        pulumi.set(__self__, "domain", domain)
+        if handles and not isinstance(handles, list):
+            raise TypeError("Expected argument 'handles' to be a list")
+        pulumi.set(__self__, "handles", handles)
+        if nameservers and not isinstance(nameservers, list):
+            raise TypeError("Expected argument 'nameservers' to be a list")
+        pulumi.set(__self__, "nameservers", nameservers)
+        if runtime_end and not isinstance(runtime_end, str):
+            raise TypeError("Expected argument 'runtime_end' to be a str")
+        pulumi.set(__self__, "runtime_end", runtime_end)
+        if runtime_start and not isinstance(runtime_start, str):
+            raise TypeError("Expected argument 'runtime_start' to be a str")
+        pulumi.set(__self__, "runtime_start", runtime_start)
+        if state and not isinstance(state, str):
+            raise TypeError("Expected argument 'state' to be a str")
+        pulumi.set(__self__, "state", state)
+
+    @property
+    @pulumi.getter
+    def domain(self) -> builtins.str:
+        """
+        The domain name
+        """
+        return pulumi.get(self, "domain")
+
+    @property
+    @pulumi.getter
+    def handles(self) -> Sequence['outputs.DomainHandleDetails']:
+        """
+        The contact handles assigned to the domain
+        """
+        return pulumi.get(self, "handles")
+
+    @property
+    @pulumi.getter
+    def nameservers(self) -> Sequence[builtins.str]:
+        """
+        The hostnames of the nameservers the domain is delegated to
+        """
+        return pulumi.get(self, "nameservers")
+
+    @property
+    @pulumi.getter(name="runtimeEnd")
+    def runtime_end(self) -> builtins.str:
+        """
+        The end date of the current contract runtime
+        """
+        return pulumi.get(self, "runtime_end")
+
+    @property
+    @pulumi.getter(name="runtimeStart")
+    def runtime_start(self) -> builtins.str:
+        """
+        The start date of the current contract runtime
+        """
+        return pulumi.get(self, "runtime_start")
+
+    @property
+    @pulumi.getter
+    def state(self) -> builtins.str:
+        """
+        The registration state of the domain as reported by Netcup
+        """
+        return pulumi.get(self, "state")
+
+
+class AwaitableGetDomainInfoResult(GetDomainInfoResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetDomainInfoResult(
+            domain=self.domain,
+            handles=self.handles,
+            nameservers=self.nameservers,
+            runtime_end=self.runtime_end,
+            runtime_start=self.runtime_start,
+            state=self.state)
+
+
+def get_domain_info(domain: Optional[builtins.str] = None,
+                    opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetDomainInfoResult:
+    """
+    Returns the registration details of a domain owned by the Netcup customer account
+
+
+    :param builtins.str domain: The domain name to look up (e.g., 'example.com')
+    """
+    __args__ = dict()
+    __args__['domain'] = domain
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('netcup:index:getDomainInfo', __args__, opts=opts, typ=GetDomainInfoResult).value
+
+    return AwaitableGetDomainInfoResult(
+        domain=pulumi.get(__ret__, 'domain'),
+        handles=pulumi.get(__ret__, 'handles'),
+        nameservers=pulumi.get(__ret__, 'nameservers'),
+        runtime_end=pulumi.get(__ret__, 'runtime_end'),
+        runtime_start=pulumi.get(__ret__, 'runtime_start'),
+        state=pulumi.get(__ret__, 'state'))
+def get_domain_info_output(domain: Optional[pulumi.Input[builtins.str]] = None,
+                           opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetDomainInfoResult]:
+    """
+    Returns the registration details of a domain owned by the Netcup customer account
+
+
+    :param builtins.str domain: The domain name to look up (e.g., 'example.com')
+    """
+    __args__ = dict()
+    __args__['domain'] = domain
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('netcup:index:getDomainInfo', __args__, opts=opts, typ=GetDomainInfoResult)
+    return __ret__.apply(lambda __response__: GetDomainInfoResult(
+        domain=pulumi.get(__response__, 'domain'),
+        handles=pulumi.get(__response__, 'handles'),
+        nameservers=pulumi.get(__response__, 'nameservers'),
+        runtime_end=pulumi.get(__response__, 'runtime_end'),
+        runtime_start=pulumi.get(__response__, 'runtime_start'),
+        state=pulumi.get(__response__, 'state')))
@@ -0,0 +1,79 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetSshfpRecordsResult',
+    'AwaitableGetSshfpRecordsResult',
+    'get_sshfp_records',
+    'get_sshfp_records_output',
+]
+
+@pulumi.output_type
+class GetSshfpRecordsResult:
+    def __init__(__self__, records=None):
+        if records and not isinstance(records, list):
+            raise TypeError("Expected argument 'records' to be a list")
+        pulumi.set(__self__, "records", records)
+
+    @property
+    @pulumi.getter
+    def records(self) -> Sequence['outputs.SSHFPRecordEntry']:
+        """
+        The SSHFP records, a SHA-1 and a SHA-256 record per key
+        """
+        return pulumi.get(self, "records")
+
+
+class AwaitableGetSshfpRecordsResult(GetSshfpRecordsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetSshfpRecordsResult(
+            records=self.records)
+
+
+def get_sshfp_records(public_keys: Optional[Sequence[builtins.str]] = None,
+                      opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetSshfpRecordsResult:
+    """
+    Returns the SHA-1 and SHA-256 SSHFP records of SSH host keys, such as the contents of /etc/ssh/ssh_host_*_key.pub
+
+
+    :param Sequence[builtins.str] public_keys: The RSA, ECDSA or Ed25519 host keys in OpenSSH authorized_keys format
+    """
+    __args__ = dict()
+    __args__['publicKeys'] = public_keys
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('netcup:index:getSshfpRecords', __args__, opts=opts, typ=GetSshfpRecordsResult).value
+
+    return AwaitableGetSshfpRecordsResult(
+        records=pulumi.get(__ret__, 'records'))
+def get_sshfp_records_output(public_keys: Optional[pulumi.Input[Sequence[builtins.str]]] = None,
+                             opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetSshfpRecordsResult]:
+    """
+    Returns the SHA-1 and SHA-256 SSHFP records of SSH host keys, such as the contents of /etc/ssh/ssh_host_*_key.pub
+
+
+    :param Sequence[builtins.str] public_keys: The RSA, ECDSA or Ed25519 host keys in OpenSSH authorized_keys format
+    """
+    __args__ = dict()
+    __args__['publicKeys'] = public_keys
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('netcup:index:getSshfpRecords', __args__, opts=opts, typ=GetSshfpRecordsResult)
+    return __ret__.apply(lambda __response__: GetSshfpRecordsResult(
+        records=pulumi.get(__response__, 'records')))
@@ -0,0 +1,69 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'ListDomainsResult',
+    'AwaitableListDomainsResult',
+    'list_domains',
+    'list_domains_output',
+]
+
+@pulumi.output_type
+class ListDomainsResult:
+    def __init__(__self__, domains=None):
+        if domains and not isinstance(domains, list):
+            raise TypeError("Expected argument 'domains' to be a list")
+        pulumi.set(__self__, "domains", domains)
+
+    @property
+    @pulumi.getter
+    def domains(self) -> Sequence['outputs.DomainDetails']:
+        """
+        The domains of the account, sorted by name
+        """
+        return pulumi.get(self, "domains")
+
+
+class AwaitableListDomainsResult(ListDomainsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return ListDomainsResult(
+            domains=self.domains)
+
+
+def list_domains(opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableListDomainsResult:
+    """
+    Returns all domains owned by the Netcup customer account
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('netcup:index:listDomains', __args__, opts=opts, typ=ListDomainsResult).value
+
+    return AwaitableListDomainsResult(
+        domains=pulumi.get(__ret__, 'domains'))
+def list_domains_output(opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[ListDomainsResult]:
+    """
+    Returns all domains owned by the Netcup customer account
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('netcup:index:listDomains', __args__, opts=opts, typ=ListDomainsResult)
+    return __ret__.apply(lambda __response__: ListDomainsResult(
+        domains=pulumi.get(__response__, 'domains')))
@@ -0,0 +1,753 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'CAARecord',
+    'DNSRecordEntry',
+    'DNSRecordFilter',
+    'DNSRecordSetValue',
+    'DNSZoneRecord',
+    'DomainDetails',
+    'DomainHandleDetails',
+    'OPENPGPKEYRecord',
+    'SMIMEARecord',
+    'SRVRecord',
+    'SSHFPRecordEntry',
+    'SSHFPRecordSet',
+    'TLSARecord',
+]
+
+@pulumi.output_type
+class CAARecord(dict):
+    def __init__(__self__, *,
+                 tag: builtins.str,
+                 value: builtins.str,
+                 flags: Optional[builtins.int] = None):
+        """
+        :param builtins.str tag: The property tag: issue, issuewild, iodef, contactemail or contactphone
+        :param builtins.str value: The unquoted property value, e.g. 'letsencrypt.org' for issue or 'mailto:security@example.com' for iodef
+        :param builtins.int flags: The flags of the record: 0, or 128 to mark the property as critical for issuers
+        """
+        pulumi.set(__self__, "tag", tag)
+        pulumi.set(__self__, "value", value)
+        if flags is None:
+            flags = 0
+        if flags is not None:
+            pulumi.set(__self__, "flags", flags)
+
+    @property
+    @pulumi.getter
+    def tag(self) -> builtins.str:
+        """
+        The property tag: issue, issuewild, iodef, contactemail or contactphone
+        """
+        return pulumi.get(self, "tag")
+
+    @property
+    @pulumi.getter
+    def value(self) -> builtins.str:
+        """
+        The unquoted property value, e.g. 'letsencrypt.org' for issue or 'mailto:security@example.com' for iodef
+        """
+        return pulumi.get(self, "value")
+
+    @property
+    @pulumi.getter
+    def flags(self) -> Optional[builtins.int]:
+        """
+        The flags of the record: 0, or 128 to mark the property as critical for issuers
+        """
+        return pulumi.get(self, "flags")
+
+
+@pulumi.output_type
+class DNSRecordEntry(dict):
+    def __init__(__self__, *,
+                 fqdn: builtins.str,
+                 name: builtins.str,
+                 record_id: builtins.str,
+                 type: builtins.str,
+                 value: builtins.str,
+                 priority: Optional[builtins.str] = None):
+        """
+        :param builtins.str fqdn: The fully qualified domain name
+        :param builtins.str name: The hostname of the DNS record
+        :param builtins.str record_id: The Netcup record ID
+        :param builtins.str type: The DNS record type
+        :param builtins.str value: The value/destination of the DNS record
+        :param builtins.str priority: The priority of MX and SRV records
+        """
+        pulumi.set(__self__, "fqdn", fqdn)
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "record_id", record_id)
+        pulumi.set(__self__, "type", type)
+        pulumi.set(__self__, "value", value)
+        if priority is not None:
+            pulumi.set(__self__, "priority", priority)
+
+    @property
+    @pulumi.getter
+    def fqdn(self) -> builtins.str:
+        """
+        The fully qualified domain name
+        """
+        return pulumi.get(self, "fqdn")
+
+    @property
+    @pulumi.getter
+    def name(self) -> builtins.str:
+        """
+        The hostname of the DNS record
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="recordId")
+    def record_id(self) -> builtins.str:
+        """
+        The Netcup record ID
+        """
+        return pulumi.get(self, "record_id")
+
+    @property
+    @pulumi.getter
+    def type(self) -> builtins.str:
+        """
+        The DNS record type
+        """
+        return pulumi.get(self, "type")
+
+    @property
+    @pulumi.getter
+    def value(self) -> builtins.str:
+        """
+        The value/destination of the DNS record
+        """
+        return pulumi.get(self, "value")
+
+    @property
+    @pulumi.getter
+    def priority(self) -> Optional[builtins.str]:
+        """
+        The priority of MX and SRV records
+        """
+        return pulumi.get(self, "priority")
+
+
+@pulumi.output_type
+class DNSRecordFilter(dict):
+    def __init__(__self__, *,
+                 name: Optional[builtins.str] = None,
+                 type: Optional[builtins.str] = None):
+        """
+        :param builtins.str name: The hostname to match. Matches every hostname when not set
+        :param builtins.str type: The record type to match. Matches every type when not set
+        """
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+        if type is not None:
+            pulumi.set(__self__, "type", type)
+
+    @property
+    @pulumi.getter
+    def name(self) -> Optional[builtins.str]:
+        """
+        The hostname to match. Matches every hostname when not set
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter
+    def type(self) -> Optional[builtins.str]:
+        """
+        The record type to match. Matches every type when not set
+        """
+        return pulumi.get(self, "type")
+
+
+@pulumi.output_type
+class DNSRecordSetValue(dict):
+    def __init__(__self__, *,
+                 value: builtins.str,
+                 priority: Optional[builtins.str] = None):
+        """
+        :param builtins.str value: The value/destination of the record
+        :param builtins.str priority: The priority for MX and SRV records (required for these types, ignored for others)
+        """
+        pulumi.set(__self__, "value", value)
+        if priority is not None:
+            pulumi.set(__self__, "priority", priority)
+
+    @property
+    @pulumi.getter
+    def value(self) -> builtins.str:
+        """
+        The value/destination of the record
+        """
+        return pulumi.get(self, "value")
+
+    @property
+    @pulumi.getter
+    def priority(self) -> Optional[builtins.str]:
+        """
+        The priority for MX and SRV records (required for these types, ignored for others)
+        """
+        return pulumi.get(self, "priority")
+
+
+@pulumi.output_type
+class DNSZoneRecord(dict):
+    def __init__(__self__, *,
+                 name: builtins.str,
+                 type: builtins.str,
+                 value: builtins.str,
+                 priority: Optional[builtins.str] = None):
+        """
+        :param builtins.str name: The hostname of the record. Use '@' for the root domain
+        :param builtins.str type: The DNS record type
+        :param builtins.str value: The value/destination of the record
+        :param builtins.str priority: The priority for MX and SRV records
+        """
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "type", type)
+        pulumi.set(__self__, "value", value)
+        if priority is not None:
+            pulumi.set(__self__, "priority", priority)
+
+    @property
+    @pulumi.getter
+    def name(self) -> builtins.str:
+        """
+        The hostname of the record. Use '@' for the root domain
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter
+    def type(self) -> builtins.str:
+        """
+        The DNS record type
+        """
+        return pulumi.get(self, "type")
+
+    @property
+    @pulumi.getter
+    def value(self) -> builtins.str:
+        """
+        The value/destination of the record
+        """
+        return pulumi.get(self, "value")
+
+    @property
+    @pulumi.getter
+    def priority(self) -> Optional[builtins.str]:
+        """
+        The priority for MX and SRV records
+        """
+        return pulumi.get(self, "priority")
+
+
+@pulumi.output_type
+class DomainDetails(dict):
+    def __init__(__self__, *,
+                 domain: builtins.str,
+                 handles: Sequence['outputs.DomainHandleDetails'],
+                 nameservers: Sequence[builtins.str],
+                 runtime_end: builtins.str,
+                 runtime_start: builtins.str,
+                 state: builtins.str):
+        """
+        :param builtins.str domain: The domain name
+        :param Sequence['DomainHandleDetails'] handles: The contact handles assigned to the domain
+        :param Sequence[builtins.str] nameservers: The hostnames of the nameservers the domain is delegated to
+        :param builtins.str runtime_end: The end date of the current contract runtime
+        :param builtins.str runtime_start: The start date of the current contract runtime
+        :param builtins.str state: The registration state of the domain as reported by Netcup
+        """
+        pulumi.set(__self__, "domain", domain)
+        pulumi.set(__self__, "handles", handles)
+        pulumi.set(__self__, "nameservers", nameservers)
+        pulumi.set(__self__, "runtime_end", runtime_end)
+        pulumi.set(__self__, "runtime_start", runtime_start)
+        pulumi.set(__self__, "state", state)
+
+    @property
+    @pulumi.getter
+    def domain(self) -> builtins.str:
+        """
+        The domain name
+        """
+        return pulumi.get(self, "domain")
+
+    @property
+    @pulumi.getter
+    def handles(self) -> Sequence['outputs.DomainHandleDetails']:
+        """
+        The contact handles assigned to the domain
+        """
+        return pulumi.get(self, "handles")
+
+    @property
+    @pulumi.getter
+    def nameservers(self) -> Sequence[builtins.str]:
+        """
+        The hostnames of the nameservers the domain is delegated to
+        """
+        return pulumi.get(self, "nameservers")
+
+    @property
+    @pulumi.getter(name="runtimeEnd")
+    def runtime_end(self) -> builtins.str:
+        """
+        The end date of the current contract runtime
+        """
+        return pulumi.get(self, "runtime_end")
+
+    @property
+    @pulumi.getter(name="runtimeStart")
+    def runtime_start(self) -> builtins.str:
+        """
+        The start date of the current contract runtime
+        """
+        return pulumi.get(self, "runtime_start")
+
+    @property
+    @pulumi.getter
+    def state(self) -> builtins.str:
+        """
+        The registration state of the domain as reported by Netcup
+        """
+        return pulumi.get(self, "state")
+
+
+@pulumi.output_type
+class DomainHandleDetails(dict):
+    def __init__(__self__, *,
+                 handle_id: builtins.str,
+                 type: builtins.str):
+        """
+        :param builtins.str handle_id: The Netcup ID of the handle
+        :param builtins.str type: The role of the handle, such as 'ownerc', 'adminc', 'techc' or 'zonec'
+        """
+        pulumi.set(__self__, "handle_id", handle_id)
+        pulumi.set(__self__, "type", type)
+
+    @property
+    @pulumi.getter(name="handleId")
+    def handle_id(self) -> builtins.str:
+        """
+        The Netcup ID of the handle
+        """
+        return pulumi.get(self, "handle_id")
+
+    @property
+    @pulumi.getter
+    def type(self) -> builtins.str:
+        """
+        The role of the handle, such as 'ownerc', 'adminc', 'techc' or 'zonec'
+        """
+        return pulumi.get(self, "type")
+
+
+@pulumi.output_type
+class OPENPGPKEYRecord(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "publicKey":
+            suggest = "public_key"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in OPENPGPKEYRecord. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        OPENPGPKEYRecord.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        OPENPGPKEYRecord.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 email: builtins.str,
+                 public_key: builtins.str):
+        """
+        :param builtins.str email: The email address the key belongs to, within the domain of the record
+        :param builtins.str public_key: The ASCII armored OpenPGP public key, with a user ID of the email address
+        """
+        pulumi.set(__self__, "email", email)
+        pulumi.set(__self__, "public_key", public_key)
+
+    @property
+    @pulumi.getter
+    def email(self) -> builtins.str:
+        """
+        The email address the key belongs to, within the domain of the record
+        """
+        return pulumi.get(self, "email")
+
+    @property
+    @pulumi.getter(name="publicKey")
+    def public_key(self) -> builtins.str:
+        """
+        The ASCII armored OpenPGP public key, with a user ID of the email address
+        """
+        return pulumi.get(self, "public_key")
+
+
+@pulumi.output_type
+class SMIMEARecord(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "matchingType":
+            suggest = "matching_type"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in SMIMEARecord. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        SMIMEARecord.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        SMIMEARecord.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 certificate: builtins.str,
+                 email: builtins.str,
+                 matching_type: Optional[builtins.int] = None,
+                 selector: Optional[builtins.int] = None,
+                 usage: Optional[builtins.int] = None):
+        """
+        :param builtins.str certificate: The PEM encoded S/MIME certificate of the email address
+        :param builtins.str email: The email address the certificate is issued for, within the domain of the record
+        :param builtins.int matching_type: The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512. Senders need the full certificate to encrypt, so it defaults to 0
+        :param builtins.int selector: The selector: 0 for the full certificate or 1 for the public key
+        :param builtins.int usage: The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+        """
+        pulumi.set(__self__, "certificate", certificate)
+        pulumi.set(__self__, "email", email)
+        if matching_type is None:
+            matching_type = 0
+        if matching_type is not None:
+            pulumi.set(__self__, "matching_type", matching_type)
+        if selector is None:
+            selector = 0
+        if selector is not None:
+            pulumi.set(__self__, "selector", selector)
+        if usage is None:
+            usage = 3
+        if usage is not None:
+            pulumi.set(__self__, "usage", usage)
+
+    @property
+    @pulumi.getter
+    def certificate(self) -> builtins.str:
+        """
+        The PEM encoded S/MIME certificate of the email address
+        """
+        return pulumi.get(self, "certificate")
+
+    @property
+    @pulumi.getter
+    def email(self) -> builtins.str:
+        """
+        The email address the certificate is issued for, within the domain of the record
+        """
+        return pulumi.get(self, "email")
+
+    @property
+    @pulumi.getter(name="matchingType")
+    def matching_type(self) -> Optional[builtins.int]:
+        """
+        The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512. Senders need the full certificate to encrypt, so it defaults to 0
+        """
+        return pulumi.get(self, "matching_type")
+
+    @property
+    @pulumi.getter
+    def selector(self) -> Optional[builtins.int]:
+        """
+        The selector: 0 for the full certificate or 1 for the public key
+        """
+        return pulumi.get(self, "selector")
+
+    @property
+    @pulumi.getter
+    def usage(self) -> Optional[builtins.int]:
+        """
+        The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+        """
+        return pulumi.get(self, "usage")
+
+
+@pulumi.output_type
+class SRVRecord(dict):
+    def __init__(__self__, *,
+                 port: builtins.int,
+                 protocol: builtins.str,
+                 service: builtins.str,
+                 target: builtins.str,
+                 weight: Optional[builtins.int] = None):
+        """
+        :param builtins.int port: The port the service is offered on, between 0 and 65535
+        :param builtins.str protocol: The transport protocol without leading underscore (e.g., 'tcp', 'udp', 'tls')
+        :param builtins.str service: The symbolic name of the service without leading underscore (e.g., 'sip', 'xmpp-client')
+        :param builtins.str target: The hostname of the server offering the service, or '.' if the service is not offered
+        :param builtins.int weight: The relative weight of records with the same priority, between 0 and 65535
+        """
+        pulumi.set(__self__, "port", port)
+        pulumi.set(__self__, "protocol", protocol)
+        pulumi.set(__self__, "service", service)
+        pulumi.set(__self__, "target", target)
+        if weight is None:
+            weight = 0
+        if weight is not None:
+            pulumi.set(__self__, "weight", weight)
+
+    @property
+    @pulumi.getter
+    def port(self) -> builtins.int:
+        """
+        The port the service is offered on, between 0 and 65535
+        """
+        return pulumi.get(self, "port")
+
+    @property
+    @pulumi.getter
+    def protocol(self) -> builtins.str:
+        """
+        The transport protocol without leading underscore (e.g., 'tcp', 'udp', 'tls')
+        """
+        return pulumi.get(self, "protocol")
+
+    @property
+    @pulumi.getter
+    def service(self) -> builtins.str:
+        """
+        The symbolic name of the service without leading underscore (e.g., 'sip', 'xmpp-client')
+        """
+        return pulumi.get(self, "service")
+
+    @property
+    @pulumi.getter
+    def target(self) -> builtins.str:
+        """
+        The hostname of the server offering the service, or '.' if the service is not offered
+        """
+        return pulumi.get(self, "target")
+
+    @property
+    @pulumi.getter
+    def weight(self) -> Optional[builtins.int]:
+        """
+        The relative weight of records with the same priority, between 0 and 65535
+        """
+        return pulumi.get(self, "weight")
+
+
+@pulumi.output_type
+class SSHFPRecordEntry(dict):
+    def __init__(__self__, *,
+                 algorithm: builtins.int,
+                 fingerprint: builtins.str,
+                 fingerprint_type: builtins.int,
+                 value: builtins.str):
+        """
+        :param builtins.int algorithm: The SSHFP algorithm number: 1 (RSA), 3 (ECDSA) or 4 (Ed25519)
+        :param builtins.str fingerprint: The hex encoded fingerprint of the key
+        :param builtins.int fingerprint_type: The fingerprint type: 1 (SHA-1) or 2 (SHA-256)
+        :param builtins.str value: The value of the SSHFP record
+        """
+        pulumi.set(__self__, "algorithm", algorithm)
+        pulumi.set(__self__, "fingerprint", fingerprint)
+        pulumi.set(__self__, "fingerprint_type", fingerprint_type)
+        pulumi.set(__self__, "value", value)
+
+    @property
+    @pulumi.getter
+    def algorithm(self) -> builtins.int:
+        """
+        The SSHFP algorithm number: 1 (RSA), 3 (ECDSA) or 4 (Ed25519)
+        """
+        return pulumi.get(self, "algorithm")
+
+    @property
+    @pulumi.getter
+    def fingerprint(self) -> builtins.str:
+        """
+        The hex encoded fingerprint of the key
+        """
+        return pulumi.get(self, "fingerprint")
+
+    @property
+    @pulumi.getter(name="fingerprintType")
+    def fingerprint_type(self) -> builtins.int:
+        """
+        The fingerprint type: 1 (SHA-1) or 2 (SHA-256)
+        """
+        return pulumi.get(self, "fingerprint_type")
+
+    @property
+    @pulumi.getter
+    def value(self) -> builtins.str:
+        """
+        The value of the SSHFP record
+        """
+        return pulumi.get(self, "value")
+
+
+@pulumi.output_type
+class SSHFPRecordSet(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "publicKeys":
+            suggest = "public_keys"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in SSHFPRecordSet. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        SSHFPRecordSet.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        SSHFPRecordSet.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 public_keys: Sequence[builtins.str]):
+        """
+        :param Sequence[builtins.str] public_keys: The RSA, ECDSA or Ed25519 host keys in OpenSSH authorized_keys format
+        """
+        pulumi.set(__self__, "public_keys", public_keys)
+
+    @property
+    @pulumi.getter(name="publicKeys")
+    def public_keys(self) -> Sequence[builtins.str]:
+        """
+        The RSA, ECDSA or Ed25519 host keys in OpenSSH authorized_keys format
+        """
+        return pulumi.get(self, "public_keys")
+
+
+@pulumi.output_type
+class TLSARecord(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "matchingType":
+            suggest = "matching_type"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in TLSARecord. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        TLSARecord.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        TLSARecord.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 certificate: builtins.str,
+                 port: builtins.int,
+                 matching_type: Optional[builtins.int] = None,
+                 protocol: Optional[builtins.str] = None,
+                 selector: Optional[builtins.int] = None,
+                 usage: Optional[builtins.int] = None):
+        """
+        :param builtins.str certificate: The PEM encoded certificate or public key (SubjectPublicKeyInfo) the record is derived from. Of a certificate chain, the first certificate is used
+        :param builtins.int port: The port the TLS service is offered on, e.g. 25 for SMTP
+        :param builtins.int matching_type: The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512
+        :param builtins.str protocol: The transport protocol of the service without leading underscore
+        :param builtins.int selector: The selector: 0 for the full certificate or 1 for the public key
+        :param builtins.int usage: The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+        """
+        pulumi.set(__self__, "certificate", certificate)
+        pulumi.set(__self__, "port", port)
+        if matching_type is None:
+            matching_type = 1
+        if matching_type is not None:
+            pulumi.set(__self__, "matching_type", matching_type)
+        if protocol is None:
+            protocol = 'tcp'
+        if protocol is not None:
+            pulumi.set(__self__, "protocol", protocol)
+        if selector is None:
+            selector = 1
+        if selector is not None:
+            pulumi.set(__self__, "selector", selector)
+        if usage is None:
+            usage = 3
+        if usage is not None:
+            pulumi.set(__self__, "usage", usage)
+
+    @property
+    @pulumi.getter
+    def certificate(self) -> builtins.str:
+        """
+        The PEM encoded certificate or public key (SubjectPublicKeyInfo) the record is derived from. Of a certificate chain, the first certificate is used
+        """
+        return pulumi.get(self, "certificate")
+
+    @property
+    @pulumi.getter
+    def port(self) -> builtins.int:
+        """
+        The port the TLS service is offered on, e.g. 25 for SMTP
+        """
+        return pulumi.get(self, "port")
+
+    @property
+    @pulumi.getter(name="matchingType")
+    def matching_type(self) -> Optional[builtins.int]:
+        """
+        The matching type: 0 for the full data, 1 for SHA-256 or 2 for SHA-512
+        """
+        return pulumi.get(self, "matching_type")
+
+    @property
+    @pulumi.getter
+    def protocol(self) -> Optional[builtins.str]:
+        """
+        The transport protocol of the service without leading underscore
+        """
+        return pulumi.get(self, "protocol")
+
+    @property
+    @pulumi.getter
+    def selector(self) -> Optional[builtins.int]:
+        """
+        The selector: 0 for the full certificate or 1 for the public key
+        """
+        return pulumi.get(self, "selector")
+
+    @property
+    @pulumi.getter
+    def usage(self) -> Optional[builtins.int]:
+        """
+        The certificate usage: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE)
+        """
+        return pulumi.get(self, "usage")
+
+
@@ -0,0 +1,85 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins
+import copy
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'ParseZoneFileResult',
+    'AwaitableParseZoneFileResult',
+    'parse_zone_file',
+    'parse_zone_file_output',
+]
+
+@pulumi.output_type
+class ParseZoneFileResult:
+    def __init__(__self__, records=None):
+        if records and not isinstance(records, list):
+            raise TypeError("Expected argument 'records' to be a list")
+        pulumi.set(__self__, "records", records)
+
+    @property
+    @pulumi.getter
+    def records(self) -> Sequence['outputs.DNSZoneRecord']:
+        """
+        The records of the zone file, in the order they are defined
+        """
+        return pulumi.get(self, "records")
+
+
+class AwaitableParseZoneFileResult(ParseZoneFileResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return ParseZoneFileResult(
+            records=self.records)
+
+
+def parse_zone_file(domain: Optional[builtins.str] = None,
+                    zone_file: Optional[builtins.str] = None,
+                    opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableParseZoneFileResult:
+    """
+    Parses an RFC 1035 zone file (BIND format) into the records of a domain, ready to be used as records of a DNSZoneRecords resource. SOA and apex NS records are skipped, as Netcup manages them, and record TTLs are ignored
+
+
+    :param builtins.str domain: The domain name the zone file belongs to. It is the initial $ORIGIN
+    :param builtins.str zone_file: The content of the zone file
+    """
+    __args__ = dict()
+    __args__['domain'] = domain
+    __args__['zoneFile'] = zone_file
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('netcup:index:parseZoneFile', __args__, opts=opts, typ=ParseZoneFileResult).value
+
+    return AwaitableParseZoneFileResult(
+        records=pulumi.get(__ret__, 'records'))
+def parse_zone_file_output(domain: Optional[pulumi.Input[builtins.str]] = None,
+                           zone_file: Optional[pulumi.Input[builtins.str]] = None,
+                           opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[ParseZoneFileResult]:
+    """
+    Parses an RFC 1035 zone file (BIND format) into the records of a domain, ready to be used as records of a DNSZoneRecords resource. SOA and apex NS records are skipped, as Netcup manages them, and record TTLs are ignored
+
+
+    :param builtins.str domain: The domain name the zone file belongs to. It is the initial $ORIGIN
+    :param builtins.str zone_file: The content of the zone file
+    """
+    __args__ = dict()
+    __args__['domain'] = domain
+    __args__['zoneFile'] = zone_file
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('netcup:index:parseZoneFile', __args__, opts=opts, typ=ParseZoneFileResult)
+    return __ret__.apply(lambda __response__: ParseZoneFileResult(
+        records=pulumi.get(__response__, 'records')))
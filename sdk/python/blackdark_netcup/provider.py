@@ -20,55 +20,192 @@ __all__ = ['ProviderArgs', 'Provider']
 @pulumi.input_type
 class ProviderArgs:
     def __init__(__self__, *,
-                 api_key: pulumi.Input[builtins.str],
-                 api_password: pulumi.Input[builtins.str],
-                 customer_id: pulumi.Input[builtins.str]):
+                 api_key: Optional[pulumi.Input[builtins.str]] = None,
+                 api_password: Optional[pulumi.Input[builtins.str]] = None,
+                 ca_bundle: Optional[pulumi.Input[builtins.str]] = None,
+                 customer_id: Optional[pulumi.Input[builtins.str]] = None,
+                 endpoint: Optional[pulumi.Input[builtins.str]] = None,
+                 max_retries: Optional[pulumi.Input[builtins.int]] = None,
+                 nameserver: Optional[pulumi.Input[builtins.str]] = None,
+                 proxy_url: Optional[pulumi.Input[builtins.str]] = None,
+                 request_timeout: Optional[pulumi.Input[builtins.int]] = None,
+                 requests_per_minute: Optional[pulumi.Input[builtins.int]] = None,
+                 user_agent: Optional[pulumi.Input[builtins.str]] = None):
         """
         The set of arguments for constructing a Provider resource.
-        :param pulumi.Input[builtins.str] api_key: The Netcup API key for authentication
-        :param pulumi.Input[builtins.str] api_password: The Netcup API password for authentication
-        :param pulumi.Input[builtins.str] customer_id: The Netcup customer ID
+        :param pulumi.Input[builtins.str] api_key: The Netcup API key for authentication. Can also be set with NETCUP_API_KEY
+        :param pulumi.Input[builtins.str] api_password: The Netcup API password for authentication. Can also be set with NETCUP_API_PASSWORD
+        :param pulumi.Input[builtins.str] ca_bundle: Path to a PEM file with additional CA certificates trusted for API requests
+        :param pulumi.Input[builtins.str] customer_id: The Netcup customer ID. Can also be set with NETCUP_CUSTOMER_ID
+        :param pulumi.Input[builtins.str] endpoint: The URL of the Netcup CCP JSON API. Defaults to https://ccp.netcup.net/run/webservice/servers/endpoint.php?JSON
+        :param pulumi.Input[builtins.int] max_retries: How often a rate-limited (status 2057) or transiently failed API request is retried. Defaults to 5
+        :param pulumi.Input[builtins.str] nameserver: The nameserver (host:port) DNSKEY records of DNSSEC signed zones are read from. Defaults to root-dns.netcup.net:53
+        :param pulumi.Input[builtins.str] proxy_url: The URL of an HTTP proxy for API requests. Defaults to the HTTPS_PROXY and NO_PROXY environment variables
+        :param pulumi.Input[builtins.int] request_timeout: The timeout of a single API request in seconds. Defaults to 30
+        :param pulumi.Input[builtins.int] requests_per_minute: The maximum number of Netcup API requests per minute made by the provider. The limit is shared by all provider instances in the process. Defaults to 180
+        :param pulumi.Input[builtins.str] user_agent: The User-Agent header sent with API requests
         """
-        pulumi.set(__self__, "api_key", api_key)
-        pulumi.set(__self__, "api_password", api_password)
-        pulumi.set(__self__, "customer_id", customer_id)
+        if api_key is None:
+            api_key = _utilities.get_env('NETCUP_API_KEY')
+        if api_key is not None:
+            pulumi.set(__self__, "api_key", api_key)
+        if api_password is None:
+            api_password = _utilities.get_env('NETCUP_API_PASSWORD')
+        if api_password is not None:
+            pulumi.set(__self__, "api_password", api_password)
+        if ca_bundle is not None:
+            pulumi.set(__self__, "ca_bundle", ca_bundle)
+        if customer_id is None:
+            customer_id = _utilities.get_env('NETCUP_CUSTOMER_ID')
+        if customer_id is not None:
+            pulumi.set(__self__, "customer_id", customer_id)
+        if endpoint is not None:
+            pulumi.set(__self__, "endpoint", endpoint)
+        if max_retries is not None:
+            pulumi.set(__self__, "max_retries", max_retries)
+        if nameserver is not None:
+            pulumi.set(__self__, "nameserver", nameserver)
+        if proxy_url is not None:
+            pulumi.set(__self__, "proxy_url", proxy_url)
+        if request_timeout is not None:
+            pulumi.set(__self__, "request_timeout", request_timeout)
+        if requests_per_minute is not None:
+            pulumi.set(__self__, "requests_per_minute", requests_per_minute)
+        if user_agent is not None:
+            pulumi.set(__self__, "user_agent", user_agent)
 
     @property
     @pulumi.getter(name="apiKey")
-    def api_key(self) -> pulumi.Input[builtins.str]:
+    def api_key(self) -> Optional[pulumi.Input[builtins.str]]:
         """
-        The Netcup API key for authentication
+        The Netcup API key for authentication. Can also be set with NETCUP_API_KEY
         """
         return pulumi.get(self, "api_key")
 
     @api_key.setter
-    def api_key(self, value: pulumi.Input[builtins.str]):
+    def api_key(self, value: Optional[pulumi.Input[builtins.str]]):
         pulumi.set(self, "api_key", value)
 
     @property
     @pulumi.getter(name="apiPassword")
-    def api_password(self) -> pulumi.Input[builtins.str]:
+    def api_password(self) -> Optional[pulumi.Input[builtins.str]]:
         """
-        The Netcup API password for authentication
+        The Netcup API password for authentication. Can also be set with NETCUP_API_PASSWORD
         """
         return pulumi.get(self, "api_password")
 
     @api_password.setter
-    def api_password(self, value: pulumi.Input[builtins.str]):
+    def api_password(self, value: Optional[pulumi.Input[builtins.str]]):
         pulumi.set(self, "api_password", value)
 
+    @property
+    @pulumi.getter(name="caBundle")
+    def ca_bundle(self) -> Optional[pulumi.Input[builtins.str]]:
+        """
+        Path to a PEM file with additional CA certificates trusted for API requests
+        """
+        return pulumi.get(self, "ca_bundle")
+
+    @ca_bundle.setter
+    def ca_bundle(self, value: Optional[pulumi.Input[builtins.str]]):
+        pulumi.set(self, "ca_bundle", value)
+
     @property
     @pulumi.getter(name="customerId")
-    def customer_id(self) -> pulumi.Input[builtins.str]:
+    def customer_id(self) -> Optional[pulumi.Input[builtins.str]]:
         """
-        The Netcup customer ID
+        The Netcup customer ID. Can also be set with NETCUP_CUSTOMER_ID
         """
         return pulumi.get(self, "customer_id")
 
     @customer_id.setter
-    def customer_id(self, value: pulumi.Input[builtins.str]):
+    def customer_id(self, value: Optional[pulumi.Input[builtins.str]]):
         pulumi.set(self, "customer_id", value)
 
+    @property
+    @pulumi.getter
+    def endpoint(self) -> Optional[pulumi.Input[builtins.str]]:
+        """
+        The URL of the Netcup CCP JSON API. Defaults to https://ccp.netcup.net/run/webservice/servers/endpoint.php?JSON
+        """
+        return pulumi.get(self, "endpoint")
+
+    @endpoint.setter
+    def endpoint(self, value: Optional[pulumi.Input[builtins.str]]):
+        pulumi.set(self, "endpoint", value)
+
+    @property
+    @pulumi.getter(name="maxRetries")
+    def max_retries(self) -> Optional[pulumi.Input[builtins.int]]:
+        """
+        How often a rate-limited (status 2057) or transiently failed API request is retried. Defaults to 5
+        """
+        return pulumi.get(self, "max_retries")
+
+    @max_retries.setter
+    def max_retries(self, value: Optional[pulumi.Input[builtins.int]]):
+        pulumi.set(self, "max_retries", value)
+
+    @property
+    @pulumi.getter
+    def nameserver(self) -> Optional[pulumi.Input[builtins.str]]:
+        """
+        The nameserver (host:port) DNSKEY records of DNSSEC signed zones are read from. Defaults to root-dns.netcup.net:53
+        """
+        return pulumi.get(self, "nameserver")
+
+    @nameserver.setter
+    def nameserver(self, value: Optional[pulumi.Input[builtins.str]]):
+        pulumi.set(self, "nameserver", value)
+
+    @property
+    @pulumi.getter(name="proxyUrl")
+    def proxy_url(self) -> Optional[pulumi.Input[builtins.str]]:
+        """
+        The URL of an HTTP proxy for API requests. Defaults to the HTTPS_PROXY and NO_PROXY environment variables
+        """
+        return pulumi.get(self, "proxy_url")
+
+    @proxy_url.setter
+    def proxy_url(self, value: Optional[pulumi.Input[builtins.str]]):
+        pulumi.set(self, "proxy_url", value)
+
+    @property
+    @pulumi.getter(name="requestTimeout")
+    def request_timeout(self) -> Optional[pulumi.Input[builtins.int]]:
+        """
+        The timeout of a single API request in seconds. Defaults to 30
+        """
+        return pulumi.get(self, "request_timeout")
+
+    @request_timeout.setter
+    def request_timeout(self, value: Optional[pulumi.Input[builtins.int]]):
+        pulumi.set(self, "request_timeout", value)
+
+    @property
+    @pulumi.getter(name="requestsPerMinute")
+    def requests_per_minute(self) -> Optional[pulumi.Input[builtins.int]]:
+        """
+        The maximum number of Netcup API requests per minute made by the provider. The limit is shared by all provider instances in the process. Defaults to 180
+        """
+        return pulumi.get(self, "requests_per_minute")
+
+    @requests_per_minute.setter
+    def requests_per_minute(self, value: Optional[pulumi.Input[builtins.int]]):
+        pulumi.set(self, "requests_per_minute", value)
+
+    @property
+    @pulumi.getter(name="userAgent")
+    def user_agent(self) -> Optional[pulumi.Input[builtins.str]]:
+        """
+        The User-Agent header sent with API requests
+        """
+        return pulumi.get(self, "user_agent")
+
+    @user_agent.setter
+    def user_agent(self, value: Optional[pulumi.Input[builtins.str]]):
+        pulumi.set(self, "user_agent", value)
+
 
 @pulumi.type_token("pulumi:providers:netcup")
 class Provider(pulumi.ProviderResource):
@@ -78,21 +215,37 @@ class Provider(pulumi.ProviderResource):
                  opts: Optional[pulumi.ResourceOptions] = None,
                  api_key: Optional[pulumi.Input[builtins.str]] = None,
                  api_password: Optional[pulumi.Input[builtins.str]] = None,
+                 ca_bundle: Optional[pulumi.Input[builtins.str]] = None,
                  customer_id: Optional[pulumi.Input[builtins.str]] = None,
+                 endpoint: Optional[pulumi.Input[builtins.str]] = None,
+                 max_retries: Optional[pulumi.Input[builtins.int]] = None,
+                 nameserver: Optional[pulumi.Input[builtins.str]] = None,
+                 proxy_url: Optional[pulumi.Input[builtins.str]] = None,
+                 request_timeout: Optional[pulumi.Input[builtins.int]] = None,
+                 requests_per_minute: Optional[pulumi.Input[builtins.int]] = None,
+                 user_agent: Optional[pulumi.Input[builtins.str]] = None,
                  __props__=None):
         """
         Create a Netcup resource with the given unique name, props, and options.
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
-        :param pulumi.Input[builtins.str] api_key: The Netcup API key for authentication
-        :param pulumi.Input[builtins.str] api_password: The Netcup API password for authentication
-        :param pulumi.Input[builtins.str] customer_id: The Netcup customer ID
+        :param pulumi.Input[builtins.str] api_key: The Netcup API key for authentication. Can also be set with NETCUP_API_KEY
+        :param pulumi.Input[builtins.str] api_password: The Netcup API password for authentication. Can also be set with NETCUP_API_PASSWORD
+        :param pulumi.Input[builtins.str] ca_bundle: Path to a PEM file with additional CA certificates trusted for API requests
+        :param pulumi.Input[builtins.str] customer_id: The Netcup customer ID. Can also be set with NETCUP_CUSTOMER_ID
+        :param pulumi.Input[builtins.str] endpoint: The URL of the Netcup CCP JSON API. Defaults to https://ccp.netcup.net/run/webservice/servers/endpoint.php?JSON
+        :param pulumi.Input[builtins.int] max_retries: How often a rate-limited (status 2057) or transiently failed API request is retried. Defaults to 5
+        :param pulumi.Input[builtins.str] nameserver: The nameserver (host:port) DNSKEY records of DNSSEC signed zones are read from. Defaults to root-dns.netcup.net:53
+        :param pulumi.Input[builtins.str] proxy_url: The URL of an HTTP proxy for API requests. Defaults to the HTTPS_PROXY and NO_PROXY environment variables
+        :param pulumi.Input[builtins.int] request_timeout: The timeout of a single API request in seconds. Defaults to 30
+        :param pulumi.Input[builtins.int] requests_per_minute: The maximum number of Netcup API requests per minute made by the provider. The limit is shared by all provider instances in the process. Defaults to 180
+        :param pulumi.Input[builtins.str] user_agent: The User-Agent header sent with API requests
         """
         ...
     @overload
     def __init__(__self__,
                  resource_name: str,
-                 args: ProviderArgs,
+                 args: Optional[ProviderArgs] = None,
                  opts: Optional[pulumi.ResourceOptions] = None):
         """
         Create a Netcup resource with the given unique name, props, and options.
@@ -113,7 +266,15 @@ class Provider(pulumi.ProviderResource):
                  opts: Optional[pulumi.ResourceOptions] = None,
                  api_key: Optional[pulumi.Input[builtins.str]] = None,
                  api_password: Optional[pulumi.Input[builtins.str]] = None,
+                 ca_bundle: Optional[pulumi.Input[builtins.str]] = None,
                  customer_id: Optional[pulumi.Input[builtins.str]] = None,
+                 endpoint: Optional[pulumi.Input[builtins.str]] = None,
+                 max_retries: Optional[pulumi.Input[builtins.int]] = None,
+                 nameserver: Optional[pulumi.Input[builtins.str]] = None,
+                 proxy_url: Optional[pulumi.Input[builtins.str]] = None,
+                 request_timeout: Optional[pulumi.Input[builtins.int]] = None,
+                 requests_per_minute: Optional[pulumi.Input[builtins.int]] = None,
+                 user_agent: Optional[pulumi.Input[builtins.str]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
         if not isinstance(opts, pulumi.ResourceOptions):
@@ -123,15 +284,23 @@ class Provider(pulumi.ProviderResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = ProviderArgs.__new__(ProviderArgs)
 
-            if api_key is None and not opts.urn:
-                raise TypeError("Missing required property 'api_key'")
+            if api_key is None:
+                api_key = _utilities.get_env('NETCUP_API_KEY')
             __props__.__dict__["api_key"] = None if api_key is None else pulumi.Output.secret(api_key)
-            if api_password is None and not opts.urn:
-                raise TypeError("Missing required property 'api_password'")
+            if api_password is None:
+                api_password = _utilities.get_env('NETCUP_API_PASSWORD')
             __props__.__dict__["api_password"] = None if api_password is None else pulumi.Output.secret(api_password)
-            if customer_id is None and not opts.urn:
-                raise TypeError("Missing required property 'customer_id'")
+            __props__.__dict__["ca_bundle"] = ca_bundle
+            if customer_id is None:
+                customer_id = _utilities.get_env('NETCUP_CUSTOMER_ID')
             __props__.__dict__["customer_id"] = customer_id
+            __props__.__dict__["endpoint"] = endpoint
+            __props__.__dict__["max_retries"] = pulumi.Output.from_input(max_retries).apply(pulumi.runtime.to_json) if max_retries is not None else None
+            __props__.__dict__["nameserver"] = nameserver
+            __props__.__dict__["proxy_url"] = proxy_url
+            __props__.__dict__["request_timeout"] = pulumi.Output.from_input(request_timeout).apply(pulumi.runtime.to_json) if request_timeout is not None else None
+            __props__.__dict__["requests_per_minute"] = pulumi.Output.from_input(requests_per_minute).apply(pulumi.runtime.to_json) if requests_per_minute is not None else None
+            __props__.__dict__["user_agent"] = user_agent
         secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["apiKey", "apiPassword"])
         opts = pulumi.ResourceOptions.merge(opts, secret_opts)
         super(Provider, __self__).__init__(
@@ -142,25 +311,65 @@ class Provider(pulumi.ProviderResource):
 
     @property
     @pulumi.getter(name="apiKey")
-    def api_key(self) -> pulumi.Output[builtins.str]:
+    def api_key(self) -> pulumi.Output[Optional[builtins.str]]:
         """
-        The Netcup API key for authentication
+        The Netcup API key for authentication. Can also be set with NETCUP_API_KEY
         """
         return pulumi.get(self, "api_key")
 
     @property
     @pulumi.getter(name="apiPassword")
-    def api_password(self) -> pulumi.Output[builtins.str]:
+    def api_password(self) -> pulumi.Output[Optional[builtins.str]]:
         """
-        The Netcup API password for authentication
+        The Netcup API password for authentication. Can also be set with NETCUP_API_PASSWORD
         """
         return pulumi.get(self, "api_password")
 
+    @property
+    @pulumi.getter(name="caBundle")
+    def ca_bundle(self) -> pulumi.Output[Optional[builtins.str]]:
+        """
+        Path to a PEM file with additional CA certificates trusted for API requests
+        """
+        return pulumi.get(self, "ca_bundle")
+
     @property
     @pulumi.getter(name="customerId")
-    def customer_id(self) -> pulumi.Output[builtins.str]:
+    def customer_id(self) -> pulumi.Output[Optional[builtins.str]]:
         """
-        The Netcup customer ID
+        The Netcup customer ID. Can also be set with NETCUP_CUSTOMER_ID
         """
         return pulumi.get(self, "customer_id")
 
+    @property
+    @pulumi.getter
+    def endpoint(self) -> pulumi.Output[Optional[builtins.str]]:
+        """
+        The URL of the Netcup CCP JSON API. Defaults to https://ccp.netcup.net/run/webservice/servers/endpoint.php?JSON
+        """
+        return pulumi.get(self, "endpoint")
+
+    @property
+    @pulumi.getter
+    def nameserver(self) -> pulumi.Output[Optional[builtins.str]]:
+        """
+        The nameserver (host:port) DNSKEY records of DNSSEC signed zones are read from. Defaults to root-dns.netcup.net:53
+        """
+        return pulumi.get(self, "nameserver")
+
+    @property
+    @pulumi.getter(name="proxyUrl")
+    def proxy_url(self) -> pulumi.Output[Optional[builtins.str]]:
+        """
+        The URL of an HTTP proxy for API requests. Defaults to the HTTPS_PROXY and NO_PROXY environment variables
+        """
+        return pulumi.get(self, "proxy_url")
+
+    @property
+    @pulumi.getter(name="userAgent")
+    def user_agent(self) -> pulumi.Output[Optional[builtins.str]]:
+        """
+        The User-Agent header sent with API requests
+        """
+        return pulumi.get(self, "user_agent")
+
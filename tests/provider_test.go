@@ -36,7 +36,7 @@ func TestDnsRecordCreateDryRun(t *testing.T) {
 	prov := provider(t)
 
 	response, err := prov.Create(p.CreateRequest{
-		Urn: urn("DnsRecord"),
+		Urn: urn("DNSRecord"),
 		Properties: property.NewMap(map[string]property.Value{
 			"domain": property.New("example.com"),
 			"name":   property.New("test"),